@@ -30,3 +30,10 @@ func ErrInvalidShape(expected onnx.Shape, actual []int) error {
 func ErrModel(format string, a ...any) error {
 	return fmt.Errorf("%w: %s", errModel, fmt.Sprintf(format, a...))
 }
+
+// ErrRunInterrupted is used when a run of a model was interrupted while executing a node,
+// because its context was canceled or its deadline was exceeded. The error of the context
+// is wrapped, such that it can be checked using errors.Is.
+func ErrRunInterrupted(node string, err error) error {
+	return fmt.Errorf("%w: run interrupted at node %v: %w", errModel, node, err)
+}
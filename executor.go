@@ -0,0 +1,161 @@
+package gonnx
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+)
+
+// parallelRun holds the state of a run in which the steps of a plan are executed by
+// multiple workers. A step is ready to be executed as soon as all steps producing its
+// inputs are done.
+type parallelRun struct {
+	model  *Model
+	plan   *plan
+	values []ops.Value
+
+	// pending holds for every step the number of steps it still waits for, and consumers
+	// holds for every slot the number of steps that still have to use it.
+	pending   []atomic.Int32
+	consumers []atomic.Int32
+
+	ready     chan *step
+	done      chan struct{}
+	remaining atomic.Int32
+
+	liveBytes atomic.Int64
+	peakBytes atomic.Int64
+
+	errOnce sync.Once
+	err     error
+}
+
+// executeParallel executes the steps of the plan using at most workers goroutines, where
+// steps that do not depend on each other are executed at the same time. It returns the
+// highest number of bytes held by the values produced by the steps at the same time.
+// The first error of any step stops the run, and interrupts the steps still executing.
+func (m *Model) executeParallel(ctx context.Context, p *plan, values []ops.Value) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := &parallelRun{
+		model:     m,
+		plan:      p,
+		values:    values,
+		pending:   make([]atomic.Int32, len(p.steps)),
+		consumers: make([]atomic.Int32, len(p.consumers)),
+		ready:     make(chan *step, len(p.steps)),
+		done:      make(chan struct{}),
+	}
+
+	r.remaining.Store(int32(len(p.steps)))
+
+	for slot, n := range p.consumers {
+		r.consumers[slot].Store(int32(n))
+	}
+
+	for i, s := range p.steps {
+		r.pending[i].Store(int32(s.deps))
+
+		if s.deps == 0 {
+			r.ready <- s
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < min(m.workers, len(p.steps)); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			r.work(ctx, cancel)
+		}()
+	}
+
+	wg.Wait()
+
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return r.peakBytes.Load(), nil
+}
+
+// work executes ready steps until all steps are done or the run failed.
+func (r *parallelRun) work(ctx context.Context, cancel context.CancelFunc) {
+	for {
+		select {
+		case <-r.done:
+			return
+		case s := <-r.ready:
+			if err := r.executeStep(ctx, s); err != nil {
+				r.errOnce.Do(func() {
+					r.err = err
+					cancel()
+					close(r.done)
+				})
+
+				return
+			}
+
+			if r.remaining.Add(-1) == 0 {
+				close(r.done)
+				return
+			}
+		}
+	}
+}
+
+// executeStep executes a single step, after which the values which are not used anymore
+// are dropped and the steps that waited for this step are scheduled when they are ready.
+func (r *parallelRun) executeStep(ctx context.Context, s *step) error {
+	if err := ctx.Err(); err != nil {
+		return ErrRunInterrupted(s.String(), err)
+	}
+
+	if err := r.model.applyOp(ctx, s, r.values); err != nil {
+		return err
+	}
+
+	var outputBytes int64
+	for _, slot := range s.outputs {
+		if slot != noSlot {
+			outputBytes += ops.ValueBytes(r.values[slot])
+		}
+	}
+
+	storeMax(&r.peakBytes, r.liveBytes.Add(outputBytes))
+
+	for _, slot := range s.inputs {
+		if slot != noSlot && r.consumers[slot].Add(-1) == 0 {
+			r.free(slot)
+		}
+	}
+
+	for _, slot := range s.outputs {
+		if slot != noSlot && r.plan.consumers[slot] == 0 {
+			r.free(slot)
+		}
+	}
+
+	for _, i := range s.next {
+		if r.pending[i].Add(-1) == 0 {
+			r.ready <- r.plan.steps[i]
+		}
+	}
+
+	return nil
+}
+
+// free drops the value in the slot, if the plan allows the slot to be dropped.
+func (r *parallelRun) free(slot int) {
+	if !r.plan.freeable[slot] {
+		return
+	}
+
+	r.liveBytes.Add(-ops.ValueBytes(r.values[slot]))
+	r.values[slot] = nil
+}
@@ -0,0 +1,121 @@
+package gonnx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelRunParallel(t *testing.T) {
+	for name, bytesModel := range sampleModelsFixture(t) {
+		bytesModel := bytesModel
+
+		t.Run(name, func(t *testing.T) {
+			model, err := NewModelFromBytes(bytesModel)
+			assert.Nil(t, err)
+
+			expected, err := model.Run(inputsFixture(model))
+			assert.Nil(t, err)
+
+			model, err = NewModelFromBytes(bytesModel, WithWorkers(4))
+			assert.Nil(t, err)
+
+			for i := 0; i < 3; i++ {
+				outputs, err := model.Run(inputsFixture(model))
+				assert.Nil(t, err)
+				assert.Equal(t, len(expected), len(outputs))
+
+				for outputName, expectedTensor := range expected {
+					assert.Equal(t, expectedTensor.Shape(), outputs[outputName].Shape())
+					assert.Equal(t, expectedTensor.Data(), outputs[outputName].Data())
+				}
+			}
+		})
+	}
+}
+
+func TestModelRunParallelBranches(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z", "w"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Sigmoid", Input: []string{"a_out"}, Output: []string{"c_out"}},
+			{Name: "d", OpType: "Abs", Input: []string{"x"}, Output: []string{"unused"}},
+			{Name: "e", OpType: "Add", Input: []string{"b_out", "c_out"}, Output: []string{"z"}},
+			{Name: "f", OpType: "Mul", Input: []string{"b_out", "b_out"}, Output: []string{"w"}},
+		},
+	)
+
+	model, err := NewModel(mp, WithWorkers(3))
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(
+		tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{-1, 0}}),
+		WithIntermediates("c_out"),
+	)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float32{1.7310586, 0.5}, outputs["z"].Data(), 0.00001)
+	assert.Equal(t, []float32{1, 0}, outputs["w"].Data())
+	assert.InDeltaSlice(t, []float32{0.7310586, 0.5}, outputs["c_out"].Data(), 0.00001)
+	assert.Greater(t, model.PeakTensorBytes(), int64(0))
+}
+
+func TestModelRunParallelSkippedOutputs(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y", "z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Split", Input: []string{"x"}, Output: []string{"", "y"}},
+			{Name: "b", OpType: "Split", Input: []string{"x"}, Output: []string{"", "z"}},
+		},
+	)
+
+	model, err := NewModel(mp, WithWorkers(2))
+	assert.Nil(t, err)
+
+	// Both nodes are executed at the same time, hence their skipped outputs must not share
+	// a slot. Run with -race to detect them writing to the same slot.
+	for i := 0; i < 10; i++ {
+		outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{rangeFloat(4)}))
+		assert.Nil(t, err)
+		assert.Equal(t, []float32{2, 3}, outputs["y"].Data())
+		assert.Equal(t, []float32{2, 3}, outputs["z"].Data())
+	}
+}
+
+func TestModelRunParallelError(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z", "w"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"z"}},
+			{Name: "c", OpType: "Add", Input: []string{"x", "y"}, Output: []string{"c_out"}},
+			{Name: "d", OpType: "Abs", Input: []string{"c_out"}, Output: []string{"w"}},
+		},
+	)
+
+	model, err := NewModel(mp, WithWorkers(2))
+	assert.Nil(t, err)
+
+	_, err = model.Run(tensorsFixture(
+		[]string{"x", "y"},
+		[][]int{{4}, {3}},
+		[][]float32{rangeFloat(4), rangeFloat(3)},
+	))
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = model.RunContext(ctx, tensorsFixture(
+		[]string{"x", "y"},
+		[][]int{{4}, {4}},
+		[][]float32{rangeFloat(4), rangeFloat(4)},
+	))
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,98 @@
+package gonnx
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+// upcastHalfInputs converts the half precision inputs of an operator to float32, for every
+// input for which the operator does not support the half precision type but does support
+// float32. Besides the inputs, the original half precision inputs are returned at the
+// position of every converted input, which is nil when no input was converted. The given
+// inputs are left untouched.
+func upcastHalfInputs(op ops.Operator, inputs []tensor.Tensor) ([]tensor.Tensor, []tensor.Tensor, error) {
+	typeConstraints := op.GetInputTypeConstraints()
+
+	var halfInputs []tensor.Tensor
+
+	upcast := inputs
+
+	for i, input := range inputs {
+		if input == nil || !isHalfDtype(input.Dtype()) || i >= len(typeConstraints) {
+			continue
+		}
+
+		if slices.Contains(typeConstraints[i], input.Dtype()) || !slices.Contains(typeConstraints[i], tensor.Float32) {
+			continue
+		}
+
+		converted, err := ops.ConvertTensorDtype(input, int32(onnx.TensorProto_FLOAT))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if halfInputs == nil {
+			halfInputs = make([]tensor.Tensor, len(inputs))
+			upcast = slices.Clone(inputs)
+		}
+
+		halfInputs[i] = input
+		upcast[i] = converted
+	}
+
+	return upcast, halfInputs, nil
+}
+
+// downcastOutputs converts the float32 outputs of an operator back to the half precision
+// type of the input they follow, if that input was converted to float32 by
+// upcastHalfInputs. Outputs which follow another input keep their type.
+func downcastOutputs(op ops.Operator, outputs, halfInputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	for i, output := range outputs {
+		if output == nil || output.Dtype() != tensor.Float32 {
+			continue
+		}
+
+		input := outputTypeInput(op, i)
+		if input < 0 || input >= len(halfInputs) || halfInputs[input] == nil {
+			continue
+		}
+
+		converted, err := ops.ConvertTensorDtypeLike(output, halfInputs[input])
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[i] = converted
+	}
+
+	return outputs, nil
+}
+
+// outputTypeInput returns the index of the input of which the output of the operator has
+// the dtype, or -1 when the dtype of the output does not depend on the inputs. Unless the
+// operator tells otherwise, an output has the dtype of the first input allowing float32.
+func outputTypeInput(op ops.Operator, output int) int {
+	if op, ok := op.(ops.OutputTypeOperator); ok {
+		outputTypeInputs := op.GetOutputTypeInputs()
+		if output >= len(outputTypeInputs) {
+			return -1
+		}
+
+		return outputTypeInputs[output]
+	}
+
+	for i, typeConstraint := range op.GetInputTypeConstraints() {
+		if slices.Contains(typeConstraint, tensor.Float32) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isHalfDtype(dtype tensor.Dtype) bool {
+	return dtype == onnx.Float16Dtype || dtype == onnx.BFloat16Dtype
+}
@@ -0,0 +1,117 @@
+package gonnx
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestModelHalfPrecision(t *testing.T) {
+	tests := []struct {
+		dataType int32
+		x        tensor.Tensor
+		w        *onnx.TensorProto
+		expected tensor.Tensor
+	}{
+		{
+			int32(onnx.TensorProto_FLOAT16),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float16{0xbc00, 0x3c00, 0x4000})),
+			&onnx.TensorProto{Dims: []int64{3}, RawData: []byte{0x00, 0x38, 0x00, 0x3c, 0x00, 0xbc}},
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float16{0x3800, 0x4000, 0x3c00})),
+		},
+		{
+			int32(onnx.TensorProto_BFLOAT16),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.BFloat16{0xbf80, 0x3f80, 0x4000})),
+			&onnx.TensorProto{Dims: []int64{3}, Int32Data: []int32{0x3f00, 0x3f80, 0xbf80}},
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.BFloat16{0x3f00, 0x4000, 0x3f80})),
+		},
+	}
+
+	for _, test := range tests {
+		mp := modelProtoFixture(
+			[]string{"x"},
+			[]string{"y"},
+			[]*onnx.NodeProto{
+				{Name: "relu", OpType: "Relu", Input: []string{"x"}, Output: []string{"relu_out"}},
+				{Name: "add", OpType: "Add", Input: []string{"relu_out", "w"}, Output: []string{"y"}},
+			},
+		)
+
+		test.w.Name = "w"
+		test.w.DataType = test.dataType
+		mp.Graph.Initializer = []*onnx.TensorProto{test.w}
+
+		model, err := NewModel(mp)
+		assert.Nil(t, err)
+
+		outputs, err := model.Run(Tensors{"x": test.x})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, outputs["y"])
+	}
+}
+
+func TestUpcastHalfInputs(t *testing.T) {
+	x := tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Float16{0x3c00, 0x4000}))
+	inputs := []tensor.Tensor{x}
+
+	upcast, halfInputs, err := upcastHalfInputs(&opset13.Relu{}, inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []tensor.Tensor{x}, halfInputs)
+	assert.Equal(t, []float32{1, 2}, upcast[0].Data())
+	assert.Equal(t, x, inputs[0])
+
+	outputs, err := downcastOutputs(&opset13.Relu{}, upcast, halfInputs)
+	assert.Nil(t, err)
+	assert.Equal(t, x, outputs[0])
+
+	// The cast operator supports half precision inputs, hence they are kept.
+	upcast, halfInputs, err = upcastHalfInputs(&opset13.Cast{}, inputs)
+	assert.Nil(t, err)
+	assert.Nil(t, halfInputs)
+	assert.Equal(t, inputs, upcast)
+}
+
+func TestModelHalfPrecisionMixedOutputs(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "q"},
+		[]string{"y", "mean", "inv_std_dev", "dequantized"},
+		[]*onnx.NodeProto{
+			{
+				Name: "norm", OpType: "LayerNormalization", Input: []string{"x", "scale"},
+				Output: []string{"y", "mean", "inv_std_dev"},
+			},
+			{
+				Name: "dequantize", OpType: "DequantizeLinear", Input: []string{"q", "q_scale", "q_zero_point"},
+				Output: []string{"dequantized"},
+			},
+		},
+	)
+	mp.OpsetImport = []*onnx.OperatorSetIdProto{{Version: 17}}
+	mp.Graph.Initializer = []*onnx.TensorProto{
+		{Name: "scale", DataType: int32(onnx.TensorProto_FLOAT16), Dims: []int64{2}, Int32Data: []int32{0x3c00, 0x3c00}},
+		{Name: "q_scale", DataType: int32(onnx.TensorProto_FLOAT16), Int32Data: []int32{0x3800}},
+		{Name: "q_zero_point", DataType: int32(onnx.TensorProto_INT8), Int32Data: []int32{0}},
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{
+		"x": tensor.New(tensor.WithShape(2, 2), tensor.WithBacking([]onnx.Float16{0x3c00, 0x4200, 0xbc00, 0x3c00})),
+		"q": tensor.New(tensor.WithShape(2), tensor.WithBacking([]int8{2, -4})),
+	})
+	assert.Nil(t, err)
+
+	// The result of a layer normalization follows the type of the input, but the mean and
+	// the inverse standard deviation are float32, as set by the stash_type attribute.
+	assert.Equal(t, []onnx.Float16{0xbc00, 0x3c00, 0xbc00, 0x3c00}, outputs["y"].Data())
+	assert.Equal(t, []float32{2, 0}, outputs["mean"].Data())
+	assert.Equal(t, tensor.Float32, outputs["inv_std_dev"].Dtype())
+	assert.InDeltaSlice(t, []float32{1, 1}, outputs["inv_std_dev"].Data(), 0.0001)
+
+	// The result of a dequantization follows the type of the scale, not of the input.
+	assert.Equal(t, []onnx.Float16{0x3c00, 0xc000}, outputs["dequantized"].Data())
+}
@@ -2,8 +2,16 @@ package gonnx
 
 import (
 	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path"
+	"path/filepath"
+	"sync/atomic"
+	"time"
 
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
@@ -14,25 +22,104 @@ import (
 // Tensors is a map with tensors.
 type Tensors map[string]tensor.Tensor
 
-// Model defines a model that can be used for inference.
+// Values is a map with values. Besides tensors, a value can be an ops.Sequence, an ops.Map
+// or an ops.Optional.
+type Values map[string]ops.Value
+
+// Model defines a model that can be used for inference. A model is safe for concurrent
+// use by multiple goroutines: operators are not allowed to change their state while they
+// are applied, and the parameters of the model are never handed to operators directly.
+//
+// Operators only get views of the parameters, which share their data, hence operators must
+// never modify their inputs in place. The outputs of a run never share data with the
+// parameters: outputs which would, like an initializer which is also an output of the
+// graph or a sequence constructed from one, are copied. The caller is therefore free to
+// modify the outputs of a run.
 type Model struct {
-	mp          *onnx.ModelProto
-	parameters  Tensors
+	mp         *onnx.ModelProto
+	parameters Tensors
+	plan       *plan
+	opsets     opsets
+	registry   *Registry
+
+	// mappings holds the external data files mapped into memory to back the parameters.
+	mappings *onnx.Mappings
+
+	// getMLOperator is the getter used to resolve the operators of the ai.onnx.ml domain
+	// which are not in the registry of the model.
+	getMLOperator OpGetter
+
+	// peakTensorBytes is the highest number of bytes held by tensors produced during a
+	// single run, over all runs of the model.
+	peakTensorBytes atomic.Int64
+
+	// GetOperator is the getter used to resolve the operators of the default ONNX domain
+	// which are not in the registry of the model. It is only read while the graph is
+	// compiled, when the model is created, hence changing it afterwards has no effect.
+	//
+	// Deprecated: Custom operators should be resolved by giving the model a registry using
+	// WithRegistry.
 	GetOperator OpGetter
+
+	// observer, if set, is called before and after every node that is executed.
+	observer Observer
+
+	// workers is the maximum number of nodes executed at the same time during a run.
+	workers int
+}
+
+// NewModelFromFile creates a new model from a path to a file. Tensors stored as external
+// data are loaded from files relative to the directory of the model.
+func NewModelFromFile(path string, opts ...ModelOption) (*Model, error) {
+	return NewModelFromFS(os.DirFS(filepath.Dir(path)), filepath.Base(path), opts...)
 }
 
-// NewModelFromFile creates a new model from a path to a file.
-func NewModelFromFile(path string) (*Model, error) {
-	bytesModel, err := os.ReadFile(path)
+// NewModelFromFS creates a new model from a file in a file system, like an embedded file
+// system. Tensors stored as external data are loaded from files in the same file system,
+// relative to the directory of the model.
+func NewModelFromFS(fsys fs.FS, name string, opts ...ModelOption) (*Model, error) {
+	bytesModel, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	mp, err := ModelProtoFromBytes(bytesModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if dir := path.Dir(name); dir != "." {
+		if fsys, err = fs.Sub(fsys, dir); err != nil {
+			return nil, err
+		}
+	}
+
+	config := newModelConfig(opts)
+	if !config.mapParameters {
+		if err := mp.Graph.LoadExternalData(fsys); err != nil {
+			return nil, err
+		}
+
+		return newModel(mp, config)
+	}
+
+	mappings, err := mp.Graph.MapExternalData(fsys)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewModelFromBytes(bytesModel)
+	m, err := newModel(mp, config)
+	if err != nil {
+		return nil, errors.Join(err, mappings.Close())
+	}
+
+	m.mappings = mappings
+
+	return m, nil
 }
 
 // NewModelFromZipFile creates a new model from a file in a zip archive.
-func NewModelFromZipFile(file *zip.File) (*Model, error) {
+func NewModelFromZipFile(file *zip.File, opts ...ModelOption) (*Model, error) {
 	fc, err := file.Open()
 	if err != nil {
 		return nil, err
@@ -43,47 +130,106 @@ func NewModelFromZipFile(file *zip.File) (*Model, error) {
 		return nil, err
 	}
 
-	return NewModelFromBytes(bytesModel)
+	return NewModelFromBytes(bytesModel, opts...)
 }
 
 // NewModelFromBytes creates a new model from a list of bytes.
-func NewModelFromBytes(bytesModel []byte) (*Model, error) {
+func NewModelFromBytes(bytesModel []byte, opts ...ModelOption) (*Model, error) {
 	mp, err := ModelProtoFromBytes(bytesModel)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewModel(mp)
+	return NewModel(mp, opts...)
 }
 
 // NewModel creates a new model ready for inference given a path to an onnx file.
-func NewModel(mp *onnx.ModelProto) (*Model, error) {
-	params, err := mp.Graph.Params()
+// The graph of the model is compiled into an execution plan, which means all operators
+// are resolved and initialized once. Invalid operators and attributes are reported here
+// instead of when the model is run. The model can be configured using model options,
+// for example to resolve custom operators from a registry.
+func NewModel(mp *onnx.ModelProto, opts ...ModelOption) (*Model, error) {
+	return newModel(mp, newModelConfig(opts))
+}
+
+func newModel(mp *onnx.ModelProto, config *modelConfig) (*Model, error) {
+	params, err := graphParams(mp.Graph, config)
 	if err != nil {
 		return nil, err
 	}
 
-	opsetImports := mp.GetOpsetImport()
+	m := &Model{
+		mp:         mp,
+		parameters: params,
+		opsets:     newOpsets(mp.GetOpsetImport()),
+		registry:   config.registry,
+		observer:   config.observer,
+		workers:    config.workers,
+	}
 
-	var opsetID int64
+	// The built-in operators are resolved for the imported versions of the ONNX domains.
+	// Every other domain can only have operators from the registry.
+	if version, ok := m.opsets.version(DomainONNX); ok {
+		if m.GetOperator, err = ResolveOperatorGetter(version); err != nil {
+			return nil, err
+		}
+	}
 
-	for i := 0; i < len(opsetImports); i++ {
-		version := opsetImports[i].GetVersion()
-		if version > opsetID {
-			opsetID = version
+	if version, ok := m.opsets.version(DomainML); ok {
+		if m.getMLOperator, err = ResolveMLOperatorGetter(version); err != nil {
+			return nil, err
 		}
 	}
 
-	GetOperator, err := ResolveOperatorGetter(opsetID)
+	m.plan, err = newPlan(mp.Graph, m.getOperator)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Model{
-		mp:          mp,
-		parameters:  params,
-		GetOperator: GetOperator,
-	}, nil
+	return m, nil
+}
+
+// graphParams returns the parameters of the graph, which share the memory of their raw
+// data when the parameters are mapped.
+func graphParams(g *onnx.GraphProto, config *modelConfig) (Tensors, error) {
+	if config.mapParameters {
+		return g.ParamsNoCopy()
+	}
+
+	return g.Params()
+}
+
+// Close releases the memory mappings backing the parameters of the model, if any. The
+// model, and the tensors of its runs, must not be used anymore after it is closed.
+func (m *Model) Close() error {
+	return m.mappings.Close()
+}
+
+// getOperator resolves the operator of a node, for the version of its domain imported by
+// the model. Operators in the registry of the model take precedence, such that users can
+// add operators to the ONNX domains as well. Other operators of the ONNX domains are
+// resolved using the built-in operators. Operators of any other domain must be in the
+// registry.
+func (m *Model) getOperator(n *onnx.NodeProto) (ops.Operator, error) {
+	domain := normalizeDomain(n.GetDomain())
+
+	version, ok := m.opsets.version(domain)
+	if !ok {
+		return nil, ErrModel("domain %v of node %v is not imported by the model", domain, n.GetName())
+	}
+
+	if factory, ok := m.registry.Lookup(domain, n.GetOpType(), version); ok {
+		return factory(), nil
+	}
+
+	switch domain {
+	case DomainONNX:
+		return m.GetOperator(n.GetOpType())
+	case DomainML:
+		return m.getMLOperator(n.GetOpType())
+	default:
+		return nil, ops.ErrUnknownOperatorType(fmt.Sprintf("%v of domain %v", n.GetOpType(), domain))
+	}
 }
 
 // ModelProtoFromBytes creates an onnx.ModelProto based on a list of bytes.
@@ -151,79 +297,419 @@ func (m *Model) hasInput(input string) bool {
 	return false
 }
 
-// Run builds and executes the computional graph of the network given the inputs.
-func (m *Model) Run(inputs Tensors) (Tensors, error) {
-	if err := m.validateShapes(inputs); err != nil {
+func (m *Model) hasOutput(output string) bool {
+	for _, outputName := range m.OutputNames() {
+		if outputName == output {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run executes the compiled graph of the network given the inputs. The run can be
+// configured using run options, for example to request intermediate tensors. All inputs
+// and outputs must be tensors; use RunValues for graphs with sequences, maps or optionals.
+func (m *Model) Run(inputs Tensors, opts ...RunOption) (Tensors, error) {
+	return m.RunContext(context.Background(), inputs, opts...)
+}
+
+// RunContext executes the compiled graph of the network given the inputs, like Run. The
+// context is checked before every node is executed, and while long running operators
+// like LSTM, GRU and RNN are applied. When the context is done, the run is interrupted
+// and an error is returned which wraps the error of the context.
+func (m *Model) RunContext(ctx context.Context, inputs Tensors, opts ...RunOption) (Tensors, error) {
+	inputValues := make(Values, len(inputs))
+	for name, input := range inputs {
+		inputValues[name] = input
+	}
+
+	outputValues, err := m.RunValuesContext(ctx, inputValues, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	outputTensors := make(Tensors, len(outputValues))
+
+	for name, output := range outputValues {
+		outputTensor, ok := output.(tensor.Tensor)
+		if !ok {
+			return nil, ErrModel("output %v is not a tensor, use RunValues to get it", name)
+		}
+
+		outputTensors[name] = outputTensor
+	}
+
+	return outputTensors, nil
+}
+
+// RunValues executes the compiled graph of the network given the inputs, like Run. The
+// inputs and outputs are values, hence graphs with sequences, maps and optionals can be
+// run as well.
+func (m *Model) RunValues(inputs Values, opts ...RunOption) (Values, error) {
+	return m.RunValuesContext(context.Background(), inputs, opts...)
+}
+
+// RunValuesContext executes the compiled graph of the network given the input values, like
+// RunValues. The run is interrupted when the context is done, like RunContext.
+func (m *Model) RunValuesContext(ctx context.Context, inputs Values, opts ...RunOption) (Values, error) {
+	p, outputNames, err := m.runPlan(newRunConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateShapes(p, inputs); err != nil {
 		return nil, err
 	}
 
-	tensors := make(Tensors)
-	for inputName, inputTensor := range inputs {
-		tensors[inputName] = inputTensor
+	values := p.newSlots()
+
+	// Operators only get views of the inputs and parameters, which share the data but
+	// have their own shape. This way, the parameters are shared between runs safely and
+	// the inputs of the caller are left untouched.
+	for inputName, inputValue := range inputs {
+		if slot, ok := m.plan.slots[inputName]; ok {
+			values[slot] = ops.ShallowCopyValue(inputValue)
+		}
 	}
 
 	for parameterName, parameterTensor := range m.parameters {
-		tensors[parameterName] = parameterTensor
+		if slot, ok := m.plan.slots[parameterName]; ok {
+			values[slot] = ops.ShallowCopy(parameterTensor)
+		}
 	}
 
-	for _, n := range m.mp.Graph.GetNode() {
-		op, err := m.GetOperator(n.GetOpType())
-		if err != nil {
-			return nil, err
+	var peakBytes int64
+
+	if m.workers > 1 && len(p.steps) > 1 {
+		peakBytes, err = m.executeParallel(ctx, p, values)
+	} else {
+		peakBytes, err = m.execute(ctx, p, values)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	storeMax(&m.peakTensorBytes, peakBytes)
+
+	outputValues := make(Values)
+	for _, outputName := range outputNames {
+		outputValues[outputName] = m.copyParameterData(values[p.slots[outputName]])
+	}
+
+	return outputValues, nil
+}
+
+// copyParameterData returns the value with a copy of every tensor that shares its data
+// with one of the parameters, such that the caller cannot modify the parameters through
+// the outputs of a run.
+func (m *Model) copyParameterData(v ops.Value) ops.Value {
+	switch value := v.(type) {
+	case tensor.Tensor:
+		if m.sharesParameterData(value) {
+			return copyTensor(value)
 		}
 
-		if err := m.applyOp(op, n, tensors); err != nil {
-			return nil, err
+		return value
+	case ops.Sequence:
+		res := make(ops.Sequence, len(value))
+		for i, element := range value {
+			res[i] = m.copyParameterData(element)
 		}
+
+		return res
+	case ops.Map:
+		res := make(ops.Map, len(value))
+		for key, element := range value {
+			res[key] = m.copyParameterData(element)
+		}
+
+		return res
+	case ops.Optional:
+		return ops.Optional{Element: m.copyParameterData(value.Element)}
+	default:
+		return v
 	}
+}
 
-	outputTensors := make(Tensors)
-	for _, outputName := range m.OutputNames() {
-		outputTensors[outputName] = tensors[outputName]
+// sharesParameterData returns whether the memory of the tensor overlaps with the memory
+// of one of the parameters.
+func (m *Model) sharesParameterData(t tensor.Tensor) bool {
+	if t.MemSize() == 0 {
+		return false
 	}
 
-	return outputTensors, nil
+	start := t.Uintptr()
+	end := start + t.MemSize()
+
+	for _, parameter := range m.parameters {
+		if parameter.MemSize() == 0 {
+			continue
+		}
+
+		parameterStart := parameter.Uintptr()
+		if start < parameterStart+parameter.MemSize() && parameterStart < end {
+			return true
+		}
+	}
+
+	return false
 }
 
-// applyOp applies the operation to the graph.
-func (m *Model) applyOp(op ops.Operator, n *onnx.NodeProto, tensors Tensors) error {
-	if err := op.Init(n); err != nil {
-		return err
+// copyTensor returns a tensor with the same shape and values as t, which has its own data.
+func copyTensor(t tensor.Tensor) tensor.Tensor {
+	dense, ok := t.(*tensor.Dense)
+	if !ok {
+		return t
+	}
+
+	// Materialize copies the data of a view, whereas Clone would copy the view itself.
+	if dense.IsMaterializable() {
+		return dense.Materialize()
+	}
+
+	copied, ok := dense.Clone().(tensor.Tensor)
+	if !ok {
+		return t
+	}
+
+	return copied
+}
+
+// execute executes the steps of the plan one after another. It returns the highest
+// number of bytes held by the values produced by the steps at the same time.
+func (m *Model) execute(ctx context.Context, p *plan, values []ops.Value) (int64, error) {
+	var liveBytes, peakBytes int64
+
+	for _, s := range p.steps {
+		if err := ctx.Err(); err != nil {
+			return 0, ErrRunInterrupted(s.String(), err)
+		}
+
+		if err := m.applyOp(ctx, s, values); err != nil {
+			return 0, err
+		}
+
+		for _, slot := range s.outputs {
+			if slot != noSlot {
+				liveBytes += ops.ValueBytes(values[slot])
+			}
+		}
+
+		peakBytes = max(peakBytes, liveBytes)
+
+		// Drop the values which are not used by any of the next steps, such that their
+		// memory can be reclaimed while the rest of the graph is executed.
+		for _, slot := range s.free {
+			liveBytes -= ops.ValueBytes(values[slot])
+			values[slot] = nil
+		}
+	}
+
+	return peakBytes, nil
+}
+
+// runPlan returns the plan to execute for a run, together with the names of the tensors
+// the run returns. Without options, the compiled plan of the model is used. When only
+// some outputs or also intermediate tensors are requested, the plan is pruned to the
+// nodes needed to compute those tensors.
+func (m *Model) runPlan(config *runConfig) (*plan, []string, error) {
+	if config.outputs == nil && config.intermediates == nil {
+		return m.plan, m.OutputNames(), nil
+	}
+
+	names := m.OutputNames()
+
+	if config.outputs != nil {
+		names = make([]string, 0, len(config.outputs)+len(config.intermediates))
+
+		for _, name := range config.outputs {
+			if !m.hasOutput(name) {
+				return nil, nil, ErrModel("output %v does not exist", name)
+			}
+
+			names = append(names, name)
+		}
 	}
 
-	inputTensors, err := getInputTensorsForNode(n.GetInput(), tensors)
+	for _, name := range config.intermediates {
+		if _, ok := m.plan.slots[name]; !ok {
+			return nil, nil, ErrModel("tensor %v does not exist in the graph", name)
+		}
+
+		names = append(names, name)
+	}
+
+	return m.plan.prune(names), names, nil
+}
+
+// PeakTensorBytes returns the highest number of bytes held at the same time by the
+// tensors produced during a single run of the model, over all runs so far. Tensors are
+// dropped as soon as no operator needs them anymore, so this is the peak memory used by
+// the intermediate and output tensors. The inputs and parameters are not included.
+// Tensors sharing their data, like the result of a Reshape, are counted separately.
+func (m *Model) PeakTensorBytes() int64 {
+	return m.peakTensorBytes.Load()
+}
+
+// storeMax stores n in v if n is larger than the value of v.
+func storeMax(v *atomic.Int64, n int64) {
+	for {
+		current := v.Load()
+		if n <= current || v.CompareAndSwap(current, n) {
+			return
+		}
+	}
+}
+
+// applyOp applies the operation of a single step of the plan. Operators that support it
+// are given the context, so they can be interrupted while they are applied.
+func (m *Model) applyOp(ctx context.Context, s *step, values []ops.Value) error {
+	inputValues, err := getInputValuesForStep(s, values)
 	if err != nil {
 		return err
 	}
 
-	inputTensors, err = op.ValidateInputs(inputTensors)
+	if m.observer == nil {
+		outputValues, err := applyStep(ctx, s, inputValues)
+		if err != nil {
+			return err
+		}
+
+		return setOutputValuesOfStep(s, outputValues, values)
+	}
+
+	event := &NodeEvent{
+		Node:        s.node,
+		Operator:    s.op,
+		Inputs:      valueTensors(inputValues),
+		InputValues: inputValues,
+	}
+
+	ctx = m.observer.BeforeNode(ctx, event)
+	start := time.Now()
+
+	event.OutputValues, event.Err = applyStep(ctx, s, inputValues)
+	event.Outputs = valueTensors(event.OutputValues)
+	event.Duration = time.Since(start)
+
+	m.observer.AfterNode(ctx, event)
+
+	if event.Err != nil {
+		return event.Err
+	}
+
+	return setOutputValuesOfStep(s, event.OutputValues, values)
+}
+
+// applyStep validates the input values of the step and applies its operator on them.
+// Value operators are given the values as they are, while the inputs of every other
+// operator must be tensors.
+func applyStep(ctx context.Context, s *step, inputValues []ops.Value) ([]ops.Value, error) {
+	if op, ok := s.op.(ops.ValueOperator); ok {
+		inputValues, err := op.ValidateValues(inputValues)
+		if err != nil {
+			return nil, err
+		}
+
+		return op.ApplyValues(inputValues)
+	}
+
+	inputTensors := make([]tensor.Tensor, len(inputValues))
+
+	for i, inputValue := range inputValues {
+		if inputValue == nil {
+			continue
+		}
+
+		inputTensor, ok := inputValue.(tensor.Tensor)
+		if !ok {
+			return nil, ErrModel("input %v of %v is not a tensor", s.node.GetInput()[i], s)
+		}
+
+		inputTensors[i] = inputTensor
+	}
+
+	outputTensors, err := applyTensorStep(ctx, s, inputTensors)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	outputValues := make([]ops.Value, len(outputTensors))
+	for i, outputTensor := range outputTensors {
+		outputValues[i] = outputTensor
 	}
 
-	outputTensors, err := op.Apply(inputTensors)
+	return outputValues, nil
+}
+
+// applyTensorStep validates the input tensors of the step and applies its operator on
+// them. Operators which do not support half precision inputs compute them in float32,
+// after which the float32 outputs following those inputs are converted back to half
+// precision.
+func applyTensorStep(ctx context.Context, s *step, inputTensors []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputTensors, halfInputs, err := upcastHalfInputs(s.op, inputTensors)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	inputTensors, err = s.op.ValidateInputs(inputTensors)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputTensors []tensor.Tensor
+
+	if op, ok := s.op.(ops.ContextOperator); ok {
+		outputTensors, err = op.ApplyContext(ctx, inputTensors)
+	} else {
+		outputTensors, err = s.op.Apply(inputTensors)
 	}
 
-	return setOutputTensorsOfNode(n.GetOutput(), outputTensors, tensors)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			return nil, ErrRunInterrupted(s.String(), err)
+		}
+
+		return nil, err
+	}
+
+	if halfInputs != nil {
+		return downcastOutputs(s.op, outputTensors, halfInputs)
+	}
+
+	return outputTensors, nil
 }
 
 // validateShapes validates if the tensors passed in have the same shape as the shapes defined
-// by the onnx.Shapes.
-func (m *Model) validateShapes(inputTensors Tensors) error {
-	for name, shapeExpected := range m.InputShapes() {
+// by the onnx.Shapes. Only the inputs needed by the plan are validated.
+func (m *Model) validateShapes(p *plan, inputs Values) error {
+	inputShapes := m.InputShapes()
+
+	for _, name := range p.inputs {
 		// If the input is a parameter, the user does not have to provide a tensor for it.
 		if _, ok := m.parameters[name]; ok {
 			continue
 		}
 
-		tensor, ok := inputTensors[name]
+		// Inputs without a shape in the graph can not be validated.
+		shapeExpected, ok := inputShapes[name]
+		if !ok {
+			continue
+		}
+
+		input, ok := inputs[name]
 		if !ok {
 			return ErrModel("tensor: %v not found", name)
 		}
 
-		shapeReceived := tensor.Shape()
+		inputTensor, ok := input.(tensor.Tensor)
+		if !ok {
+			return ErrModel("input %v is not a tensor", name)
+		}
+
+		shapeReceived := inputTensor.Shape()
 
 		if len(shapeReceived) != len(shapeExpected) {
 			return ErrInvalidShape(shapeExpected, shapeReceived)
@@ -245,36 +731,50 @@ func (m *Model) validateShapes(inputTensors Tensors) error {
 	return nil
 }
 
-func getInputTensorsForNode(names []string, tensors Tensors) ([]tensor.Tensor, error) {
-	var inputTensors []tensor.Tensor
+func getInputValuesForStep(s *step, values []ops.Value) ([]ops.Value, error) {
+	inputValues := make([]ops.Value, len(s.inputs))
 
-	for _, tensorName := range names {
-		// An empty name can happen in between optional inputs, like:
-		//   [<required_input>, <optional_input>, nil, <optional_input>]
-		// In such a case, ONNX includes the name of the input in the node, and we need
-		// to set a value (nil) for it, although it will not be used.
-		if tensorName == "" {
-			inputTensors = append(inputTensors, nil)
-		} else if tensor, ok := tensors[tensorName]; ok {
-			inputTensors = append(inputTensors, tensor)
-		} else {
-			return nil, ErrModel("no tensor yet for name %v", tensorName)
+	for i, slot := range s.inputs {
+		if slot == noSlot {
+			continue
 		}
+
+		if values[slot] == nil {
+			return nil, ErrModel("no tensor yet for name %v", s.node.GetInput()[i])
+		}
+
+		inputValues[i] = values[slot]
 	}
 
-	return inputTensors, nil
+	return inputValues, nil
 }
 
-func setOutputTensorsOfNode(
-	names []string, outputTensors []tensor.Tensor, tensors Tensors,
-) error {
-	if len(names) != len(outputTensors) {
+func setOutputValuesOfStep(s *step, outputValues []ops.Value, values []ops.Value) error {
+	if len(s.outputs) != len(outputValues) {
 		return ErrModel("could not set output tensor")
 	}
 
-	for i, tensor := range outputTensors {
-		tensors[names[i]] = tensor
+	// The values of skipped outputs are dropped right away.
+	for i, value := range outputValues {
+		if s.outputs[i] != noSlot {
+			values[s.outputs[i]] = value
+		}
 	}
 
 	return nil
 }
+
+// valueTensors returns the tensors of a list of values, where the values which are not
+// tensors are nil.
+func valueTensors(values []ops.Value) []tensor.Tensor {
+	if values == nil {
+		return nil
+	}
+
+	tensors := make([]tensor.Tensor, len(values))
+	for i, value := range values {
+		tensors[i], _ = value.(tensor.Tensor)
+	}
+
+	return tensors
+}
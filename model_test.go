@@ -1,10 +1,26 @@
 package gonnx
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"testing/fstest"
+	"time"
+	"unsafe"
 
 	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"gorgonia.org/tensor"
 )
 
@@ -150,6 +166,356 @@ func TestModelIOUtil(t *testing.T) {
 	assert.False(t, model.hasInput("fail"))
 }
 
+func TestNewModelFromFileExternalData(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "weights"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "weights", "w.bin"), externalDataFixture(), 0o600))
+
+	bytesModel, err := proto.Marshal(externalModelFixture(2, "weights/w.bin", "4", "8"))
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "model.onnx"), bytesModel, 0o600))
+
+	model, err := NewModelFromFile(filepath.Join(dir, "model.onnx"))
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{1, 2}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{4, 7}, outputs["y"].Data())
+}
+
+func TestNewModelFromFS(t *testing.T) {
+	tests := []struct {
+		location string
+		offset   string
+		length   string
+		expected []float32
+		err      error
+	}{
+		{"w.bin", "4", "8", []float32{3, 5}, nil},
+		{"./w.bin", "4", "", []float32{3, 5, 4}, nil},
+		{"w.bin", "", "8", []float32{1, 3}, nil},
+		{"w.bin", "8", "12", nil, io.ErrUnexpectedEOF},
+		{"missing.bin", "", "", nil, fs.ErrNotExist},
+		{"../w.bin", "", "", nil, onnx.ErrExternalData},
+		{"", "", "", nil, onnx.ErrExternalData},
+		{"w.bin", "a", "", nil, onnx.ErrExternalData},
+	}
+
+	for _, test := range tests {
+		bytesModel, err := proto.Marshal(externalModelFixture(int64(len(test.expected)), test.location, test.offset, test.length))
+		assert.Nil(t, err)
+
+		fsys := fstest.MapFS{
+			"models/model.onnx": {Data: bytesModel},
+			"models/w.bin":      {Data: externalDataFixture()},
+		}
+
+		model, err := NewModelFromFS(fsys, "models/model.onnx")
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err)
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		x := make([]float32, len(test.expected))
+		outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{len(x)}}, [][]float32{x}))
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, outputs["y"].Data())
+	}
+}
+
+func TestNewModelWithMappedParameters(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "w.bin"), externalDataFixture(), 0o600))
+
+	bytesModel, err := proto.Marshal(externalModelFixture(2, "w.bin", "4", "8"))
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "model.onnx"), bytesModel, 0o600))
+
+	model, err := NewModelFromFile(filepath.Join(dir, "model.onnx"), WithMappedParameters())
+	assert.Nil(t, err)
+
+	rawData := model.mp.Graph.GetInitializer()[0].GetRawData()
+	assert.Equal(t, onnx.TensorProto_DEFAULT, model.mp.Graph.GetInitializer()[0].GetDataLocation())
+	assert.Equal(t, []float32{3, 5}, model.parameters["w"].Data())
+	assert.Equal(t, uintptr(unsafe.Pointer(&rawData[0])), model.parameters["w"].Uintptr())
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{1, 2}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{4, 7}, outputs["y"].Data())
+
+	assert.Nil(t, model.Close())
+	assert.Nil(t, model.Close())
+}
+
+func TestNewModelWithMappedParametersFromFS(t *testing.T) {
+	bytesModel, err := proto.Marshal(externalModelFixture(2, "w.bin", "4", "8"))
+	assert.Nil(t, err)
+
+	fsys := fstest.MapFS{
+		"model.onnx": {Data: bytesModel},
+		"w.bin":      {Data: externalDataFixture()},
+	}
+
+	model, err := NewModelFromFS(fsys, "model.onnx", WithMappedParameters())
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{3, 5}, model.parameters["w"].Data())
+	assert.Nil(t, model.Close())
+
+	bytesModel, err = proto.Marshal(externalModelFixture(2, "w.bin", "12", "8"))
+	assert.Nil(t, err)
+
+	fsys["model.onnx"] = &fstest.MapFile{Data: bytesModel}
+
+	model, err = NewModelFromFS(fsys, "model.onnx", WithMappedParameters())
+	assert.Nil(t, model)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestNewModelWithMappedRawData(t *testing.T) {
+	mp := externalModelFixture(4, "", "", "")
+	mp.Graph.Initializer[0].DataLocation = onnx.TensorProto_DEFAULT
+	mp.Graph.Initializer[0].ExternalData = nil
+	mp.Graph.Initializer[0].RawData = externalDataFixture()
+
+	model, err := NewModel(mp, WithMappedParameters())
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 3, 5, 4}, model.parameters["w"].Data())
+	assert.Equal(t, uintptr(unsafe.Pointer(&mp.Graph.Initializer[0].RawData[0])), model.parameters["w"].Uintptr())
+
+	model, err = NewModel(mp)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 3, 5, 4}, model.parameters["w"].Data())
+	assert.NotEqual(t, uintptr(unsafe.Pointer(&mp.Graph.Initializer[0].RawData[0])), model.parameters["w"].Uintptr())
+}
+
+func TestNewModelExternalDataNotLoaded(t *testing.T) {
+	model, err := NewModel(externalModelFixture(2, "w.bin", "", ""))
+
+	assert.Nil(t, model)
+	assert.ErrorIs(t, err, onnx.ErrExternalData)
+}
+
+func TestNewModelSparseInitializer(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y"},
+		[]*onnx.NodeProto{{Name: "add", OpType: "Add", Input: []string{"x", "w"}, Output: []string{"y"}}},
+	)
+	mp.Graph.SparseInitializer = []*onnx.SparseTensorProto{{
+		Values:  &onnx.TensorProto{Name: "w", DataType: int32(onnx.TensorProto_FLOAT), Dims: []int64{2}, FloatData: []float32{3, 5}},
+		Indices: &onnx.TensorProto{DataType: int32(onnx.TensorProto_INT64), Dims: []int64{2, 2}, Int64Data: []int64{0, 1, 1, 2}},
+		Dims:    []int64{2, 3},
+	}}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"w"}, model.ParamNames())
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2, 3}}, [][]float32{rangeFloat(6)}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{0, 4, 2, 3, 4, 10}, outputs["y"].Data())
+}
+
+func TestModelStringTensors(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y", "z"},
+		[]*onnx.NodeProto{
+			{Name: "gather", OpType: "Gather", Input: []string{"vocab", "x"}, Output: []string{"words"}},
+			{Name: "equal", OpType: "Equal", Input: []string{"words", "word"}, Output: []string{"y"}},
+			{Name: "cast", OpType: "Cast", Input: []string{"words"}, Output: []string{"z"}, Attribute: []*onnx.AttributeProto{{Name: "to", I: 1}}},
+		},
+	)
+	mp.Graph.Initializer = []*onnx.TensorProto{
+		{Name: "vocab", DataType: int32(onnx.TensorProto_STRING), Dims: []int64{3}, StringData: [][]byte{[]byte("1.5"), []byte("2"), []byte("-3")}},
+		{Name: "word", DataType: int32(onnx.TensorProto_STRING), Dims: []int64{1}, StringData: [][]byte{[]byte("2")}},
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{"x": tensor.New(tensor.WithShape(3), tensor.WithBacking([]int64{1, 2, 1}))})
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false, true}, outputs["y"].Data())
+	assert.Equal(t, []float32{2, -3, 2}, outputs["z"].Data())
+}
+
+func TestModelQuantizedTypes(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y", "z", "u"},
+		[]*onnx.NodeProto{
+			{Name: "dequantize", OpType: "DequantizeLinear", Input: []string{"w", "scale"}, Output: []string{"dequantized"}},
+			{Name: "add", OpType: "Add", Input: []string{"x", "dequantized"}, Output: []string{"y"}},
+			{Name: "cast_f8", OpType: "Cast", Input: []string{"f8"}, Output: []string{"z"}, Attribute: []*onnx.AttributeProto{{Name: "to", I: 1}}},
+			{Name: "cast_u4", OpType: "Cast", Input: []string{"u4"}, Output: []string{"u"}, Attribute: []*onnx.AttributeProto{{Name: "to", I: 1}}},
+		},
+	)
+	mp.OpsetImport[0].Version = 21
+	mp.Graph.Initializer = []*onnx.TensorProto{
+		{Name: "w", DataType: int32(onnx.TensorProto_INT4), Dims: []int64{2, 3}, RawData: []byte{0x78, 0xf1, 0x30}},
+		{Name: "scale", DataType: int32(onnx.TensorProto_FLOAT), FloatData: []float32{0.5}},
+		{Name: "f8", DataType: int32(onnx.TensorProto_FLOAT8E4M3FN), Dims: []int64{3}, Int32Data: []int32{0x38, 0xc4, 0x01}},
+		{Name: "u4", DataType: int32(onnx.TensorProto_UINT4), Dims: []int64{3}, RawData: []byte{0x21, 0x0f}},
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{"x": tensor.New(tensor.WithShape(2, 3), tensor.WithBacking([]float32{1, 1, 1, 1, 1, 1}))})
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{-3, 4.5, 1.5, 0.5, 1, 2.5}, outputs["y"].Data())
+	assert.Equal(t, []float32{1, -3, 0.001953125}, outputs["z"].Data())
+	assert.Equal(t, []float32{1, 2, 15}, outputs["u"].Data())
+
+	mp.Graph.Initializer[3].RawData = []byte{0x21}
+
+	_, err = NewModel(mp)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestModelRunValues(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"sequence", "length", "last", "stacked"},
+		[]*onnx.NodeProto{
+			{
+				Name: "split", OpType: "SplitToSequence", Input: []string{"x"}, Output: []string{"sequence"},
+				Attribute: []*onnx.AttributeProto{{Name: "axis", I: 1}, {Name: "keepdims", I: 0}},
+			},
+			{Name: "length", OpType: "SequenceLength", Input: []string{"sequence"}, Output: []string{"length"}},
+			{Name: "at", OpType: "SequenceAt", Input: []string{"sequence", "position"}, Output: []string{"at"}},
+			{Name: "relu", OpType: "Relu", Input: []string{"at"}, Output: []string{"last"}},
+			{
+				Name: "concat", OpType: "ConcatFromSequence", Input: []string{"sequence"}, Output: []string{"stacked"},
+				Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}, {Name: "new_axis", I: 1}},
+			},
+		},
+	)
+	mp.Graph.Initializer = []*onnx.TensorProto{
+		{Name: "position", DataType: int32(onnx.TensorProto_INT64), Int64Data: []int64{-1}},
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	x := tensor.New(tensor.WithShape(2, 3), tensor.WithBacking([]float32{-1, 2, -3, 4, -5, 6}))
+
+	outputs, err := model.RunValues(Values{"x": x})
+	assert.Nil(t, err)
+
+	sequence, ok := outputs["sequence"].(ops.Sequence)
+	assert.True(t, ok)
+	assert.Equal(t, 3, len(sequence))
+	assert.Equal(t, []float32{-1, 4}, sequence[0].(tensor.Tensor).Data())
+	assert.Equal(t, int64(3), outputs["length"].(tensor.Tensor).Data())
+	assert.Equal(t, []float32{0, 6}, outputs["last"].(tensor.Tensor).Data())
+	assert.Equal(t, tensor.Shape{3, 2}, outputs["stacked"].(tensor.Tensor).Shape())
+	assert.Equal(t, []float32{-1, 4, 2, -5, -3, 6}, outputs["stacked"].(tensor.Tensor).Data())
+
+	// Run only returns tensors, which is fine as long as no sequence is requested.
+	_, err = model.Run(Tensors{"x": x})
+	assert.Equal(t, ErrModel("output sequence is not a tensor, use RunValues to get it"), err)
+
+	tensors, err := model.Run(Tensors{"x": x}, WithOutputs("stacked"))
+	assert.Nil(t, err)
+	assert.Equal(t, outputs["stacked"], tensors["stacked"])
+}
+
+func TestModelOutputsDoNotShareParameterData(t *testing.T) {
+	mp := modelProtoFixture(
+		nil,
+		[]string{"w", "reshaped", "sequence"},
+		[]*onnx.NodeProto{
+			{Name: "reshape", OpType: "Reshape", Input: []string{"w", "shape"}, Output: []string{"reshaped"}},
+			{Name: "construct", OpType: "SequenceConstruct", Input: []string{"w"}, Output: []string{"sequence"}},
+		},
+	)
+	mp.Graph.Initializer = []*onnx.TensorProto{
+		{Name: "w", DataType: int32(onnx.TensorProto_FLOAT), Dims: []int64{2, 2}, FloatData: []float32{1, 2, 3, 4}},
+		{Name: "shape", DataType: int32(onnx.TensorProto_INT64), Dims: []int64{1}, Int64Data: []int64{4}},
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.RunValues(Values{})
+	assert.Nil(t, err)
+
+	// Modifying the outputs must not modify the initializer they are derived from.
+	outputs["w"].(tensor.Tensor).Data().([]float32)[0] = 10
+	outputs["reshaped"].(tensor.Tensor).Data().([]float32)[1] = 20
+	outputs["sequence"].(ops.Sequence)[0].(tensor.Tensor).Data().([]float32)[2] = 30
+
+	outputs, err = model.RunValues(Values{})
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2, 3, 4}, outputs["w"].(tensor.Tensor).Data())
+	assert.Equal(t, []float32{1, 2, 3, 4}, outputs["reshaped"].(tensor.Tensor).Data())
+	assert.Equal(t, tensor.Shape{4}, outputs["reshaped"].(tensor.Tensor).Shape())
+	assert.Equal(t, []float32{1, 2, 3, 4}, outputs["sequence"].(ops.Sequence)[0].(tensor.Tensor).Data())
+}
+
+func TestModelSequenceAndOptionalInputs(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"sequence", "optional"},
+		[]string{"y", "has_element", "z"},
+		[]*onnx.NodeProto{
+			{
+				Name: "concat", OpType: "ConcatFromSequence", Input: []string{"sequence"}, Output: []string{"y"},
+				Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}},
+			},
+			{Name: "has", OpType: "OptionalHasElement", Input: []string{"optional"}, Output: []string{"has_element"}},
+			{Name: "wrap", OpType: "Optional", Input: []string{"y"}, Output: []string{"z"}},
+		},
+	)
+	mp.OpsetImport[0].Version = 15
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	a := tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 2}))
+	b := tensor.New(tensor.WithShape(1), tensor.WithBacking([]float32{3}))
+
+	outputs, err := model.RunValues(Values{"sequence": ops.Sequence{a, b}, "optional": ops.Optional{}})
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2, 3}, outputs["y"].(tensor.Tensor).Data())
+	assert.Equal(t, false, outputs["has_element"].(tensor.Tensor).Data())
+	assert.Equal(t, ops.Optional{Element: outputs["y"]}, outputs["z"])
+
+	// Tensor operators do not accept other values.
+	mp.Graph.Node[0] = &onnx.NodeProto{Name: "relu", OpType: "Relu", Input: []string{"sequence"}, Output: []string{"y"}}
+
+	model, err = NewModel(mp)
+	assert.Nil(t, err)
+
+	_, err = model.RunValues(Values{"sequence": ops.Sequence{a, b}, "optional": ops.Optional{}})
+	assert.Equal(t, ErrModel("input sequence of relu (Relu) is not a tensor"), err)
+}
+
+func TestModelZipMap(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y"},
+		[]*onnx.NodeProto{{
+			Name: "zip", OpType: "ZipMap", Domain: DomainML, Input: []string{"x"}, Output: []string{"y"},
+			Attribute: []*onnx.AttributeProto{{Name: "classlabels_strings", Strings: [][]byte{[]byte("no"), []byte("yes")}}},
+		}},
+	)
+	mp.OpsetImport = append(mp.OpsetImport, &onnx.OperatorSetIdProto{Domain: DomainML, Version: 1})
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.RunValues(Values{"x": tensor.New(tensor.WithShape(1, 2), tensor.WithBacking([]float32{0.25, 0.75}))})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.Sequence{ops.Map{
+		"no":  tensor.New(tensor.FromScalar(float32(0.25))),
+		"yes": tensor.New(tensor.FromScalar(float32(0.75))),
+	}}, outputs["y"])
+}
+
 func TestInputDimSize(t *testing.T) {
 	model, err := NewModelFromFile("./sample_models/onnx_models/mlp.onnx")
 	assert.Nil(t, err)
@@ -168,6 +534,405 @@ func TestInputDimSizeInvalidInput(t *testing.T) {
 	assert.Equal(t, ErrModel("input %v does not exist", "swagger"), err)
 }
 
+func TestNewModelReportsInvalidAttributes(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y"},
+		[]*onnx.NodeProto{
+			{
+				Name:      "transpose",
+				OpType:    "Transpose",
+				Input:     []string{"x"},
+				Output:    []string{"y"},
+				Attribute: []*onnx.AttributeProto{{Name: "axes"}},
+			},
+		},
+	)
+
+	model, err := NewModel(mp)
+
+	assert.Nil(t, model)
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", &opset13.Transpose{}), err)
+}
+
+func TestModelRunReusesPlan(t *testing.T) {
+	model, err := NewModelFromFile("./sample_models/onnx_models/mlp.onnx")
+	assert.Nil(t, err)
+
+	steps := model.plan.steps
+	assert.Equal(t, len(model.mp.Graph.GetNode()), len(steps))
+
+	for i := 0; i < 3; i++ {
+		outputs, err := model.Run(tensorsFixture(
+			[]string{"data_input"},
+			[][]int{{2, 3}},
+			[][]float32{rangeFloat(6)},
+		))
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{-0.056310713, -1.1901507, -1.5961288, -3.3445296}, outputs["preds"].Data(), 0.00001)
+	}
+
+	assert.Equal(t, steps, model.plan.steps)
+}
+
+func TestModelPeakTensorBytes(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Abs", Input: []string{"b_out"}, Output: []string{"z"}},
+		},
+	)
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), model.PeakTensorBytes())
+
+	_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{rangeFloat(4)}))
+	assert.Nil(t, err)
+
+	// At most two tensors of 4 float32 values are alive at the same time, because a_out
+	// is dropped after node b and b_out is dropped after node c.
+	assert.Equal(t, int64(32), model.PeakTensorBytes())
+
+	_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{rangeFloat(2)}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(32), model.PeakTensorBytes())
+
+	_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{8}}, [][]float32{rangeFloat(8)}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(64), model.PeakTensorBytes())
+}
+
+func TestModelPeakTensorBytesSkippedOutputs(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y", "z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Split", Input: []string{"x"}, Output: []string{"", "y"}},
+			{Name: "b", OpType: "Split", Input: []string{"x"}, Output: []string{"", "z"}},
+		},
+	)
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{1000}}, [][]float32{rangeFloat(1000)}))
+	assert.Nil(t, err)
+
+	// Skipped outputs are dropped right away, hence only y and z of 500 float32 values
+	// each are alive at the same time.
+	assert.Equal(t, int64(4000), model.PeakTensorBytes())
+}
+
+func TestModelRunWithIntermediates(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Add", Input: []string{"a_out", "b_out"}, Output: []string{"z"}},
+		},
+	)
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	inputs := tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{{-2, -1, 0, 1}})
+
+	outputs, err := model.Run(inputs, WithIntermediates("a_out"), WithIntermediates("b_out"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(outputs))
+	assert.Equal(t, []float32{2, 1, 0, 1}, outputs["a_out"].Data())
+	assert.Equal(t, []float32{2, 1, 0, 1}, outputs["b_out"].Data())
+	assert.Equal(t, []float32{4, 2, 0, 2}, outputs["z"].Data())
+
+	_, err = model.Run(inputs, WithIntermediates("a_out", "unknown"))
+	assert.Equal(t, ErrModel("tensor %v does not exist in the graph", "unknown"), err)
+}
+
+func TestModelRunWithOutputs(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z1", "z2"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"z1"}},
+			{Name: "b", OpType: "Relu", Input: []string{"y"}, Output: []string{"z2"}},
+		},
+	)
+
+	for _, input := range mp.Graph.Input {
+		input.Type = tensorTypeFixture(4)
+	}
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	inputs := tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{{-2, -1, 0, 1}})
+
+	_, err = model.Run(inputs)
+	assert.Equal(t, ErrModel("tensor: %v not found", "y"), err)
+
+	outputs, err := model.Run(inputs, WithOutputs("z1"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(outputs))
+	assert.Equal(t, []float32{2, 1, 0, 1}, outputs["z1"].Data())
+
+	_, err = model.Run(inputs, WithOutputs("z1", "unknown"))
+	assert.Equal(t, ErrModel("output %v does not exist", "unknown"), err)
+}
+
+func TestModelRunContext(t *testing.T) {
+	model, err := NewModelFromFile("./sample_models/onnx_models/gru.onnx")
+	assert.Nil(t, err)
+
+	inputs := func() Tensors {
+		return tensorsFixture(
+			[]string{"data_input", "init_hidden"},
+			[][]int{{1, 30, 3}, {1, 1, 5}},
+			[][]float32{rangeFloat(90), rangeZeros(5)},
+		)
+	}
+
+	outputs, err := model.RunContext(context.Background(), inputs())
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, expectedGruHiddenOut(), outputs["hidden_out"].Data(), 0.00001)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	outputs, err = model.RunContext(canceledCtx, inputs())
+	assert.Nil(t, outputs)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, errModel)
+	assert.Equal(t, ErrRunInterrupted(model.plan.steps[0].String(), context.Canceled), err)
+
+	expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	outputs, err = model.RunContext(expiredCtx, inputs())
+	assert.Nil(t, outputs)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestModelConcurrentRun runs all sample models from many goroutines at the same time,
+// and checks that every run gives the same result as a sequential run and that the
+// parameters of the models are left untouched. Run it with the race detector enabled
+// (make test_race) to check for data races.
+func TestModelConcurrentRun(t *testing.T) {
+	const (
+		nGoroutines = 8
+		nRuns       = 5
+	)
+
+	for name, bytesModel := range sampleModelsFixture(t) {
+		bytesModel := bytesModel
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model, err := NewModelFromBytes(bytesModel)
+			assert.Nil(t, err)
+
+			parameters := make(Tensors, len(model.parameters))
+			for paramName, param := range model.parameters {
+				parameters[paramName] = param.Clone().(tensor.Tensor)
+			}
+
+			expected, err := model.Run(inputsFixture(model))
+			assert.Nil(t, err)
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < nGoroutines; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					for j := 0; j < nRuns; j++ {
+						outputs, err := model.Run(inputsFixture(model))
+						assert.Nil(t, err)
+
+						for outputName, expectedTensor := range expected {
+							assert.Equal(t, expectedTensor.Shape(), outputs[outputName].Shape())
+							assert.Equal(t, expectedTensor.Data(), outputs[outputName].Data())
+						}
+					}
+				}()
+			}
+
+			wg.Wait()
+
+			for paramName, param := range parameters {
+				assert.Equal(t, param.Shape(), model.parameters[paramName].Shape())
+				assert.Equal(t, param.Data(), model.parameters[paramName].Data())
+			}
+		})
+	}
+}
+
+// sampleModelsFixture reads all sample models, including the models stored in zip
+// archives. Models stored with git lfs which have not been fetched are skipped.
+func sampleModelsFixture(t *testing.T) map[string][]byte {
+	t.Helper()
+
+	models := make(map[string][]byte)
+
+	paths, err := filepath.Glob("./sample_models/onnx_models/*.onnx")
+	assert.Nil(t, err)
+
+	for _, path := range paths {
+		bytesModel, err := os.ReadFile(path)
+		assert.Nil(t, err)
+
+		if bytes.HasPrefix(bytesModel, []byte("version https://git-lfs")) {
+			t.Logf("skipping %v, git lfs object was not fetched", path)
+			continue
+		}
+
+		models[filepath.Base(path)] = bytesModel
+	}
+
+	archives, err := filepath.Glob("./sample_models/onnx_models/*.zip")
+	assert.Nil(t, err)
+
+	for _, archive := range archives {
+		reader, err := zip.OpenReader(archive)
+		assert.Nil(t, err)
+
+		for _, file := range reader.File {
+			if filepath.Ext(file.Name) != ".onnx" {
+				continue
+			}
+
+			fc, err := file.Open()
+			assert.Nil(t, err)
+
+			bytesModel, err := io.ReadAll(fc)
+			assert.Nil(t, err)
+			assert.Nil(t, fc.Close())
+
+			models[file.Name] = bytesModel
+		}
+
+		assert.Nil(t, reader.Close())
+	}
+
+	return models
+}
+
+// inputsFixture creates float32 tensors for all inputs of a model. Dynamic dimensions
+// are given size 2.
+func inputsFixture(model *Model) Tensors {
+	const dynamicDimSize = 2
+
+	inputs := make(Tensors)
+
+	for name, shape := range model.InputShapes() {
+		if _, ok := model.parameters[name]; ok {
+			continue
+		}
+
+		dims := make([]int, len(shape))
+		for i, dim := range shape {
+			dims[i] = int(dim.Size)
+			if dim.IsDynamic {
+				dims[i] = dynamicDimSize
+			}
+		}
+
+		inputs[name] = tensor.New(
+			tensor.WithShape(dims...),
+			tensor.WithBacking(rangeFloat(ops.NElements(dims...))),
+		)
+	}
+
+	return inputs
+}
+
+// modelProtoFixture creates a model proto for opset 13 with a graph containing the given
+// nodes. The inputs and outputs of the graph have no type information, hence their shapes
+// are not validated when the model is run.
+func modelProtoFixture(inputs, outputs []string, nodes []*onnx.NodeProto) *onnx.ModelProto {
+	graph := &onnx.GraphProto{Node: nodes}
+
+	for _, input := range inputs {
+		graph.Input = append(graph.Input, &onnx.ValueInfoProto{Name: input})
+	}
+
+	for _, output := range outputs {
+		graph.Output = append(graph.Output, &onnx.ValueInfoProto{Name: output})
+	}
+
+	return &onnx.ModelProto{
+		OpsetImport: []*onnx.OperatorSetIdProto{{Version: 13}},
+		Graph:       graph,
+	}
+}
+
+// externalModelFixture creates a model which adds its input to a float32 initializer of
+// the given size, stored as external data with the given location, offset and length.
+func externalModelFixture(size int64, location, offset, length string) *onnx.ModelProto {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y"},
+		[]*onnx.NodeProto{{Name: "add", OpType: "Add", Input: []string{"x", "w"}, Output: []string{"y"}}},
+	)
+
+	entries := []*onnx.StringStringEntryProto{{Key: "location", Value: location}}
+	if offset != "" {
+		entries = append(entries, &onnx.StringStringEntryProto{Key: "offset", Value: offset})
+	}
+
+	if length != "" {
+		entries = append(entries, &onnx.StringStringEntryProto{Key: "length", Value: length})
+	}
+
+	mp.Graph.Initializer = []*onnx.TensorProto{{
+		Name:         "w",
+		Dims:         []int64{size},
+		DataType:     int32(onnx.TensorProto_FLOAT),
+		DataLocation: onnx.TensorProto_EXTERNAL,
+		ExternalData: entries,
+	}}
+
+	return mp
+}
+
+// externalDataFixture returns the little endian bytes of the float32 values 1, 3, 5 and 4.
+func externalDataFixture() []byte {
+	data := make([]byte, 0, 16)
+	for _, v := range []float32{1, 3, 5, 4} {
+		data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v))
+	}
+
+	return data
+}
+
+// tensorTypeFixture creates the type of a tensor with a fixed shape, used to give the
+// inputs of a model proto a shape.
+func tensorTypeFixture(shape ...int64) *onnx.TypeProto {
+	dims := make([]*onnx.TensorShapeProto_Dimension, len(shape))
+	for i, size := range shape {
+		dims[i] = &onnx.TensorShapeProto_Dimension{
+			Value: &onnx.TensorShapeProto_Dimension_DimValue{DimValue: size},
+		}
+	}
+
+	return &onnx.TypeProto{
+		Value: &onnx.TypeProto_TensorType{
+			TensorType: &onnx.TypeProto_Tensor{
+				ElemType: int32(onnx.TensorProto_FLOAT),
+				Shape:    &onnx.TensorShapeProto{Dim: dims},
+			},
+		},
+	}
+}
+
 // tensorsFixture creates Tensors with the given names shapes and backings. This is useful for
 // providing a model with inputs and checking it's outputs.
 func tensorsFixture(names []string, shapes [][]int, backing [][]float32) Tensors {
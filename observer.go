@@ -0,0 +1,60 @@
+package gonnx
+
+import (
+	"context"
+	"time"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+// NodeEvent describes the execution of a single node of the graph. It is passed to the
+// observer of a model before and after the node is executed.
+type NodeEvent struct {
+	// Node is the node of the graph that is executed.
+	Node *onnx.NodeProto
+
+	// Operator is the initialized operator of the node.
+	Operator ops.Operator
+
+	// Inputs holds a tensor for every input of the node, in the same order as the inputs
+	// of the node. Optional inputs which are skipped, and inputs which are not tensors,
+	// like sequences, are nil.
+	Inputs []tensor.Tensor
+
+	// InputValues holds the value of every input of the node, including the values which
+	// are not tensors.
+	InputValues []ops.Value
+
+	// Outputs holds the tensors produced by the node. It is only set after the node is
+	// executed, and is nil if the execution failed. Outputs which are not tensors are nil.
+	Outputs []tensor.Tensor
+
+	// OutputValues holds the values produced by the node, including the values which are
+	// not tensors. It is set together with Outputs.
+	OutputValues []ops.Value
+
+	// Duration is the wall-clock time it took to execute the node. It is only set after
+	// the node is executed.
+	Duration time.Duration
+
+	// Err is the error returned while executing the node, if any. It is only set after
+	// the node is executed.
+	Err error
+}
+
+// Observer is called before and after every node of the graph is executed, which is
+// useful for tracing, profiling and debugging. The tensors in the events are the tensors
+// used by the run, so observers must not modify them. Observers are called from every
+// goroutine running the model, hence they must be safe for concurrent use.
+type Observer interface {
+	// BeforeNode is called right before a node is executed. The returned context is used
+	// to execute the node and is passed to AfterNode, which allows for creating a span
+	// for the node when tracing.
+	BeforeNode(ctx context.Context, event *NodeEvent) context.Context
+
+	// AfterNode is called after the node is executed, also when the execution failed. The
+	// event is the same as the one given to BeforeNode, with the results filled in.
+	AfterNode(ctx context.Context, event *NodeEvent)
+}
@@ -0,0 +1,128 @@
+package gonnx
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+type observerKey struct{}
+
+// recordingObserver records the names of the nodes in the order in which it is called.
+type recordingObserver struct {
+	mu     sync.Mutex
+	calls  []string
+	events []*NodeEvent
+}
+
+func (o *recordingObserver) BeforeNode(ctx context.Context, event *NodeEvent) context.Context {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.calls = append(o.calls, "before "+event.Node.GetName())
+
+	return context.WithValue(ctx, observerKey{}, event.Node.GetName())
+}
+
+func (o *recordingObserver) AfterNode(ctx context.Context, event *NodeEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name, _ := ctx.Value(observerKey{}).(string)
+
+	o.calls = append(o.calls, "after "+name)
+	o.events = append(o.events, event)
+}
+
+func TestModelObserver(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"z"}},
+		},
+	)
+
+	observer := &recordingObserver{}
+
+	model, err := NewModel(mp, WithObserver(observer))
+	assert.Nil(t, err)
+
+	_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{{-2, -1, 0, 1}}))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"before a", "after a", "before b", "after b"}, observer.calls)
+	assert.Equal(t, 2, len(observer.events))
+
+	event := observer.events[0]
+	assert.Equal(t, mp.Graph.Node[0], event.Node)
+	assert.IsType(t, &opset13.Abs{}, event.Operator)
+	assert.Equal(t, []float32{-2, -1, 0, 1}, event.Inputs[0].Data())
+	assert.Equal(t, []float32{2, 1, 0, 1}, event.Outputs[0].Data())
+	assert.Greater(t, event.Duration.Nanoseconds(), int64(0))
+	assert.Nil(t, event.Err)
+}
+
+func TestModelObserverError(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Add", Input: []string{"x", "y"}, Output: []string{"z"}},
+		},
+	)
+
+	observer := &recordingObserver{}
+
+	model, err := NewModel(mp, WithObserver(observer))
+	assert.Nil(t, err)
+
+	_, err = model.Run(tensorsFixture(
+		[]string{"x", "y"},
+		[][]int{{4}, {3}},
+		[][]float32{rangeFloat(4), rangeFloat(3)},
+	))
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []string{"before a", "after a"}, observer.calls)
+	assert.Equal(t, err, observer.events[0].Err)
+	assert.Nil(t, observer.events[0].Outputs)
+}
+
+func TestModelObserverValues(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "SequenceConstruct", Input: []string{"x", "x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "SequenceLength", Input: []string{"a_out"}, Output: []string{"z"}},
+		},
+	)
+
+	observer := &recordingObserver{}
+
+	model, err := NewModel(mp, WithObserver(observer))
+	assert.Nil(t, err)
+
+	x := tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{1, 2}})["x"]
+
+	_, err = model.Run(Tensors{"x": x})
+	assert.Nil(t, err)
+
+	event := observer.events[0]
+	assert.Equal(t, []ops.Value{x, x}, event.InputValues)
+	assert.Equal(t, []tensor.Tensor{x, x}, event.Inputs)
+	assert.Equal(t, []ops.Value{ops.Sequence{x, x}}, event.OutputValues)
+	assert.Equal(t, []tensor.Tensor{nil}, event.Outputs)
+
+	event = observer.events[1]
+	assert.Equal(t, []tensor.Tensor{nil}, event.Inputs)
+	assert.Equal(t, int64(2), event.Outputs[0].Data())
+}
@@ -0,0 +1,188 @@
+package onnx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"path"
+	"strconv"
+)
+
+// ErrExternalData is returned when the external data of a tensor cannot be loaded.
+var ErrExternalData = errors.New("invalid external data")
+
+// ExternalData describes where the data of a tensor is stored outside of the model.
+// The location is relative to the directory of the model. A negative length means the
+// data runs until the end of the file.
+type ExternalData struct {
+	Location string
+	Offset   int64
+	Length   int64
+}
+
+// IsExternal returns whether the data of the tensor is stored in an external file.
+func (x *TensorProto) IsExternal() bool {
+	return x.GetDataLocation() == TensorProto_EXTERNAL
+}
+
+// GetExternalDataInfo parses the external data entries of the tensor.
+func (x *TensorProto) GetExternalDataInfo() (ExternalData, error) {
+	info := ExternalData{Length: -1}
+
+	for _, entry := range x.GetExternalData() {
+		var err error
+
+		switch entry.GetKey() {
+		case "location":
+			info.Location = entry.GetValue()
+		case "offset":
+			info.Offset, err = strconv.ParseInt(entry.GetValue(), 10, 64)
+		case "length":
+			info.Length, err = strconv.ParseInt(entry.GetValue(), 10, 64)
+		}
+
+		if err != nil {
+			return info, fmt.Errorf("%w: %v of tensor %v: %w", ErrExternalData, entry.GetKey(), x.GetName(), err)
+		}
+	}
+
+	if info.Location == "" {
+		return info, fmt.Errorf("%w: tensor %v has no location", ErrExternalData, x.GetName())
+	}
+
+	if info.Offset < 0 {
+		return info, fmt.Errorf("%w: tensor %v has a negative offset", ErrExternalData, x.GetName())
+	}
+
+	return info, nil
+}
+
+// LoadExternalData reads the external data of the tensor from the file system and
+// stores it as raw data, after which the tensor no longer refers to external data.
+// Tensors which are not external are left untouched.
+func (x *TensorProto) LoadExternalData(fsys fs.FS) error {
+	if !x.IsExternal() {
+		return nil
+	}
+
+	info, location, err := x.externalLocation()
+	if err != nil {
+		return err
+	}
+
+	data, err := readExternalData(fsys, location, info.Offset, info.Length)
+	if err != nil {
+		return fmt.Errorf("%w: tensor %v: %w", ErrExternalData, x.GetName(), err)
+	}
+
+	x.setRawData(data)
+
+	return nil
+}
+
+// externalLocation returns the external data info of the tensor, together with the
+// cleaned path of its location.
+func (x *TensorProto) externalLocation() (ExternalData, string, error) {
+	info, err := x.GetExternalDataInfo()
+	if err != nil {
+		return info, "", err
+	}
+
+	// ONNX always uses forward slashes in locations. Valid paths of a file system cannot
+	// escape its root, hence neither can the location.
+	location := path.Clean(info.Location)
+	if !fs.ValidPath(location) {
+		return info, "", fmt.Errorf("%w: location %v of tensor %v is outside of the model directory", ErrExternalData, info.Location, x.GetName())
+	}
+
+	return info, location, nil
+}
+
+// setRawData replaces the external data of the tensor by the given raw data.
+func (x *TensorProto) setRawData(data []byte) {
+	x.RawData = data
+	x.ExternalData = nil
+	x.DataLocation = TensorProto_DEFAULT
+}
+
+// LoadExternalData loads the external data of all tensors in the graph, which are the
+// initializers and the tensors in the attributes of the nodes, including sparse tensors.
+func (g *GraphProto) LoadExternalData(fsys fs.FS) error {
+	for _, tp := range g.tensors() {
+		if err := tp.LoadExternalData(fsys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tensors returns all tensors stored in the graph, which are the initializers and the
+// tensors in the attributes of the nodes. The values and indices of sparse tensors are
+// included as well.
+func (g *GraphProto) tensors() []*TensorProto {
+	tensors := append([]*TensorProto{}, g.GetInitializer()...)
+	tensors = appendSparseTensors(tensors, g.GetSparseInitializer()...)
+
+	for _, n := range g.GetNode() {
+		for _, attr := range n.GetAttribute() {
+			if attr.GetT() != nil {
+				tensors = append(tensors, attr.GetT())
+			}
+
+			tensors = append(tensors, attr.GetTensors()...)
+
+			if attr.GetSparseTensor() != nil {
+				tensors = appendSparseTensors(tensors, attr.GetSparseTensor())
+			}
+
+			tensors = appendSparseTensors(tensors, attr.GetSparseTensors()...)
+		}
+	}
+
+	return tensors
+}
+
+func appendSparseTensors(tensors []*TensorProto, sparseTensors ...*SparseTensorProto) []*TensorProto {
+	for _, sp := range sparseTensors {
+		tensors = append(tensors, sp.GetValues(), sp.GetIndices())
+	}
+
+	return tensors
+}
+
+func readExternalData(fsys fs.FS, name string, offset, length int64) ([]byte, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+
+	switch s := f.(type) {
+	case io.ReaderAt:
+		r = io.NewSectionReader(s, offset, math.MaxInt64-offset)
+	case io.Seeker:
+		if _, err := s.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+	default:
+		if _, err := io.CopyN(io.Discard, f, offset); err != nil {
+			return nil, err
+		}
+	}
+
+	if length < 0 {
+		return io.ReadAll(r)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,185 @@
+package onnx
+
+import (
+	"math"
+	"reflect"
+
+	"gorgonia.org/tensor"
+)
+
+// Float16 is an IEEE 754 half precision floating point number, stored as its bits.
+type Float16 uint16
+
+// BFloat16 is a brain floating point number, which has the exponent of a float32 and a
+// mantissa of 7 bits. It is stored as its bits, which are the upper bits of a float32.
+type BFloat16 uint16
+
+var (
+	// Float16Dtype is the dtype of tensors with Float16 values.
+	Float16Dtype = tensor.Dtype{Type: reflect.TypeOf(Float16(0))}
+
+	// BFloat16Dtype is the dtype of tensors with BFloat16 values.
+	BFloat16Dtype = tensor.Dtype{Type: reflect.TypeOf(BFloat16(0))}
+)
+
+const (
+	float32ExponentBias = 127
+	float16ExponentBias = 15
+	float16MantissaBits = 10
+	float32MantissaBits = 23
+	float16Exponent     = 0x1f
+	float16Mantissa     = 0x3ff
+	float16Sign         = 0x8000
+	float16NaN          = 0x7e00
+	float16Inf          = 0x7c00
+	bfloat16NaN         = 0x7fc0
+	halfShift           = 16
+	float32ExponentMask = 0xff
+	float32MantissaMask = 0x7fffff
+	mantissaShift       = float32MantissaBits - float16MantissaBits
+)
+
+// Float16FromFloat32 converts a float32 to the nearest Float16, where ties are rounded to
+// even. Values which are too large become infinite.
+func Float16FromFloat32(f float32) Float16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>halfShift) & float16Sign
+	exponent := int((bits>>float32MantissaBits)&float32ExponentMask) - float32ExponentBias + float16ExponentBias
+	mantissa := bits & float32MantissaMask
+
+	switch {
+	case math.IsNaN(float64(f)):
+		return Float16(sign | float16NaN)
+	case exponent >= float16Exponent:
+		return Float16(sign | float16Inf)
+	case exponent <= 0:
+		// The value is subnormal in half precision, or rounds to zero. The implicit leading
+		// bit of the mantissa becomes explicit.
+		if exponent < -float16MantissaBits {
+			return Float16(sign)
+		}
+
+		mantissa |= 1 << float32MantissaBits
+		shift := uint(mantissaShift + 1 - exponent)
+
+		return Float16(sign | uint16(roundShift(mantissa, shift)))
+	default:
+		// Rounding can carry into the exponent, which correctly rounds up to infinity.
+		return Float16(sign | uint16(uint32(exponent)<<float16MantissaBits+roundShift(mantissa, mantissaShift)))
+	}
+}
+
+// roundShift shifts the value to the right, rounding ties to even.
+func roundShift(value uint32, shift uint) uint32 {
+	half := uint32(1) << (shift - 1)
+	rest := value & (half<<1 - 1)
+	value >>= shift
+
+	if rest > half || (rest == half && value&1 == 1) {
+		value++
+	}
+
+	return value
+}
+
+// Float32 converts the Float16 to a float32, which is exact.
+func (f Float16) Float32() float32 {
+	sign := uint32(f&float16Sign) << halfShift
+	exponent := uint32(f>>float16MantissaBits) & float16Exponent
+	mantissa := uint32(f & float16Mantissa)
+
+	switch {
+	case exponent == float16Exponent:
+		return math.Float32frombits(sign | float32ExponentMask<<float32MantissaBits | mantissa<<mantissaShift)
+	case exponent == 0 && mantissa == 0:
+		return math.Float32frombits(sign)
+	case exponent == 0:
+		// Subnormal values are normalized, as they are normal numbers in single precision.
+		value := float32(mantissa) / (1 << (float16MantissaBits + float16ExponentBias - 1))
+		if sign != 0 {
+			return -value
+		}
+
+		return value
+	default:
+		exponent = exponent - float16ExponentBias + float32ExponentBias
+
+		return math.Float32frombits(sign | exponent<<float32MantissaBits | mantissa<<mantissaShift)
+	}
+}
+
+// BFloat16FromFloat32 converts a float32 to a BFloat16 by truncating the mantissa, like the
+// reference implementation of ONNX does when casting to bfloat16. NaN stays NaN.
+func BFloat16FromFloat32(f float32) BFloat16 {
+	if math.IsNaN(float64(f)) {
+		return bfloat16NaN
+	}
+
+	return BFloat16(math.Float32bits(f) >> halfShift)
+}
+
+// Float32 converts the BFloat16 to a float32, which is exact.
+func (b BFloat16) Float32() float32 {
+	return math.Float32frombits(uint32(b) << halfShift)
+}
+
+// Float16ArrayToFloat32Array converts an array of Float16 values to float32 values.
+func Float16ArrayToFloat32Array(arr []Float16) []float32 {
+	res := make([]float32, len(arr))
+	for i, value := range arr {
+		res[i] = value.Float32()
+	}
+
+	return res
+}
+
+// BFloat16ArrayToFloat32Array converts an array of BFloat16 values to float32 values.
+func BFloat16ArrayToFloat32Array(arr []BFloat16) []float32 {
+	res := make([]float32, len(arr))
+	for i, value := range arr {
+		res[i] = value.Float32()
+	}
+
+	return res
+}
+
+func getFloat16Data(tp *TensorProto) ([]Float16, error) {
+	if len(tp.Int32Data) > 0 {
+		return int32ArrayToHalfArray[Float16](tp.GetInt32Data()), nil
+	}
+
+	return readHalfArrayFromBytes[Float16](tp.RawData)
+}
+
+func getBFloat16Data(tp *TensorProto) ([]BFloat16, error) {
+	if len(tp.Int32Data) > 0 {
+		return int32ArrayToHalfArray[BFloat16](tp.GetInt32Data()), nil
+	}
+
+	return readHalfArrayFromBytes[BFloat16](tp.RawData)
+}
+
+// int32ArrayToHalfArray converts int32 data, in which every value holds the bits of a half
+// precision number, to the half precision numbers.
+func int32ArrayToHalfArray[H Float16 | BFloat16](arr []int32) []H {
+	res := make([]H, len(arr))
+	for i, value := range arr {
+		res[i] = H(uint16(value))
+	}
+
+	return res
+}
+
+func readHalfArrayFromBytes[H Float16 | BFloat16](data []byte) ([]H, error) {
+	bits, err := ReadUint16ArrayFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]H, len(bits))
+	for i, value := range bits {
+		res[i] = H(value)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,192 @@
+package onnx
+
+import (
+	"math"
+	"reflect"
+
+	"gorgonia.org/tensor"
+)
+
+// The data types below were added to ONNX after onnx.proto3.pb.go was generated, hence they
+// are not part of the generated enum.
+const (
+	TensorProto_FLOAT8E4M3FN   TensorProto_DataType = 17
+	TensorProto_FLOAT8E4M3FNUZ TensorProto_DataType = 18
+	TensorProto_FLOAT8E5M2     TensorProto_DataType = 19
+	TensorProto_FLOAT8E5M2FNUZ TensorProto_DataType = 20
+	TensorProto_UINT4          TensorProto_DataType = 21
+	TensorProto_INT4           TensorProto_DataType = 22
+)
+
+// Float8E4M3FN is an 8 bit floating point number with 4 exponent bits and 3 mantissa bits,
+// stored as its bits. It has no infinities, and only the values with all exponent and
+// mantissa bits set are NaN.
+type Float8E4M3FN uint8
+
+// Float8E5M2 is an 8 bit floating point number with 5 exponent bits and 2 mantissa bits,
+// stored as its bits. It follows IEEE 754, so it has infinities and NaN.
+type Float8E5M2 uint8
+
+var (
+	// Float8E4M3FNDtype is the dtype of tensors with Float8E4M3FN values.
+	Float8E4M3FNDtype = tensor.Dtype{Type: reflect.TypeOf(Float8E4M3FN(0))}
+
+	// Float8E5M2Dtype is the dtype of tensors with Float8E5M2 values.
+	Float8E5M2Dtype = tensor.Dtype{Type: reflect.TypeOf(Float8E5M2(0))}
+)
+
+// float8Format describes the layout of an 8 bit floating point type.
+type float8Format struct {
+	mantissaBits uint
+	exponentBias int
+	// maxFinite holds the bits of the largest finite value without its sign.
+	maxFinite uint32
+	// hasInf is true when the value after maxFinite is infinity, instead of NaN.
+	hasInf bool
+}
+
+var (
+	e4m3fn = float8Format{mantissaBits: 3, exponentBias: 7, maxFinite: 0x7e, hasInf: false}
+	e5m2   = float8Format{mantissaBits: 2, exponentBias: 15, maxFinite: 0x7b, hasInf: true}
+)
+
+const (
+	float8Sign      = 0x80
+	float8NaN       = 0x7f
+	float8SignShift = 24
+)
+
+// Float8E4M3FNFromFloat32 converts a float32 to the nearest Float8E4M3FN, where ties are
+// rounded to even. When saturate is true, values which are too large, including infinity,
+// become the largest finite value. Otherwise they become NaN.
+func Float8E4M3FNFromFloat32(f float32, saturate bool) Float8E4M3FN {
+	return Float8E4M3FN(e4m3fn.fromFloat32(f, saturate))
+}
+
+// Float8E5M2FromFloat32 converts a float32 to the nearest Float8E5M2, where ties are rounded
+// to even. When saturate is true, values which are too large, including infinity, become the
+// largest finite value. Otherwise they become infinite.
+func Float8E5M2FromFloat32(f float32, saturate bool) Float8E5M2 {
+	return Float8E5M2(e5m2.fromFloat32(f, saturate))
+}
+
+// Float32 converts the Float8E4M3FN to a float32, which is exact.
+func (f Float8E4M3FN) Float32() float32 {
+	return e4m3fn.toFloat32(uint8(f))
+}
+
+// Float32 converts the Float8E5M2 to a float32, which is exact.
+func (f Float8E5M2) Float32() float32 {
+	return e5m2.toFloat32(uint8(f))
+}
+
+func (format float8Format) fromFloat32(f float32, saturate bool) uint8 {
+	bits := math.Float32bits(f)
+	sign := uint8(bits>>float8SignShift) & float8Sign
+
+	if math.IsNaN(float64(f)) {
+		return sign | float8NaN
+	}
+
+	exponent := int((bits>>float32MantissaBits)&float32ExponentMask) - float32ExponentBias + format.exponentBias
+	mantissa := bits & float32MantissaMask
+	shift := float32MantissaBits - format.mantissaBits
+
+	var value uint32
+
+	switch {
+	case exponent < -int(format.mantissaBits):
+		return sign
+	case exponent <= 0:
+		// The value is subnormal in 8 bits. The implicit leading bit of the mantissa becomes
+		// explicit.
+		value = roundShift(mantissa|1<<float32MantissaBits, shift+uint(1-exponent))
+	default:
+		// Rounding can carry into the exponent, which is handled like any other overflow.
+		value = uint32(exponent)<<format.mantissaBits + roundShift(mantissa, shift)
+	}
+
+	if value > format.maxFinite {
+		switch {
+		case saturate:
+			value = format.maxFinite
+		case format.hasInf:
+			value = format.maxFinite + 1
+		default:
+			value = float8NaN
+		}
+	}
+
+	return sign | uint8(value)
+}
+
+func (format float8Format) toFloat32(bits uint8) float32 {
+	magnitude := uint32(bits &^ float8Sign)
+	exponent := int(magnitude >> format.mantissaBits)
+	mantissa := magnitude & (1<<format.mantissaBits - 1)
+
+	var value float64
+
+	switch {
+	case format.hasInf && magnitude == format.maxFinite+1:
+		value = math.Inf(1)
+	case magnitude > format.maxFinite:
+		value = math.NaN()
+	case exponent == 0:
+		value = math.Ldexp(float64(mantissa), 1-format.exponentBias-int(format.mantissaBits))
+	default:
+		value = math.Ldexp(float64(mantissa|1<<format.mantissaBits), exponent-format.exponentBias-int(format.mantissaBits))
+	}
+
+	if bits&float8Sign != 0 {
+		value = -value
+	}
+
+	return float32(value)
+}
+
+// Float8E4M3FNArrayToFloat32Array converts an array of Float8E4M3FN values to float32 values.
+func Float8E4M3FNArrayToFloat32Array(arr []Float8E4M3FN) []float32 {
+	res := make([]float32, len(arr))
+	for i, value := range arr {
+		res[i] = value.Float32()
+	}
+
+	return res
+}
+
+// Float8E5M2ArrayToFloat32Array converts an array of Float8E5M2 values to float32 values.
+func Float8E5M2ArrayToFloat32Array(arr []Float8E5M2) []float32 {
+	res := make([]float32, len(arr))
+	for i, value := range arr {
+		res[i] = value.Float32()
+	}
+
+	return res
+}
+
+// getFloat8Data returns the float8 values of a tensor, which are stored as one value per int32,
+// or one value per byte of the raw data.
+func getFloat8Data[F Float8E4M3FN | Float8E5M2](tp *TensorProto) []F {
+	if len(tp.Int32Data) > 0 {
+		return int32ArrayToFloat8Array[F](tp.GetInt32Data())
+	}
+
+	res := make([]F, len(tp.RawData))
+	for i, value := range tp.RawData {
+		res[i] = F(value)
+	}
+
+	return res
+}
+
+// int32ArrayToFloat8Array converts int32 data, in which every value holds the bits of a float8
+// number, to the float8 numbers.
+func int32ArrayToFloat8Array[F Float8E4M3FN | Float8E5M2](arr []int32) []F {
+	res := make([]F, len(arr))
+	for i, value := range arr {
+		res[i] = F(uint8(value))
+	}
+
+	return res
+}
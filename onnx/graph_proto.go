@@ -34,18 +34,32 @@ func (g *GraphProto) OutputShapes() Shapes {
 	return getShapesFromValueProto(g.GetOutput())
 }
 
-// ParamNames returns the names of the parameters as defined by the GraphProto.
+// ParamNames returns the names of the parameters as defined by the GraphProto. These are
+// the names of the initializers followed by the names of the sparse initializers.
 func (g *GraphProto) ParamNames() []string {
-	return getNamesFromTensorProto(g.GetInitializer())
+	names := getNamesFromTensorProto(g.GetInitializer())
+
+	for _, sp := range g.GetSparseInitializer() {
+		names = append(names, sp.GetValues().GetName())
+	}
+
+	return names
 }
 
-// Params returns the parameters of the graph proto as a map of tensors.
+// Params returns the parameters of the graph proto as a map of tensors. Sparse
+// initializers are converted to dense tensors.
 func (g *GraphProto) Params() (map[string]tensor.Tensor, error) {
+	return g.params(TensorFromProto)
+}
+
+// params returns the parameters of the graph proto, where the initializers are converted
+// to tensors with the given function.
+func (g *GraphProto) params(fromProto func(*TensorProto) (tensor.Tensor, error)) (map[string]tensor.Tensor, error) {
 	initializers := g.GetInitializer()
-	res := make(map[string]tensor.Tensor, len(initializers))
+	res := make(map[string]tensor.Tensor, len(initializers)+len(g.GetSparseInitializer()))
 
 	for _, i := range initializers {
-		t, err := TensorFromProto(i)
+		t, err := fromProto(i)
 		if err != nil {
 			return nil, err
 		}
@@ -53,6 +67,15 @@ func (g *GraphProto) Params() (map[string]tensor.Tensor, error) {
 		res[i.Name] = t
 	}
 
+	for _, sp := range g.GetSparseInitializer() {
+		t, err := TensorFromSparseProto(sp)
+		if err != nil {
+			return nil, err
+		}
+
+		res[sp.GetValues().GetName()] = t
+	}
+
 	return res, nil
 }
 
@@ -162,6 +185,10 @@ func TensorFromProto(tp *TensorProto) (tensor.Tensor, error) {
 		err    error
 	)
 
+	if tp.IsExternal() {
+		return nil, fmt.Errorf("%w: data of tensor %v has not been loaded", ErrExternalData, tp.GetName())
+	}
+
 	typeMap := TensorProto_DataType_value
 
 	switch tp.DataType {
@@ -187,6 +214,20 @@ func TensorFromProto(tp *TensorProto) (tensor.Tensor, error) {
 		values, err = getDoubleData(tp)
 	case typeMap["BOOL"]:
 		values = getBoolData(tp)
+	case typeMap["STRING"]:
+		values = getStringData(tp)
+	case typeMap["FLOAT16"]:
+		values, err = getFloat16Data(tp)
+	case typeMap["BFLOAT16"]:
+		values, err = getBFloat16Data(tp)
+	case int32(TensorProto_FLOAT8E4M3FN):
+		values = getFloat8Data[Float8E4M3FN](tp)
+	case int32(TensorProto_FLOAT8E5M2):
+		values = getFloat8Data[Float8E5M2](tp)
+	case int32(TensorProto_INT4):
+		values, err = getInt4Data(tp)
+	case int32(TensorProto_UINT4):
+		values, err = getUint4Data(tp)
 	default:
 		// At this moment the datatype is either UNDEFINED or some datatype we currently
 		// do not support.
@@ -293,6 +334,15 @@ func getDoubleData(tp *TensorProto) ([]float64, error) {
 	return ReadFloat64ArrayFromBytes(tp.RawData)
 }
 
+func getStringData(tp *TensorProto) []string {
+	values := make([]string, len(tp.StringData))
+	for i, value := range tp.GetStringData() {
+		values[i] = string(value)
+	}
+
+	return values
+}
+
 func getBoolData(tp *TensorProto) []bool {
 	if len(tp.Int32Data) > 0 {
 		return Int32ArrayToBoolArray(tp.GetInt32Data())
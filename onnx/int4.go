@@ -0,0 +1,119 @@
+package onnx
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+
+	"gorgonia.org/tensor"
+)
+
+// Int4 is a signed 4 bit integer. Tensors hold one value per Int4, while ONNX packs two
+// values in every byte.
+type Int4 int8
+
+// Uint4 is an unsigned 4 bit integer. Tensors hold one value per Uint4, while ONNX packs two
+// values in every byte.
+type Uint4 uint8
+
+var (
+	// Int4Dtype is the dtype of tensors with Int4 values.
+	Int4Dtype = tensor.Dtype{Type: reflect.TypeOf(Int4(0))}
+
+	// Uint4Dtype is the dtype of tensors with Uint4 values.
+	Uint4Dtype = tensor.Dtype{Type: reflect.TypeOf(Uint4(0))}
+)
+
+// The ranges of values of Int4 and Uint4.
+const (
+	MinInt4  = -8
+	MaxInt4  = 7
+	MaxUint4 = 15
+
+	int4Bits = 4
+	int4Mask = 0xf
+)
+
+// Int4FromFloat32 converts a float32 to an Int4. The value is rounded half to even and
+// saturated to the range of Int4, like ONNX does when casting to int4. NaN becomes 0.
+func Int4FromFloat32(f float32) Int4 {
+	return Int4(roundToRange(f, MinInt4, MaxInt4))
+}
+
+// Uint4FromFloat32 converts a float32 to an Uint4. The value is rounded half to even and
+// saturated to the range of Uint4, like ONNX does when casting to uint4. NaN becomes 0.
+func Uint4FromFloat32(f float32) Uint4 {
+	return Uint4(roundToRange(f, 0, MaxUint4))
+}
+
+func roundToRange(f float32, low, high float64) int {
+	if math.IsNaN(float64(f)) {
+		return 0
+	}
+
+	return int(math.RoundToEven(math.Min(math.Max(float64(f), low), high)))
+}
+
+// Int4ArrayToInt8Array converts an array of Int4 values to int8 values.
+func Int4ArrayToInt8Array(arr []Int4) []int8 {
+	res := make([]int8, len(arr))
+	for i, value := range arr {
+		res[i] = int8(value)
+	}
+
+	return res
+}
+
+// Uint4ArrayToUint8Array converts an array of Uint4 values to uint8 values.
+func Uint4ArrayToUint8Array(arr []Uint4) []uint8 {
+	res := make([]uint8, len(arr))
+	for i, value := range arr {
+		res[i] = uint8(value)
+	}
+
+	return res
+}
+
+func getInt4Data(tp *TensorProto) ([]Int4, error) {
+	return unpackInt4Data(tp, func(nibble uint8) Int4 {
+		// Shifting the nibble to the top of the byte and back extends its sign.
+		return Int4(int8(nibble<<int4Bits) >> int4Bits)
+	})
+}
+
+func getUint4Data(tp *TensorProto) ([]Uint4, error) {
+	return unpackInt4Data(tp, func(nibble uint8) Uint4 {
+		return Uint4(nibble)
+	})
+}
+
+// unpackInt4Data returns the 4 bit values of a tensor. Two values are packed in every byte,
+// where the first value is stored in the low bits. The bytes are either stored in the raw
+// data, or as one byte per int32.
+func unpackInt4Data[I Int4 | Uint4](tp *TensorProto, unpack func(uint8) I) ([]I, error) {
+	packed := tp.RawData
+
+	if len(tp.Int32Data) > 0 {
+		packed = make([]byte, len(tp.Int32Data))
+		for i, value := range tp.Int32Data {
+			packed[i] = byte(value)
+		}
+	}
+
+	size := 1
+	for _, dim := range tp.GetDims() {
+		size *= int(dim)
+	}
+
+	if len(packed) < (size+1)/2 {
+		return nil, fmt.Errorf("%w: tensor %v holds %d bytes for %d packed values", io.ErrUnexpectedEOF, tp.GetName(), len(packed), size)
+	}
+
+	res := make([]I, size)
+	for i := range res {
+		res[i] = unpack((packed[i/2] >> (int4Bits * (i % 2))) & int4Mask)
+	}
+
+	return res, nil
+}
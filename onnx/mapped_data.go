@@ -0,0 +1,196 @@
+package onnx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"unsafe"
+
+	"gorgonia.org/tensor"
+)
+
+// Mappings holds the external data files which are mapped into memory to back the data
+// of tensors. Tensors backed by the mappings must not be used anymore once the mappings
+// are closed.
+type Mappings struct {
+	files map[string][]byte
+}
+
+// Close unmaps all files. Closing the mappings more than once has no effect.
+func (m *Mappings) Close() error {
+	if m == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, data := range m.files {
+		errs = append(errs, unmapFile(data))
+	}
+
+	m.files = nil
+
+	return errors.Join(errs...)
+}
+
+// file returns the contents of the mapped file with the given name, mapping it first
+// when this has not been done before. Nil is returned when the file can not be mapped,
+// for instance because the file system does not consist of files on disk.
+func (m *Mappings) file(fsys fs.FS, name string) ([]byte, error) {
+	if data, ok := m.files[name]; ok {
+		return data, nil
+	}
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := mapFile(f)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	if m.files == nil {
+		m.files = map[string][]byte{}
+	}
+
+	m.files[name] = data
+
+	return data, nil
+}
+
+// MapExternalData backs the raw data of the tensor by its external data file mapped into
+// memory, instead of reading the data. The mapping is private, so writes to the data of
+// the tensor never end up in the file. When the file can not be mapped, the external data
+// is loaded like LoadExternalData does. Tensors which are not external are left untouched.
+func (x *TensorProto) MapExternalData(fsys fs.FS, m *Mappings) error {
+	if !x.IsExternal() {
+		return nil
+	}
+
+	info, location, err := x.externalLocation()
+	if err != nil {
+		return err
+	}
+
+	data, err := m.file(fsys, location)
+	if err != nil {
+		return fmt.Errorf("%w: tensor %v: %w", ErrExternalData, x.GetName(), err)
+	}
+
+	if data == nil {
+		return x.LoadExternalData(fsys)
+	}
+
+	end := int64(len(data))
+	if info.Length >= 0 {
+		end = info.Offset + info.Length
+	}
+
+	if info.Offset > end || end > int64(len(data)) {
+		return fmt.Errorf("%w: tensor %v: %w", ErrExternalData, x.GetName(), io.ErrUnexpectedEOF)
+	}
+
+	x.setRawData(data[info.Offset:end:end])
+
+	return nil
+}
+
+// MapExternalData maps the external data of all tensors in the graph into memory, as
+// described by TensorProto.MapExternalData. The returned mappings must be closed once
+// the tensors of the graph are not used anymore.
+func (g *GraphProto) MapExternalData(fsys fs.FS) (*Mappings, error) {
+	m := &Mappings{}
+
+	for _, tp := range g.tensors() {
+		if err := tp.MapExternalData(fsys, m); err != nil {
+			return nil, errors.Join(err, m.Close())
+		}
+	}
+
+	return m, nil
+}
+
+// ParamsNoCopy returns the parameters of the graph proto as a map of tensors, like Params.
+// Numeric tensors with raw data share the memory of their raw data however, instead of
+// decoding it element by element. The raw data must therefore not change while the tensors
+// are used.
+func (g *GraphProto) ParamsNoCopy() (map[string]tensor.Tensor, error) {
+	return g.params(TensorFromProtoNoCopy)
+}
+
+// TensorFromProtoNoCopy returns a tensor.Tensor from an onnx.TensorProto. When the tensor
+// has numeric raw data, the tensor shares the memory of the raw data instead of decoding
+// it. Otherwise, the tensor is created like TensorFromProto does.
+func TensorFromProtoNoCopy(tp *TensorProto) (tensor.Tensor, error) {
+	if tp.IsExternal() || len(tp.RawData) == 0 || !hostIsLittleEndian() {
+		return TensorFromProto(tp)
+	}
+
+	var (
+		values any
+		ok     bool
+	)
+
+	switch TensorProto_DataType(tp.DataType) {
+	case TensorProto_FLOAT:
+		values, ok = viewRawData[float32](tp.RawData)
+	case TensorProto_DOUBLE:
+		values, ok = viewRawData[float64](tp.RawData)
+	case TensorProto_INT8:
+		values, ok = viewRawData[int8](tp.RawData)
+	case TensorProto_UINT8:
+		values, ok = viewRawData[uint8](tp.RawData)
+	case TensorProto_INT16:
+		values, ok = viewRawData[int16](tp.RawData)
+	case TensorProto_UINT16:
+		values, ok = viewRawData[uint16](tp.RawData)
+	case TensorProto_INT32:
+		values, ok = viewRawData[int32](tp.RawData)
+	case TensorProto_UINT32:
+		values, ok = viewRawData[uint32](tp.RawData)
+	case TensorProto_INT64:
+		values, ok = viewRawData[int64](tp.RawData)
+	case TensorProto_UINT64:
+		values, ok = viewRawData[uint64](tp.RawData)
+	case TensorProto_FLOAT16:
+		values, ok = viewRawData[Float16](tp.RawData)
+	case TensorProto_BFLOAT16:
+		values, ok = viewRawData[BFloat16](tp.RawData)
+	case TensorProto_FLOAT8E4M3FN:
+		values, ok = viewRawData[Float8E4M3FN](tp.RawData)
+	case TensorProto_FLOAT8E5M2:
+		values, ok = viewRawData[Float8E5M2](tp.RawData)
+	default:
+	}
+
+	if !ok {
+		return TensorFromProto(tp)
+	}
+
+	return tensor.New(tensor.WithShape(getDims(tp)...), tensor.WithBacking(values)), nil
+}
+
+// viewRawData reinterprets raw data as a slice of T sharing the same memory. This is only
+// possible when the data is aligned for T and holds a whole number of elements.
+func viewRawData[T any](data []byte) (any, bool) {
+	var zero T
+
+	size := int(unsafe.Sizeof(zero))
+	ptr := unsafe.Pointer(unsafe.SliceData(data))
+
+	if len(data)%size != 0 || uintptr(ptr)%unsafe.Alignof(zero) != 0 {
+		return nil, false
+	}
+
+	return unsafe.Slice((*T)(ptr), len(data)/size), true
+}
+
+// hostIsLittleEndian returns whether the host stores numbers in little endian order, which
+// is the order in which ONNX stores raw data.
+func hostIsLittleEndian() bool {
+	return binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+}
@@ -0,0 +1,14 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package onnx
+
+import "io/fs"
+
+// mapFile never maps files on this platform, hence external data is always read.
+func mapFile(fs.File) ([]byte, error) {
+	return nil, nil
+}
+
+func unmapFile([]byte) error {
+	return nil
+}
@@ -0,0 +1,33 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package onnx
+
+import (
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+// mapFile maps the file into memory with a private mapping. Nil is returned when the file
+// is not a file on disk or when it is empty.
+func mapFile(f fs.File) ([]byte, error) {
+	osFile, ok := f.(*os.File)
+	if !ok {
+		return nil, nil
+	}
+
+	stat, err := osFile.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.Size() == 0 {
+		return nil, nil
+	}
+
+	return syscall.Mmap(int(osFile.Fd()), 0, int(stat.Size()), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE)
+}
+
+func unmapFile(data []byte) error {
+	return syscall.Munmap(data)
+}
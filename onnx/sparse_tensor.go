@@ -0,0 +1,114 @@
+package onnx
+
+import (
+	"errors"
+	"fmt"
+
+	"gorgonia.org/tensor"
+)
+
+// ErrInvalidSparseTensor is returned when a sparse tensor cannot be converted to a dense tensor.
+var ErrInvalidSparseTensor = errors.New("invalid sparse tensor")
+
+// TensorFromSparseProto returns a dense tensor.Tensor from an onnx.SparseTensorProto. All
+// elements which are not given by the sparse tensor are zero. The indices can either be
+// linear indices, with shape [NNZ], or coordinates, with shape [NNZ, rank].
+func TensorFromSparseProto(sp *SparseTensorProto) (tensor.Tensor, error) {
+	name := sp.GetValues().GetName()
+
+	if len(sp.GetDims()) == 0 {
+		return nil, fmt.Errorf("%w: %v has no dimensions", ErrInvalidSparseTensor, name)
+	}
+
+	dims := make([]int, len(sp.GetDims()))
+	size := 1
+
+	for i, dim := range sp.GetDims() {
+		if dim <= 0 {
+			return nil, fmt.Errorf("%w: %v has a dimension of size %d", ErrInvalidSparseTensor, name, dim)
+		}
+
+		dims[i] = int(dim)
+		size *= dims[i]
+	}
+
+	values, err := TensorFromProto(sp.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	valuesDense, ok := values.(*tensor.Dense)
+	if !ok {
+		return nil, fmt.Errorf("%w: values of %v are not dense", ErrInvalidSparseTensor, name)
+	}
+
+	indices, err := sparseIndices(sp, dims)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indices) != valuesDense.Len() {
+		return nil, fmt.Errorf("%w: %v has %d values and %d indices", ErrInvalidSparseTensor, name, valuesDense.Len(), len(indices))
+	}
+
+	dense := tensor.New(tensor.Of(values.Dtype()), tensor.WithShape(dims...))
+
+	for i, index := range indices {
+		if index < 0 || index >= size {
+			return nil, fmt.Errorf("%w: index %d of %v is out of range", ErrInvalidSparseTensor, index, name)
+		}
+
+		dense.Set(index, valuesDense.Get(i))
+	}
+
+	return dense, nil
+}
+
+// sparseIndices returns the linear indices of the values of a sparse tensor with the given
+// dimensions. Coordinates are converted to linear indices in row major order.
+func sparseIndices(sp *SparseTensorProto, dims []int) ([]int, error) {
+	name := sp.GetValues().GetName()
+
+	if sp.GetIndices().GetDataType() != int32(TensorProto_INT64) {
+		return nil, fmt.Errorf("%w: indices of %v should be int64", ErrInvalidSparseTensor, name)
+	}
+
+	indexData, err := getInt64Data(sp.GetIndices())
+	if err != nil {
+		return nil, err
+	}
+
+	indexDims := sp.GetIndices().GetDims()
+
+	switch {
+	case len(indexDims) == 1:
+		indices := make([]int, len(indexData))
+		for i, index := range indexData {
+			indices[i] = int(index)
+		}
+
+		return indices, nil
+	case len(indexDims) == 2 && int(indexDims[1]) == len(dims):
+		rank := len(dims)
+		if len(indexData) != int(indexDims[0])*rank {
+			return nil, fmt.Errorf("%w: indices of %v do not match their shape", ErrInvalidSparseTensor, name)
+		}
+
+		indices := make([]int, len(indexData)/rank)
+
+		for i := range indices {
+			for axis, dim := range dims {
+				coordinate := int(indexData[i*rank+axis])
+				if coordinate < 0 || coordinate >= dim {
+					return nil, fmt.Errorf("%w: coordinate %d of %v is out of range", ErrInvalidSparseTensor, coordinate, name)
+				}
+
+				indices[i] = indices[i]*dim + coordinate
+			}
+		}
+
+		return indices, nil
+	default:
+		return nil, fmt.Errorf("%w: indices of %v should have shape [NNZ] or [NNZ, %d]", ErrInvalidSparseTensor, name, len(dims))
+	}
+}
@@ -0,0 +1,80 @@
+package ops
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"gorgonia.org/tensor"
+)
+
+// ConstantOfShapeValue returns the single element tensor given by the value attribute of a
+// constantOfShape node, which must have one of the given dtypes. Without the attribute, the
+// value is a float32 zero.
+func ConstantOfShapeValue(n *onnx.NodeProto, valueTypes []tensor.Dtype, op Operator) (*tensor.Dense, error) {
+	attributes := n.GetAttribute()
+
+	if len(attributes) > 1 {
+		return nil, ErrInvalidAttributeCount(1, len(attributes), op)
+	}
+
+	if len(attributes) == 0 {
+		return tensor.New(tensor.FromScalar(float32(0.0))), nil
+	}
+
+	attr := attributes[0]
+	if attr.GetName() != "value" {
+		return nil, ErrInvalidAttribute(attr.GetName(), op)
+	}
+
+	t, err := onnx.TensorFromProto(attr.GetT())
+	if err != nil {
+		return nil, err
+	}
+
+	value := tensor.New(tensor.WithBacking(t.Data()))
+	if value.Len() != 1 {
+		return nil, ErrInvalidTensor("expected tensor to have one element", op)
+	}
+
+	if !slices.Contains(valueTypes, value.Dtype()) {
+		return nil, ErrInvalidTensor("the dtype of the value is not supported", op)
+	}
+
+	return value, nil
+}
+
+// ConstantOfShape returns a tensor with the shape given by the values of the shape tensor,
+// of which every element is the value of the single element value tensor.
+func ConstantOfShape(shapeTensor, value tensor.Tensor, op Operator) (tensor.Tensor, error) {
+	shape, err := AnyToIntSlice(IfScalarToSlice(shapeTensor.Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty dimensions in a tensor are not supported
+	for i := range shape {
+		if shape[i] <= 0 {
+			return nil, ErrInvalidTensor("empty dimensions are not allowed", op)
+		}
+	}
+
+	// The value is repeated into a backing of its own dtype, which works for every dtype,
+	// unlike arithmetic which gorgonia only supports for numbers. A scalar value is given
+	// as its underlying type by gorgonia, hence it is converted to the dtype.
+	element := reflect.ValueOf(value.Data())
+	if element.Kind() == reflect.Slice {
+		element = element.Index(0)
+	}
+
+	element = element.Convert(value.Dtype().Type)
+
+	size := NElements(shape...)
+	backing := reflect.MakeSlice(reflect.SliceOf(value.Dtype().Type), size, size)
+
+	for i := 0; i < size; i++ {
+		backing.Index(i).Set(element)
+	}
+
+	return tensor.New(tensor.WithShape(shape...), tensor.WithBacking(backing.Interface())), nil
+}
@@ -1,6 +1,10 @@
 package ops
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"gorgonia.org/tensor"
 )
@@ -10,36 +14,58 @@ type Number interface {
 	float32 | float64 | int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64
 }
 
-// ConvertTensorDtype converts an interface of a specific dtype to a new dtype.
+// ConvertTensorDtype converts an interface of a specific dtype to a new dtype. Values which are
+// too large for a float8 type saturate to its largest finite value.
 func ConvertTensorDtype(t tensor.Tensor, newType int32) (tensor.Tensor, error) {
+	return ConvertTensorDtypeSaturate(t, newType, true)
+}
+
+// ConvertTensorDtypeSaturate converts a tensor to a new dtype like ConvertTensorDtype. When
+// saturate is false, values which are too large for a float8 type become infinite, or NaN if
+// the type has no infinities. Conversions to 4 bit integers always saturate.
+func ConvertTensorDtypeSaturate(t tensor.Tensor, newType int32, saturate bool) (tensor.Tensor, error) {
 	var (
 		err        error
 		newBacking any
 	)
 
-	backing := IfScalarToSlice(t.Data())
+	backing := dataAsSlice(t)
 
 	switch t.Dtype() {
 	case tensor.Float32:
-		newBacking, err = convertBacking(backing.([]float32), newType)
+		newBacking, err = convertBacking(backing.([]float32), newType, saturate)
 	case tensor.Float64:
-		newBacking, err = convertBacking(backing.([]float64), newType)
+		newBacking, err = convertBacking(backing.([]float64), newType, saturate)
 	case tensor.Int8:
-		newBacking, err = convertBacking(backing.([]int8), newType)
+		newBacking, err = convertBacking(backing.([]int8), newType, saturate)
 	case tensor.Int16:
-		newBacking, err = convertBacking(backing.([]int16), newType)
+		newBacking, err = convertBacking(backing.([]int16), newType, saturate)
 	case tensor.Int32:
-		newBacking, err = convertBacking(backing.([]int32), newType)
+		newBacking, err = convertBacking(backing.([]int32), newType, saturate)
 	case tensor.Int64:
-		newBacking, err = convertBacking(backing.([]int64), newType)
+		newBacking, err = convertBacking(backing.([]int64), newType, saturate)
 	case tensor.Uint8:
-		newBacking, err = convertBacking(backing.([]uint8), newType)
+		newBacking, err = convertBacking(backing.([]uint8), newType, saturate)
 	case tensor.Uint16:
-		newBacking, err = convertBacking(backing.([]uint16), newType)
+		newBacking, err = convertBacking(backing.([]uint16), newType, saturate)
 	case tensor.Uint32:
-		newBacking, err = convertBacking(backing.([]uint32), newType)
+		newBacking, err = convertBacking(backing.([]uint32), newType, saturate)
 	case tensor.Uint64:
-		newBacking, err = convertBacking(backing.([]uint64), newType)
+		newBacking, err = convertBacking(backing.([]uint64), newType, saturate)
+	case onnx.Float16Dtype:
+		newBacking, err = convertBacking(onnx.Float16ArrayToFloat32Array(backing.([]onnx.Float16)), newType, saturate)
+	case onnx.BFloat16Dtype:
+		newBacking, err = convertBacking(onnx.BFloat16ArrayToFloat32Array(backing.([]onnx.BFloat16)), newType, saturate)
+	case onnx.Float8E4M3FNDtype:
+		newBacking, err = convertBacking(onnx.Float8E4M3FNArrayToFloat32Array(backing.([]onnx.Float8E4M3FN)), newType, saturate)
+	case onnx.Float8E5M2Dtype:
+		newBacking, err = convertBacking(onnx.Float8E5M2ArrayToFloat32Array(backing.([]onnx.Float8E5M2)), newType, saturate)
+	case onnx.Int4Dtype:
+		newBacking, err = convertBacking(onnx.Int4ArrayToInt8Array(backing.([]onnx.Int4)), newType, saturate)
+	case onnx.Uint4Dtype:
+		newBacking, err = convertBacking(onnx.Uint4ArrayToUint8Array(backing.([]onnx.Uint4)), newType, saturate)
+	case tensor.String:
+		newBacking, err = convertStringBacking(backing.([]string), newType, saturate)
 	default:
 		return nil, ErrConversionInvalidType(t.Dtype(), newType)
 	}
@@ -51,7 +77,106 @@ func ConvertTensorDtype(t tensor.Tensor, newType int32) (tensor.Tensor, error) {
 	return tensor.New(tensor.WithShape(t.Shape()...), tensor.WithBacking(newBacking)), nil
 }
 
-func convertBacking[B Number](backing []B, dataType int32) (any, error) {
+// tensorProtoTypes maps the dtypes of tensors to the data types used by ONNX.
+var tensorProtoTypes = map[tensor.Dtype]onnx.TensorProto_DataType{
+	tensor.Float32: onnx.TensorProto_FLOAT,
+	tensor.Float64: onnx.TensorProto_DOUBLE,
+	tensor.Int8:    onnx.TensorProto_INT8,
+	tensor.Int16:   onnx.TensorProto_INT16,
+	tensor.Int32:   onnx.TensorProto_INT32,
+	tensor.Int64:   onnx.TensorProto_INT64,
+	tensor.Uint8:   onnx.TensorProto_UINT8,
+	tensor.Uint16:  onnx.TensorProto_UINT16,
+	tensor.Uint32:  onnx.TensorProto_UINT32,
+	tensor.Uint64:  onnx.TensorProto_UINT64,
+
+	tensor.String: onnx.TensorProto_STRING,
+
+	onnx.Float16Dtype:      onnx.TensorProto_FLOAT16,
+	onnx.BFloat16Dtype:     onnx.TensorProto_BFLOAT16,
+	onnx.Float8E4M3FNDtype: onnx.TensorProto_FLOAT8E4M3FN,
+	onnx.Float8E5M2Dtype:   onnx.TensorProto_FLOAT8E5M2,
+	onnx.Int4Dtype:         onnx.TensorProto_INT4,
+	onnx.Uint4Dtype:        onnx.TensorProto_UINT4,
+}
+
+// ConvertTensorDtypeLike converts a tensor to the dtype of another tensor.
+func ConvertTensorDtypeLike(t, like tensor.Tensor) (tensor.Tensor, error) {
+	return ConvertTensorDtypeLikeSaturate(t, like, true)
+}
+
+// ConvertTensorDtypeLikeSaturate converts a tensor to the dtype of another tensor, where
+// saturate has the same meaning as for ConvertTensorDtypeSaturate.
+func ConvertTensorDtypeLikeSaturate(t, like tensor.Tensor, saturate bool) (tensor.Tensor, error) {
+	dataType, ok := tensorProtoTypes[like.Dtype()]
+	if !ok {
+		return nil, ErrConversionNotSupportedDtype(like.Dtype())
+	}
+
+	return ConvertTensorDtypeSaturate(t, int32(dataType), saturate)
+}
+
+// ApplyBFloat16AsFloat32 applies an operator of which the inputs are bfloat16 by converting
+// them to float32, which holds every bfloat16 value exactly, after which the float32
+// outputs are converted back to bfloat16. Other inputs are passed to apply unchanged.
+func ApplyBFloat16AsFloat32(
+	inputs []tensor.Tensor, apply func([]tensor.Tensor) ([]tensor.Tensor, error),
+) ([]tensor.Tensor, error) {
+	upcast := make([]tensor.Tensor, len(inputs))
+
+	var bfloat16Input tensor.Tensor
+
+	for i, input := range inputs {
+		upcast[i] = input
+
+		if input == nil || input.Dtype() != onnx.BFloat16Dtype {
+			continue
+		}
+
+		converted, err := ConvertTensorDtype(input, int32(onnx.TensorProto_FLOAT))
+		if err != nil {
+			return nil, err
+		}
+
+		upcast[i] = converted
+		bfloat16Input = input
+	}
+
+	outputs, err := apply(upcast)
+	if err != nil || bfloat16Input == nil {
+		return outputs, err
+	}
+
+	for i, output := range outputs {
+		if output == nil || output.Dtype() != tensor.Float32 {
+			continue
+		}
+
+		outputs[i], err = ConvertTensorDtypeLike(output, bfloat16Input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputs, nil
+}
+
+// Float64Data returns the data of a numeric tensor as a slice of float64.
+func Float64Data(t tensor.Tensor) ([]float64, error) {
+	converted, err := ConvertTensorDtype(t, int32(onnx.TensorProto_DOUBLE))
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := IfScalarToSlice(converted.Data()).([]float64)
+	if !ok {
+		return nil, ErrTypeAssert("[]float64", converted.Data())
+	}
+
+	return data, nil
+}
+
+func convertBacking[B Number](backing []B, dataType int32, saturate bool) (any, error) {
 	switch onnx.TensorProto_DataType(dataType) {
 	case onnx.TensorProto_FLOAT:
 		return createNewBacking[B, float32](backing), nil
@@ -73,7 +198,25 @@ func convertBacking[B Number](backing []B, dataType int32) (any, error) {
 		return createNewBacking[B, uint32](backing), nil
 	case onnx.TensorProto_UINT64:
 		return createNewBacking[B, uint64](backing), nil
-	case onnx.TensorProto_BFLOAT16, onnx.TensorProto_BOOL, onnx.TensorProto_COMPLEX64, onnx.TensorProto_COMPLEX128, onnx.TensorProto_FLOAT16, onnx.TensorProto_UNDEFINED, onnx.TensorProto_STRING:
+	case onnx.TensorProto_FLOAT16:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), onnx.Float16FromFloat32), nil
+	case onnx.TensorProto_BFLOAT16:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), onnx.BFloat16FromFloat32), nil
+	case onnx.TensorProto_FLOAT8E4M3FN:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), func(value float32) onnx.Float8E4M3FN {
+			return onnx.Float8E4M3FNFromFloat32(value, saturate)
+		}), nil
+	case onnx.TensorProto_FLOAT8E5M2:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), func(value float32) onnx.Float8E5M2 {
+			return onnx.Float8E5M2FromFloat32(value, saturate)
+		}), nil
+	case onnx.TensorProto_INT4:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), onnx.Int4FromFloat32), nil
+	case onnx.TensorProto_UINT4:
+		return convertFloat32Backing(createNewBacking[B, float32](backing), onnx.Uint4FromFloat32), nil
+	case onnx.TensorProto_STRING:
+		return stringBacking(backing), nil
+	case onnx.TensorProto_BOOL, onnx.TensorProto_COMPLEX64, onnx.TensorProto_COMPLEX128, onnx.TensorProto_UNDEFINED:
 		return nil, ErrConversionNotSupported(dataType)
 	default:
 		return nil, ErrConversionNotSupported(dataType)
@@ -88,3 +231,105 @@ func createNewBacking[B Number, R Number](backing []B) []R {
 
 	return newBacking
 }
+
+// convertStringBacking parses strings to numbers of the given data type. Besides plain and
+// scientific representations of numbers, the special values "INF", "+INF", "-INF" and "NaN"
+// are accepted in any case. Integers may also be given as floats, which are truncated.
+func convertStringBacking(backing []string, dataType int32, saturate bool) (any, error) {
+	switch onnx.TensorProto_DataType(dataType) {
+	case onnx.TensorProto_STRING:
+		return append([]string{}, backing...), nil
+	case onnx.TensorProto_FLOAT, onnx.TensorProto_DOUBLE, onnx.TensorProto_FLOAT16, onnx.TensorProto_BFLOAT16,
+		onnx.TensorProto_FLOAT8E4M3FN, onnx.TensorProto_FLOAT8E5M2:
+		values := make([]float64, len(backing))
+
+		for i, value := range backing {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, ErrConversionInvalidString(value, dataType)
+			}
+
+			values[i] = parsed
+		}
+
+		return convertBacking(values, dataType, saturate)
+	default:
+		values := make([]int64, len(backing))
+
+		for i, value := range backing {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				parsedFloat, floatErr := strconv.ParseFloat(value, 64)
+				if floatErr != nil {
+					return nil, ErrConversionInvalidString(value, dataType)
+				}
+
+				parsed = int64(parsedFloat)
+			}
+
+			values[i] = parsed
+		}
+
+		return convertBacking(values, dataType, saturate)
+	}
+}
+
+// stringBacking formats numbers as strings. Floats use a plain representation, unless they
+// are very small or large, in which case the scientific representation is used.
+func stringBacking[B Number](backing []B) []string {
+	newBacking := make([]string, len(backing))
+
+	for i, value := range backing {
+		switch v := any(value).(type) {
+		case float32:
+			newBacking[i] = formatFloat(float64(v), 32)
+		case float64:
+			newBacking[i] = formatFloat(v, 64)
+		default:
+			newBacking[i] = fmt.Sprint(v)
+		}
+	}
+
+	return newBacking
+}
+
+const (
+	minPlainFloat = 1e-4
+	maxPlainFloat = 1e16
+)
+
+func formatFloat(value float64, bitSize int) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "INF"
+	case math.IsInf(value, -1):
+		return "-INF"
+	case value != 0 && (math.Abs(value) < minPlainFloat || math.Abs(value) >= maxPlainFloat):
+		return strconv.FormatFloat(value, 'g', -1, bitSize)
+	default:
+		return strconv.FormatFloat(value, 'f', -1, bitSize)
+	}
+}
+
+// convertFloat32Backing converts float32 values to values of a type which is not a Number,
+// using the given conversion.
+func convertFloat32Backing[R any](backing []float32, convert func(float32) R) []R {
+	newBacking := make([]R, len(backing))
+	for i, value := range backing {
+		newBacking[i] = convert(value)
+	}
+
+	return newBacking
+}
+
+// StringData returns the data of a string tensor as a slice of strings.
+func StringData(t tensor.Tensor) ([]string, error) {
+	data, ok := dataAsSlice(t).([]string)
+	if !ok {
+		return nil, ErrTypeAssert("[]string", t.Data())
+	}
+
+	return data, nil
+}
@@ -1,8 +1,10 @@
 package ops
 
 import (
+	"math"
 	"testing"
 
+	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/stretchr/testify/assert"
 	"gorgonia.org/tensor"
 )
@@ -86,6 +88,96 @@ func TestConvertTensorDtype(t *testing.T) {
 			1,
 			nil,
 		},
+		{
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]float32{0.1, -2.5, 65520, 6e-8})),
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]onnx.Float16{0x2e66, 0xc100, 0x7c00, 0x1})),
+			10,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float16{0x2e66, 0xfc00, 0x1})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]float64{0.0999755859375, math.Inf(-1), 5.960464477539063e-08})),
+			11,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Float16{0x3c00, 0xc000})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]int32{1, -2})),
+			6,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]float32{0.48033667, -1, float32(math.NaN())})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.BFloat16{0x3ef5, 0xbf80, 0x7fc0})),
+			16,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.BFloat16{0x3ef5, 0xbf80})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{0.478515625, -1})),
+			1,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.BFloat16{0x3ef5, 0xbf80})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Float16{0x37a8, 0xbc00})),
+			10,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(7), tensor.WithBacking([]float32{0.1, -2.5, 464, 1e-3, 0x1p-10, float32(math.NaN()), float32(math.Inf(1))})),
+			tensor.New(tensor.WithShape(7), tensor.WithBacking([]onnx.Float8E4M3FN{0x1d, 0xc2, 0x7e, 0x01, 0x00, 0x7f, 0x7e})),
+			17,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]onnx.Float8E4M3FN{0x1d, 0xc2, 0x7e, 0x01})),
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]float64{0.1015625, -2.5, 448, 0x1p-9})),
+			11,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(5), tensor.WithBacking([]float32{0.1, -3, 61440, 1e-5, float32(math.Inf(-1))})),
+			tensor.New(tensor.WithShape(5), tensor.WithBacking([]onnx.Float8E5M2{0x2e, 0xc2, 0x7b, 0x01, 0xfb})),
+			19,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float8E5M2{0x2e, 0xfc, 0x01})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]float32{0.09375, float32(math.Inf(-1)), 0x1p-16})),
+			1,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(5), tensor.WithBacking([]float32{-8.5, 7.5, 0.5, 1.5, -2.5})),
+			tensor.New(tensor.WithShape(5), tensor.WithBacking([]onnx.Int4{-8, 7, 0, 2, -2})),
+			22,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]int64{-1, 16, 9})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Uint4{0, 15, 9})),
+			21,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Int4{-8, 7})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]int32{-8, 7})),
+			6,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Uint4{15, 0})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"15", "0"})),
+			8,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"1.5", "-inf"})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Float8E4M3FN{0x3c, 0xfe})),
+			17,
+			nil,
+		},
 		{
 			tensor.New(tensor.WithShape(2), tensor.WithBacking([]bool{true, false})),
 			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1.0, 2.0})),
@@ -93,10 +185,46 @@ func TestConvertTensorDtype(t *testing.T) {
 			ErrConversionInvalidType(tensor.Bool, 1),
 		},
 		{
-			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1.0, 2.1})),
+			tensor.New(tensor.WithShape(6), tensor.WithBacking([]float32{0.47892547, -2, 1e-5, 1e20, float32(math.Inf(-1)), float32(math.NaN())})),
+			tensor.New(tensor.WithShape(6), tensor.WithBacking([]string{"0.47892547", "-2", "1e-05", "1e+20", "-INF", "NaN"})),
+			8,
 			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]uint8{1, 255})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"1", "255"})),
 			8,
-			ErrConversionNotSupported(8),
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]string{"0.5", "1E2", "-inf", "+INF"})),
+			tensor.New(tensor.WithShape(4), tensor.WithBacking([]float32{0.5, 100, float32(math.Inf(-1)), float32(math.Inf(1))})),
+			1,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]string{"100.5", "-3", "9007199254740993"})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]int64{100, -3, 9007199254740993})),
+			7,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"a", "b"})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"a", "b"})),
+			8,
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]string{"1", "Hello"})),
+			nil,
+			1,
+			ErrConversionInvalidString("Hello", 1),
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1.0, 2.1})),
+			nil,
+			9,
+			ErrConversionNotSupported(9),
 		},
 	}
 
@@ -109,6 +237,92 @@ func TestConvertTensorDtype(t *testing.T) {
 			continue
 		}
 
+		// The data is compared instead of the tensors, as the memory of string tensors
+		// holds pointers.
+		assert.Equal(t, test.tensorOut.Shape(), out.Shape())
+		assert.Equal(t, test.tensorOut.Data(), out.Data())
+	}
+}
+
+func TestConvertTensorDtypeSaturate(t *testing.T) {
+	tests := []struct {
+		tensorIn  tensor.Tensor
+		tensorOut tensor.Tensor
+		newType   int32
+	}{
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]float32{500, float32(math.Inf(1)), float32(math.Inf(-1))})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float8E4M3FN{0x7f, 0x7f, 0xff})),
+			17,
+		},
+		{
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]float32{1e5, float32(math.Inf(1)), -1e5})),
+			tensor.New(tensor.WithShape(3), tensor.WithBacking([]onnx.Float8E5M2{0x7c, 0x7c, 0xfc})),
+			19,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{20, -20})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Int4{7, -8})),
+			22,
+		},
+	}
+
+	for _, test := range tests {
+		out, err := ConvertTensorDtypeSaturate(test.tensorIn, test.newType, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, test.tensorOut, out)
+	}
+}
+
+func TestConvertTensorDtypeLike(t *testing.T) {
+	tests := []struct {
+		tensorIn  tensor.Tensor
+		like      tensor.Tensor
+		tensorOut tensor.Tensor
+		err       error
+	}{
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float64{1.5, 2.0})),
+			tensor.New(tensor.WithShape(1), tensor.WithBacking([]int32{0})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]int32{1, 2})),
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]uint8{1, 2})),
+			tensor.New(tensor.WithShape(1), tensor.WithBacking([]float32{0})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 2})),
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 2})),
+			tensor.New(tensor.WithShape(1), tensor.WithBacking([]onnx.Float16{0})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Float16{0x3c00, 0x4000})),
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 9})),
+			tensor.New(tensor.WithShape(1), tensor.WithBacking([]onnx.Int4{0})),
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]onnx.Int4{1, 7})),
+			nil,
+		},
+		{
+			tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 2})),
+			tensor.New(tensor.WithShape(1), tensor.WithBacking([]bool{true})),
+			nil,
+			ErrConversionNotSupportedDtype(tensor.Bool),
+		},
+	}
+
+	for _, test := range tests {
+		out, err := ConvertTensorDtypeLike(test.tensorIn, test.like)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err != nil {
+			continue
+		}
+
 		assert.Equal(t, test.tensorOut, out)
 	}
 }
@@ -118,3 +332,33 @@ func TestCreateNewBacking(t *testing.T) {
 	assert.Equal(t, []int32{1, 2}, createNewBacking[float32, int32]([]float32{1.2, 2.5}))
 	assert.Equal(t, []float32{1.0, 2.0}, createNewBacking[int64, float32]([]int64{1, 2}))
 }
+
+func TestFloat64Data(t *testing.T) {
+	data, err := Float64Data(TensorWithBackingFixture([]int32{1, 2, 3}, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, data)
+
+	data, err = Float64Data(tensor.New(tensor.FromScalar(float32(0.5))))
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0.5}, data)
+
+	data, err = Float64Data(tensor.New(tensor.FromScalar(onnx.Float8E4M3FN(0x3c))))
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1.5}, data)
+
+	_, err = Float64Data(TensorWithBackingFixture([]bool{true}, 1))
+	assert.NotNil(t, err)
+}
+
+func TestStringData(t *testing.T) {
+	data, err := StringData(TensorWithBackingFixture([]string{"a", "b"}, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, data)
+
+	data, err = StringData(tensor.New(tensor.FromScalar("a")))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a"}, data)
+
+	_, err = StringData(TensorWithBackingFixture([]float32{1}, 1))
+	assert.Equal(t, ErrTypeAssert("[]string", []float32{1}), err)
+}
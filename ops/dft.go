@@ -0,0 +1,73 @@
+package ops
+
+import (
+	"math"
+
+	"gorgonia.org/tensor"
+)
+
+// DFT computes the discrete Fourier transform with the given length along an axis of the
+// data, which has the given shape. The last dim of the shape holds the real part, and
+// optionally the imaginary part of every value. Along the axis, the signal is padded with
+// zeros or truncated to the length. For onesided transforms, only the first length/2+1
+// frequencies are returned. It returns the data and the shape of the transform.
+func DFT(data []float64, shape tensor.Shape, axis, length int, inverse, onesided bool) ([]float64, tensor.Shape) {
+	rank := len(shape)
+	nParts := shape[rank-1]
+	signalLength := shape[axis]
+	outer := shape[:axis].TotalSize()
+	inner := shape[axis+1 : rank-1].TotalSize()
+
+	outLength := length
+	if onesided {
+		outLength = length/2 + 1
+	}
+
+	outShape := shape.Clone()
+	outShape[axis] = outLength
+	outShape[rank-1] = 2
+
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+
+	out := make([]float64, outer*outLength*inner*2)
+	signal := make([]complex128, length)
+
+	for o := 0; o < outer; o++ {
+		for i := 0; i < inner; i++ {
+			for n := range signal {
+				signal[n] = 0
+
+				if n < signalLength {
+					idx := ((o*signalLength+n)*inner + i) * nParts
+					signal[n] = complex(data[idx], 0)
+
+					if nParts == 2 {
+						signal[n] = complex(data[idx], data[idx+1])
+					}
+				}
+			}
+
+			for k := 0; k < outLength; k++ {
+				var sum complex128
+
+				for n, value := range signal {
+					angle := sign * 2 * math.Pi * float64(k*n%length) / float64(length)
+					sum += value * complex(math.Cos(angle), math.Sin(angle))
+				}
+
+				if inverse {
+					sum /= complex(float64(length), 0)
+				}
+
+				idx := ((o*outLength+k)*inner + i) * 2
+				out[idx] = real(sum)
+				out[idx+1] = imag(sum)
+			}
+		}
+	}
+
+	return out, outShape
+}
@@ -276,6 +276,43 @@ func ErrConversionNotSupported(dType int32) error {
 	return fmt.Errorf("%w: to %v is not supported yet", ErrConversion, dType)
 }
 
+func ErrConversionNotSupportedDtype(dType tensor.Dtype) error {
+	return fmt.Errorf("%w: to %v is not supported yet", ErrConversion, dType)
+}
+
+// ErrConversionInvalidString is used when a string can not be converted to a number.
+func ErrConversionInvalidString(value string, newType int32) error {
+	return fmt.Errorf("%w: string %q to %v is invalid", ErrConversion, value, newType)
+}
+
+var ErrScatterIndex = errors.New("scatter index out of range")
+
+var ErrScatterReduction = errors.New("unsupported scatter reduction")
+
+// ErrUnsupportedScatterReduction is used when a scatter operator uses a reduction which is
+// not supported for the data.
+func ErrUnsupportedScatterReduction(reduction ScatterReduction) error {
+	return fmt.Errorf("%w: %v", ErrScatterReduction, reduction)
+}
+
+var ErrReduction = errors.New("unsupported reduction")
+
+// ErrUnsupportedReduction is used when a reduce operator uses a reduction which is not
+// supported for the input.
+func ErrUnsupportedReduction(reduction Reduction, dType tensor.Dtype) error {
+	return fmt.Errorf("%w: %v for dtype %v", ErrReduction, reduction, dType)
+}
+
+// ErrEmptyReduction is used when a reduce operator has to reduce zero values into one.
+var ErrEmptyReduction = errors.New("unable to reduce zero values")
+
+var ErrPadMode = errors.New("unsupported pad mode")
+
+// ErrUnsupportedPadMode is used when the pad operator uses a mode which is not supported.
+func ErrUnsupportedPadMode(mode PadMode) error {
+	return fmt.Errorf("%w: %v", ErrPadMode, mode)
+}
+
 var ErrActivationNotImplementedBase = errors.New("the given activation function is not implemented")
 
 func ErrActivationNotImplemented(activation string) error {
@@ -0,0 +1,103 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinClipInputs = 1
+	MaxClipInputs = 1
+)
+
+// Clip represents the ONNX clip operator of the opsets before opset 11, which takes the
+// min and max as attributes instead of inputs.
+type Clip struct {
+	opset13.Clip
+
+	minValue *float32
+	maxValue *float32
+}
+
+// newClip creates a new clip operator.
+func newClip() ops.Operator {
+	return &Clip{}
+}
+
+// Init initializes the clip operator.
+func (c *Clip) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		value := attr.GetF()
+
+		switch attr.GetName() {
+		case "min":
+			c.minValue = &value
+		case "max":
+			c.maxValue = &value
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), c)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the clip operator.
+func (c *Clip) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	minTensor, err := c.bound(c.minValue, inputs[0].Dtype())
+	if err != nil {
+		return nil, err
+	}
+
+	maxTensor, err := c.bound(c.maxValue, inputs[0].Dtype())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Clip.Apply([]tensor.Tensor{inputs[0], minTensor, maxTensor})
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *Clip) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (c *Clip) GetMinInputs() int {
+	return MinClipInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (c *Clip) GetMaxInputs() int {
+	return MaxClipInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *Clip) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *Clip) String() string {
+	return "clip operator"
+}
+
+// bound returns a min or max attribute as a scalar tensor of the given dtype, or nil if
+// the attribute is not set.
+func (c *Clip) bound(value *float32, dtype tensor.Dtype) (tensor.Tensor, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch dtype {
+	case tensor.Float32:
+		return tensor.New(tensor.FromScalar(*value)), nil
+	case tensor.Float64:
+		return tensor.New(tensor.FromScalar(float64(*value))), nil
+	default:
+		return nil, ops.ErrInvalidInputType(0, dtype.String(), c)
+	}
+}
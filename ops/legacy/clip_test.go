@@ -0,0 +1,70 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestClipInit(t *testing.T) {
+	c := newClip().(*Clip)
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "min", F: -1},
+		{Name: "max", F: 1},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, float32(-1), *c.minValue)
+	assert.Equal(t, float32(1), *c.maxValue)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "value"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("value", c), err)
+}
+
+func TestClip(t *testing.T) {
+	tests := []struct {
+		attributes []*onnx.AttributeProto
+		input      tensor.Tensor
+		expected   interface{}
+	}{
+		{
+			[]*onnx.AttributeProto{{Name: "min", F: -1}, {Name: "max", F: 1}},
+			ops.TensorWithBackingFixture([]float32{-2, 0, 2}, 3),
+			[]float32{-1, 0, 1},
+		},
+		{
+			[]*onnx.AttributeProto{{Name: "max", F: 0.5}},
+			ops.TensorWithBackingFixture([]float64{-2, 0, 2}, 3),
+			[]float64{-2, 0, 0.5},
+		},
+		{
+			nil,
+			ops.TensorWithBackingFixture([]float32{-2, 2}, 2),
+			[]float32{-2, 2},
+		},
+	}
+
+	for _, test := range tests {
+		clip := newClip()
+		err := clip.Init(&onnx.NodeProto{Attribute: test.attributes})
+		assert.Nil(t, err)
+
+		res, err := clip.Apply([]tensor.Tensor{test.input})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationClip(t *testing.T) {
+	clip := &Clip{}
+
+	validated, err := clip.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1}, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(validated))
+
+	_, err = clip.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "int32", clip), err)
+}
@@ -0,0 +1,41 @@
+package legacy
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+)
+
+// operatorsLegacy holds the operators of the default ONNX domain of the opsets before
+// opset 13, which behave differently from their implementation in opset 13. The operators
+// which did not change are taken from opset 13 for these opsets as well.
+var operatorsLegacy = []ops.OperatorVersion{
+	{OpType: "Clip", SinceVersion: 6, New: newClip},
+	{OpType: "LogSoftmax", SinceVersion: 1, New: newLogSoftmax},
+	{OpType: "ReduceSum", SinceVersion: 1, New: newReduceSum},
+	{OpType: "Softmax", SinceVersion: 1, New: newSoftmax},
+	{OpType: "Split", SinceVersion: 2, New: newSplit},
+	{OpType: "Squeeze", SinceVersion: 1, New: newSqueeze},
+	{OpType: "Unsqueeze", SinceVersion: 1, New: newUnsqueeze},
+	{OpType: "Upsample", SinceVersion: 7, New: newUpsample},
+}
+
+// GetOperator maps strings as found in the ModelProto to the legacy Operators.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operatorsLegacy, operatorType); ok {
+		return op, nil
+	}
+
+	return nil, ops.ErrUnknownOperatorType(operatorType)
+}
+
+// GetOpNames returns a list with the names of the legacy operators.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operatorsLegacy)
+}
+
+// GetOperatorVersions returns the legacy operators, together with the version of the
+// operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operatorsLegacy)
+}
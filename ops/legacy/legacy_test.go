@@ -0,0 +1,46 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"Clip", newClip(), nil},
+		{"LogSoftmax", newLogSoftmax(), nil},
+		{"ReduceSum", newReduceSum(), nil},
+		{"Softmax", newSoftmax(), nil},
+		{"Split", newSplit(), nil},
+		{"Squeeze", newSqueeze(), nil},
+		{"Unsqueeze", newUnsqueeze(), nil},
+		{"Upsample", newUpsample(), nil},
+		{"Abs", nil, ops.ErrUnknownOperatorType("Abs")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Less(t, version.SinceVersion, int64(13))
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
@@ -0,0 +1,81 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinReduceSumInputs = 1
+	MaxReduceSumInputs = 1
+)
+
+// ReduceSum represents the ONNX reduceSum operator of the opsets before opset 13, which
+// takes the axes to reduce as an attribute instead of an input. Without axes, all dims
+// are reduced.
+type ReduceSum struct {
+	axes     []int64
+	keepDims bool
+}
+
+// newReduceSum creates a new reduceSum operator.
+func newReduceSum() ops.Operator {
+	return &ReduceSum{
+		keepDims: true,
+	}
+}
+
+// Init initializes the reduceSum operator.
+func (r *ReduceSum) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axes":
+			r.axes = attr.GetInts()
+		case "keepdims":
+			r.keepDims = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the reduceSum operator.
+func (r *ReduceSum) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ReduceWithAxes(inputs[0], axesTensor(r.axes), r.keepDims, false, ops.ReductionSum)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *ReduceSum) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *ReduceSum) GetMinInputs() int {
+	return MinReduceSumInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *ReduceSum) GetMaxInputs() int {
+	return MaxReduceSumInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *ReduceSum) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Uint32, tensor.Uint64, tensor.Int32, tensor.Int64, tensor.Float32, tensor.Float64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *ReduceSum) String() string {
+	return "reduceSum operator"
+}
@@ -0,0 +1,57 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReduceSumInit(t *testing.T) {
+	r := newReduceSum().(*ReduceSum)
+	assert.True(t, r.keepDims)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "axes", Ints: []int64{1}},
+		{Name: "keepdims", I: 0},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &ReduceSum{axes: []int64{1}}, r)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "noop_with_empty_axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("noop_with_empty_axes", r), err)
+}
+
+func TestReduceSum(t *testing.T) {
+	tests := []struct {
+		reduceSum     *ReduceSum
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{&ReduceSum{axes: []int64{1}, keepDims: true}, []float32{3, 7}, tensor.Shape{2, 1}},
+		{&ReduceSum{axes: []int64{-2}}, []float32{4, 6}, tensor.Shape{2}},
+		{&ReduceSum{keepDims: true}, []float32{10}, tensor.Shape{1, 1}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)}
+
+		res, err := test.reduceSum.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationReduceSum(t *testing.T) {
+	reduceSum := &ReduceSum{}
+
+	validated, err := reduceSum.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int64{1}, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(validated))
+
+	_, err = reduceSum.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "uint8", reduceSum), err)
+}
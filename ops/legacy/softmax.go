@@ -0,0 +1,131 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSoftmaxInputs = 1
+	MaxSoftmaxInputs = 1
+)
+
+// softmax is the implementation shared by the softmax and logSoftmax operators of the
+// opsets before opset 13. The input is coerced into a 2D matrix, by flattening the dims
+// before the axis and the dims starting at the axis, and the operation is applied to
+// every row of this matrix.
+type softmax struct {
+	name        string
+	logarithmic bool
+	axis        int
+}
+
+// newSoftmaxBase creates a new softmax operator with the default axis.
+func newSoftmaxBase(name string, logarithmic bool) *softmax {
+	return &softmax{
+		name:        name,
+		logarithmic: logarithmic,
+		axis:        1,
+	}
+}
+
+// Init initializes the softmax operator.
+func (s *softmax) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the softmax operator.
+func (s *softmax) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	if s.axis < -rank || s.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, s.axis)
+	}
+
+	axis := ops.ConvertNegativeAxis(s.axis, rank)
+
+	matrix := ops.ShallowCopy(inputs[0])
+	if err := matrix.Reshape(ops.NElements(shape[:axis]...), ops.NElements(shape[axis:]...)); err != nil {
+		return nil, err
+	}
+
+	var (
+		out tensor.Tensor
+		err error
+	)
+
+	if s.logarithmic {
+		out, err = tensor.LogSoftMax(matrix, 1)
+	} else {
+		out, err = tensor.SoftMax(matrix, 1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := out.Reshape(shape...); err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *softmax) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *softmax) GetMinInputs() int {
+	return MinSoftmaxInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *softmax) GetMaxInputs() int {
+	return MaxSoftmaxInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *softmax) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *softmax) String() string {
+	return s.name + " operator"
+}
+
+// Softmax represents the ONNX softmax operator of the opsets before opset 13, which
+// coerces the input into a 2D matrix.
+type Softmax struct {
+	*softmax
+}
+
+// newSoftmax creates a new softmax operator.
+func newSoftmax() ops.Operator {
+	return &Softmax{newSoftmaxBase("softmax", false)}
+}
+
+// LogSoftmax represents the ONNX logsoftmax operator of the opsets before opset 13, which
+// coerces the input into a 2D matrix.
+type LogSoftmax struct {
+	*softmax
+}
+
+// newLogSoftmax creates a new logsoftmax operator.
+func newLogSoftmax() ops.Operator {
+	return &LogSoftmax{newSoftmaxBase("logsoftmax", true)}
+}
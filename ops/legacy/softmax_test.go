@@ -0,0 +1,102 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSoftmaxInit(t *testing.T) {
+	s := newSoftmax().(*Softmax)
+	assert.Equal(t, 1, s.axis)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: -1}}})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, s.axis)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", s.softmax), err)
+}
+
+func TestSoftmax(t *testing.T) {
+	tests := []struct {
+		softmax       ops.Operator
+		axis          int64
+		shape         []int
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{
+			newSoftmax(),
+			1,
+			[]int{1, 2, 2},
+			[]float32{0.0320586, 0.0871443, 0.2368828, 0.6439143},
+			tensor.Shape{1, 2, 2},
+		},
+		{
+			newSoftmax(),
+			-1,
+			[]int{2, 2},
+			[]float32{0.2689414, 0.7310586, 0.2689414, 0.7310586},
+			tensor.Shape{2, 2},
+		},
+		{
+			newLogSoftmax(),
+			0,
+			[]int{2, 2},
+			[]float32{-3.4401897, -2.4401897, -1.4401897, -0.4401897},
+			tensor.Shape{2, 2},
+		},
+	}
+
+	for _, test := range tests {
+		err := test.softmax.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: test.axis}}})
+		assert.Nil(t, err)
+
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, test.shape...)}
+
+		res, err := test.softmax.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+		assert.Equal(t, tensor.Shape(test.shape), inputs[0].Shape())
+	}
+}
+
+func TestSoftmaxInvalidAxis(t *testing.T) {
+	s := newSoftmax().(*Softmax)
+	s.axis = 2
+
+	_, err := s.Apply([]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2)})
+	assert.Equal(t, ops.ErrAxisOutOfRange(-2, 1, 2), err)
+}
+
+func TestInputValidationSoftmax(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "int32", newSoftmax().(*Softmax).softmax),
+		},
+	}
+
+	for _, test := range tests {
+		softmax := newSoftmax()
+		validated, err := softmax.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSplitInputs = 1
+	MaxSplitInputs = 1
+)
+
+// Split represents the ONNX split operator of the opsets before opset 13, which takes the
+// sizes of the parts as an attribute instead of an input. Without sizes, the axis is split
+// into equal parts, one for every output.
+type Split struct {
+	axis     int
+	split    []int
+	nOutputs int
+}
+
+// newSplit creates a new split operator.
+func newSplit() ops.Operator {
+	return &Split{}
+}
+
+// Init initializes the split operator.
+func (s *Split) Init(n *onnx.NodeProto) error {
+	s.nOutputs = len(n.GetOutput())
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		case "split":
+			for _, size := range attr.GetInts() {
+				s.split = append(s.split, int(size))
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the split operator.
+func (s *Split) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if len(s.split) > 0 {
+		return ops.Split(inputs[0], s.axis, s.split)
+	}
+
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	if s.axis < -rank || s.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, s.axis)
+	}
+
+	size := shape[ops.ConvertNegativeAxis(s.axis, rank)]
+	if s.nOutputs == 0 || size%s.nOutputs != 0 {
+		return nil, ops.ErrInvalidInput("the axis can not be split into equal parts", s)
+	}
+
+	return ops.Split(inputs[0], s.axis, ops.EqualSplitSizes(size, s.nOutputs))
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Split) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *Split) GetMinInputs() int {
+	return MinSplitInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *Split) GetMaxInputs() int {
+	return MaxSplitInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Split) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Split) String() string {
+	return "split operator"
+}
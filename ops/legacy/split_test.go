@@ -0,0 +1,74 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSplitInit(t *testing.T) {
+	s := newSplit().(*Split)
+
+	err := s.Init(&onnx.NodeProto{
+		Output: []string{"a", "b"},
+		Attribute: []*onnx.AttributeProto{
+			{Name: "axis", I: 1},
+			{Name: "split", Ints: []int64{1, 2}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &Split{axis: 1, split: []int{1, 2}, nOutputs: 2}, s)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "num_outputs"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("num_outputs", s), err)
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		split    *Split
+		expected [][]float32
+	}{
+		{
+			&Split{axis: 1, split: []int{1, 2}, nOutputs: 2},
+			[][]float32{{0, 3}, {1, 2, 4, 5}},
+		},
+		{
+			&Split{axis: -1, nOutputs: 3},
+			[][]float32{{0, 3}, {1, 4}, {2, 5}},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.split.Apply([]tensor.Tensor{ops.Float32TensorFixture(2, 3)})
+		assert.Nil(t, err)
+		assert.Equal(t, len(test.expected), len(res))
+
+		for i, expected := range test.expected {
+			assert.Equal(t, expected, res[i].Data())
+		}
+	}
+}
+
+func TestSplitFail(t *testing.T) {
+	split := &Split{nOutputs: 2}
+
+	_, err := split.Apply([]tensor.Tensor{ops.Float32TensorFixture(3, 2)})
+	assert.Equal(t, ops.ErrInvalidInput("the axis can not be split into equal parts", split), err)
+}
+
+func TestInputValidationSplit(t *testing.T) {
+	split := &Split{}
+
+	validated, err := split.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int64{1}, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(validated))
+
+	_, err = split.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+		ops.TensorWithBackingFixture([]int64{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputCount(2, split), err)
+}
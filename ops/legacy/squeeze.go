@@ -0,0 +1,81 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSqueezeInputs = 1
+	MaxSqueezeInputs = 1
+)
+
+// Squeeze represents the ONNX squeeze operator of the opsets before opset 13, which takes
+// the axes to squeeze as an attribute instead of an input.
+type Squeeze struct {
+	opset13.Squeeze
+
+	axes []int64
+}
+
+// newSqueeze creates a new squeeze operator.
+func newSqueeze() ops.Operator {
+	return &Squeeze{}
+}
+
+// Init initializes the squeeze operator.
+func (s *Squeeze) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axes":
+			s.axes = attr.GetInts()
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the squeeze operator.
+func (s *Squeeze) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return s.Squeeze.Apply([]tensor.Tensor{inputs[0], axesTensor(s.axes)})
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Squeeze) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *Squeeze) GetMinInputs() int {
+	return MinSqueezeInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *Squeeze) GetMaxInputs() int {
+	return MaxSqueezeInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Squeeze) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Squeeze) String() string {
+	return "squeeze operator"
+}
+
+// axesTensor returns the axes given as attribute as a tensor, as expected by the operators
+// of opset 13. If no axes are given, nil is returned.
+func axesTensor(axes []int64) tensor.Tensor {
+	if len(axes) == 0 {
+		return nil
+	}
+
+	return tensor.New(tensor.WithShape(len(axes)), tensor.WithBacking(append([]int64{}, axes...)))
+}
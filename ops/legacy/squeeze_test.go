@@ -0,0 +1,55 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSqueezeInit(t *testing.T) {
+	s := newSqueeze().(*Squeeze)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes", Ints: []int64{0, -1}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, -1}, s.axes)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axis", s), err)
+}
+
+func TestSqueeze(t *testing.T) {
+	tests := []struct {
+		squeeze       *Squeeze
+		shape         []int
+		expectedShape tensor.Shape
+	}{
+		{&Squeeze{}, []int{1, 3, 1, 2}, tensor.Shape{3, 2}},
+		{&Squeeze{axes: []int64{0}}, []int{1, 3, 1, 2}, tensor.Shape{3, 1, 2}},
+		{&Squeeze{axes: []int64{0, -2}}, []int{1, 3, 1, 2}, tensor.Shape{3, 2}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.Float32TensorFixture(test.shape...)}
+
+		res, err := test.squeeze.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationSqueeze(t *testing.T) {
+	squeeze := &Squeeze{}
+
+	validated, err := squeeze.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1}, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(validated))
+
+	_, err = squeeze.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+		ops.TensorWithBackingFixture([]int64{0}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputCount(2, squeeze), err)
+}
@@ -0,0 +1,75 @@
+package legacy
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinUnsqueezeInputs = 1
+	MaxUnsqueezeInputs = 1
+)
+
+// Unsqueeze represents the ONNX unsqueeze operator of the opsets before opset 13, which
+// takes the axes to insert as an attribute instead of an input.
+type Unsqueeze struct {
+	opset13.Unsqueeze
+
+	axes []int64
+}
+
+// newUnsqueeze creates a new unsqueeze operator.
+func newUnsqueeze() ops.Operator {
+	return &Unsqueeze{}
+}
+
+// Init initializes the unsqueeze operator.
+func (u *Unsqueeze) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axes":
+			u.axes = attr.GetInts()
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), u)
+		}
+	}
+
+	if len(u.axes) == 0 {
+		return ops.ErrInvalidAttributeCount(1, 0, u)
+	}
+
+	return nil
+}
+
+// Apply applies the unsqueeze operator.
+func (u *Unsqueeze) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return u.Unsqueeze.Apply([]tensor.Tensor{inputs[0], axesTensor(u.axes)})
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (u *Unsqueeze) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(u, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (u *Unsqueeze) GetMinInputs() int {
+	return MinUnsqueezeInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (u *Unsqueeze) GetMaxInputs() int {
+	return MaxUnsqueezeInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (u *Unsqueeze) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (u *Unsqueeze) String() string {
+	return "unsqueeze operator"
+}
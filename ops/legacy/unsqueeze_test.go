@@ -0,0 +1,54 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestUnsqueezeInit(t *testing.T) {
+	u := newUnsqueeze().(*Unsqueeze)
+
+	err := u.Init(&onnx.NodeProto{})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, u), err)
+
+	err = u.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes", Ints: []int64{1}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, u.axes)
+
+	err = u.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axis", u), err)
+}
+
+func TestUnsqueeze(t *testing.T) {
+	tests := []struct {
+		unsqueeze     *Unsqueeze
+		shape         []int
+		expectedShape tensor.Shape
+	}{
+		{&Unsqueeze{axes: []int64{0}}, []int{3, 2}, tensor.Shape{1, 3, 2}},
+		{&Unsqueeze{axes: []int64{1, -1}}, []int{3, 2}, tensor.Shape{3, 1, 2, 1}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.Float32TensorFixture(test.shape...)}
+
+		res, err := test.unsqueeze.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationUnsqueeze(t *testing.T) {
+	unsqueeze := &Unsqueeze{}
+
+	validated, err := unsqueeze.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(validated))
+
+	_, err = unsqueeze.ValidateInputs([]tensor.Tensor{})
+	assert.Equal(t, ops.ErrInvalidInputCount(0, unsqueeze), err)
+}
@@ -0,0 +1,170 @@
+package legacy
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinUpsampleInputs = 1
+	MaxUpsampleInputs = 2
+)
+
+// upsampleTypes are the types which can be upsampled by the upsample operator.
+var upsampleTypes = []tensor.Dtype{
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Float32, tensor.Float64,
+}
+
+// Upsample represents the ONNX upsample operator, which is replaced by the resize operator
+// since opset 10. Every dim of the input is upsampled by its scale, using the nearest value
+// or linear interpolation. The scales are an attribute in opset 7, and an input since opset 9.
+type Upsample struct {
+	linear bool
+	scales []float32
+}
+
+// newUpsample creates a new upsample operator.
+func newUpsample() ops.Operator {
+	return &Upsample{}
+}
+
+// Init initializes the upsample operator.
+func (u *Upsample) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "mode":
+			switch string(attr.GetS()) {
+			case "nearest":
+				u.linear = false
+			case "linear", "bilinear":
+				u.linear = true
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), u)
+			}
+		case "scales":
+			u.scales = attr.GetFloats()
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), u)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the upsample operator.
+func (u *Upsample) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	scales := u.scales
+
+	if inputs[1] != nil {
+		var ok bool
+
+		scales, ok = ops.IfScalarToSlice(inputs[1].Data()).([]float32)
+		if !ok {
+			return nil, ops.ErrTypeAssert("[]float32", inputs[1].Data())
+		}
+	}
+
+	shape := inputs[0].Shape().Clone()
+	if len(scales) != len(shape) {
+		return nil, ops.ErrInvalidInput("there should be a scale for every dim of the input", u)
+	}
+
+	data, err := ops.Float64Data(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for axis, scale := range scales {
+		if scale < 1 {
+			return nil, ops.ErrInvalidInput("scales should be at least 1", u)
+		}
+
+		data = u.upsampleAxis(data, shape, axis, float64(scale))
+	}
+
+	out := tensor.New(tensor.WithShape(shape...), tensor.WithBacking(data))
+
+	converted, err := ops.ConvertTensorDtypeLike(out, inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (u *Upsample) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(u, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if inputs[1] == nil && u.scales == nil {
+		return nil, ops.ErrInvalidInput("the scales should be given as attribute or input", u)
+	}
+
+	return inputs, nil
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (u *Upsample) GetMinInputs() int {
+	return MinUpsampleInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (u *Upsample) GetMaxInputs() int {
+	return MaxUpsampleInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (u *Upsample) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{upsampleTypes, {tensor.Float32}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (u *Upsample) String() string {
+	return "upsample operator"
+}
+
+// upsampleAxis upsamples a single axis of the data with the given shape, and updates the
+// shape to the upsampled shape. An output position x along the axis corresponds to the
+// position x / scale in the input.
+func (u *Upsample) upsampleAxis(data []float64, shape []int, axis int, scale float64) []float64 {
+	inSize := shape[axis]
+	outSize := int(math.Floor(float64(inSize) * scale))
+	inner := ops.NElements(shape[axis+1:]...)
+	outer := len(data) / (inSize * inner)
+
+	out := make([]float64, 0, outer*outSize*inner)
+
+	for o := 0; o < outer; o++ {
+		block := data[o*inSize*inner : (o+1)*inSize*inner]
+
+		for x := 0; x < outSize; x++ {
+			position := float64(x) / scale
+			lower := min(int(position), inSize-1)
+
+			if !u.linear {
+				out = append(out, block[lower*inner:(lower+1)*inner]...)
+				continue
+			}
+
+			upper := min(lower+1, inSize-1)
+			weight := position - float64(lower)
+
+			for i := 0; i < inner; i++ {
+				out = append(out, block[lower*inner+i]*(1-weight)+block[upper*inner+i]*weight)
+			}
+		}
+	}
+
+	shape[axis] = outSize
+
+	return out
+}
@@ -0,0 +1,132 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestUpsampleInit(t *testing.T) {
+	u := newUpsample().(*Upsample)
+
+	err := u.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "mode", S: []byte("linear")},
+		{Name: "scales", Floats: []float32{1, 2}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &Upsample{linear: true, scales: []float32{1, 2}}, u)
+
+	err = u.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("cubic")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", u), err)
+
+	err = u.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "height_scale"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("height_scale", u), err)
+}
+
+func TestUpsample(t *testing.T) {
+	tests := []struct {
+		upsample      *Upsample
+		input         tensor.Tensor
+		scales        tensor.Tensor
+		expected      interface{}
+		expectedShape tensor.Shape
+	}{
+		{
+			&Upsample{scales: []float32{1, 1, 2, 3}},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 1, 2, 2),
+			nil,
+			[]float32{
+				1, 1, 1, 2, 2, 2,
+				1, 1, 1, 2, 2, 2,
+				3, 3, 3, 4, 4, 4,
+				3, 3, 3, 4, 4, 4,
+			},
+			tensor.Shape{1, 1, 4, 6},
+		},
+		{
+			&Upsample{},
+			ops.TensorWithBackingFixture([]int32{1, 2}, 1, 2),
+			ops.TensorWithBackingFixture([]float32{2, 1.5}, 2),
+			[]int32{1, 1, 2, 1, 1, 2},
+			tensor.Shape{2, 3},
+		},
+		{
+			&Upsample{linear: true},
+			ops.TensorWithBackingFixture([]float32{1, 3, 5, 7}, 2, 2),
+			ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			[]float32{1, 2, 3, 3, 5, 6, 7, 7},
+			tensor.Shape{2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.upsample.Apply([]tensor.Tensor{test.input, test.scales})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestUpsampleFail(t *testing.T) {
+	upsample := &Upsample{}
+
+	_, err := upsample.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("there should be a scale for every dim of the input", upsample), err)
+
+	_, err = upsample.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{0.5}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("scales should be at least 1", upsample), err)
+}
+
+func TestInputValidationUpsample(t *testing.T) {
+	tests := []struct {
+		upsample *Upsample
+		inputs   []tensor.Tensor
+		err      error
+	}{
+		{
+			&Upsample{scales: []float32{2}},
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1}, 1)},
+			nil,
+		},
+		{
+			&Upsample{},
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1}, 1),
+				ops.TensorWithBackingFixture([]float32{2}, 1),
+			},
+			nil,
+		},
+		{
+			&Upsample{},
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1}, 1)},
+			ops.ErrInvalidInput("the scales should be given as attribute or input", &Upsample{}),
+		},
+		{
+			&Upsample{},
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+				ops.TensorWithBackingFixture([]float64{2}, 1),
+			},
+			ops.ErrInvalidInputType(1, "float64", &Upsample{}),
+		},
+	}
+
+	for _, test := range tests {
+		validated, err := test.upsample.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 2, len(validated))
+		}
+	}
+}
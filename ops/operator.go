@@ -1,11 +1,49 @@
 package ops
 
 import (
+	"context"
+
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"gorgonia.org/tensor"
 )
 
-// Operator is the base interface for all operators.
+// OperatorVersion is an implementation of an operator, together with the version of the
+// operator set of its domain since which the implementation is valid. The implementation
+// is valid until the version in which the ONNX standard introduces a newer version of
+// the operator.
+type OperatorVersion struct {
+	OpType       string
+	SinceVersion int64
+	New          func() Operator
+}
+
+// NewOperator creates a new operator of the given type from a list of operator versions,
+// like the operators of an opset. It returns false if the list has no such operator.
+func NewOperator(versions []OperatorVersion, opType string) (Operator, bool) {
+	for _, version := range versions {
+		if version.OpType == opType {
+			return version.New(), true
+		}
+	}
+
+	return nil, false
+}
+
+// OperatorTypes returns the types of the operators in a list of operator versions.
+func OperatorTypes(versions []OperatorVersion) []string {
+	opTypes := make([]string, 0, len(versions))
+
+	for _, version := range versions {
+		opTypes = append(opTypes, version.OpType)
+	}
+
+	return opTypes
+}
+
+// Operator is the base interface for all operators. An operator is initialized once,
+// after which it is applied for every run of a model, possibly by multiple goroutines at
+// the same time. Hence, Apply and ValidateInputs must not change the state of the
+// operator, nor the data of the input tensors.
 type Operator interface {
 	// String should return a simple string describing the operator
 	String() string
@@ -34,3 +72,43 @@ type Operator interface {
 	// the right amount of inputs and the correct dtypes of the tensors.
 	ValidateInputs([]tensor.Tensor) ([]tensor.Tensor, error)
 }
+
+// ContextOperator is implemented by operators which can take a long time to apply, like
+// operators looping over the time steps of a sequence. These operators check the given
+// context while they are being applied, which allows the calculation to be interrupted.
+type ContextOperator interface {
+	Operator
+
+	// ApplyContext should apply the operator like Apply does. When the context is done
+	// before the calculation is finished, it should stop and return the error of the context.
+	ApplyContext(context.Context, []tensor.Tensor) ([]tensor.Tensor, error)
+}
+
+// ValueOperator is implemented by operators of which the inputs or outputs are not all
+// tensors, like the operators on sequences, maps and optionals. These operators are given
+// the values of their inputs, and are validated and applied using ValidateValues and
+// ApplyValues instead of ValidateInputs and Apply.
+type ValueOperator interface {
+	Operator
+
+	// ValidateValues should validate the list of input values like ValidateInputs does
+	// for tensors.
+	ValidateValues([]Value) ([]Value, error)
+
+	// ApplyValues should apply the operator to the list of input values. It should return
+	// a list with output values, the result of the operator.
+	ApplyValues([]Value) ([]Value, error)
+}
+
+// OutputTypeOperator is implemented by operators of which the dtype of an output does not
+// follow the dtype of the first input allowing floats, like outputs of which the dtype is
+// set by an attribute. Half precision inputs which are computed in float32 use this to
+// find out which float32 outputs to convert back to half precision.
+type OutputTypeOperator interface {
+	Operator
+
+	// GetOutputTypeInputs should return for every output the index of the input of which
+	// the output has the dtype, or -1 when the dtype of the output does not depend on the
+	// dtypes of the inputs.
+	GetOutputTypeInputs() []int
+}
@@ -0,0 +1,33 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOperator(t *testing.T) {
+	versions := []OperatorVersion{
+		{OpType: "A", SinceVersion: 1, New: func() Operator { return &MockOp{minInputs: 1} }},
+		{OpType: "B", SinceVersion: 2, New: func() Operator { return &MockOp{minInputs: 2} }},
+	}
+
+	tests := []struct {
+		opType   string
+		expected Operator
+		ok       bool
+	}{
+		{"A", &MockOp{minInputs: 1}, true},
+		{"B", &MockOp{minInputs: 2}, true},
+		{"C", nil, false},
+	}
+
+	for _, test := range tests {
+		op, ok := NewOperator(versions, test.opType)
+
+		assert.Equal(t, test.ok, ok)
+		assert.Equal(t, test.expected, op)
+	}
+
+	assert.Equal(t, []string{"A", "B"}, OperatorTypes(versions))
+}
@@ -70,7 +70,8 @@ func (c *Cast) GetInputTypeConstraints() [][]tensor.Dtype {
 	return [][]tensor.Dtype{
 		{
 			tensor.Int16, tensor.Uint16, tensor.Int32, tensor.Uint32, tensor.Int64, tensor.Uint64,
-			tensor.Float32, tensor.Float64,
+			tensor.Float32, tensor.Float64, onnx.Float16Dtype, onnx.BFloat16Dtype,
+			tensor.String,
 		},
 	}
 }
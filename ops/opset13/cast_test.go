@@ -60,6 +60,20 @@ func TestCast(t *testing.T) {
 			3,
 			[]int8{1, 1},
 		},
+		{
+			&Cast{},
+			[]float32{1.0, -2.0},
+			[]int{2},
+			10,
+			[]onnx.Float16{0x3c00, 0xc000},
+		},
+		{
+			&Cast{},
+			[]onnx.BFloat16{0x3f80, 0xc000},
+			[]int{2},
+			1,
+			[]float32{1.0, -2.0},
+		},
 	}
 
 	for _, test := range tests {
@@ -87,6 +101,10 @@ func TestInputValidationCast(t *testing.T) {
 			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
 			nil,
 		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.Float16{1, 2}, 2)},
+			nil,
+		},
 		{
 			[]tensor.Tensor{
 				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
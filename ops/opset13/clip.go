@@ -0,0 +1,158 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinClipInputs = 1
+	MaxClipInputs = 3
+)
+
+// clipTypes are the types which can be clipped by the clip operator.
+var clipTypes = []tensor.Dtype{
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Float32, tensor.Float64,
+}
+
+// Clip represents the ONNX clip operator, which limits the values of the input to the
+// range given by the optional min and max inputs.
+type Clip struct{}
+
+// newClip creates a new clip operator.
+func newClip() ops.Operator {
+	return &Clip{}
+}
+
+// Init initializes the clip operator.
+func (c *Clip) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the clip operator.
+func (c *Clip) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	var (
+		out tensor.Tensor
+		err error
+	)
+
+	switch inputs[0].Dtype() {
+	case tensor.Uint8:
+		out, err = clip[uint8](inputs[0], inputs[1], inputs[2])
+	case tensor.Uint16:
+		out, err = clip[uint16](inputs[0], inputs[1], inputs[2])
+	case tensor.Uint32:
+		out, err = clip[uint32](inputs[0], inputs[1], inputs[2])
+	case tensor.Uint64:
+		out, err = clip[uint64](inputs[0], inputs[1], inputs[2])
+	case tensor.Int8:
+		out, err = clip[int8](inputs[0], inputs[1], inputs[2])
+	case tensor.Int16:
+		out, err = clip[int16](inputs[0], inputs[1], inputs[2])
+	case tensor.Int32:
+		out, err = clip[int32](inputs[0], inputs[1], inputs[2])
+	case tensor.Int64:
+		out, err = clip[int64](inputs[0], inputs[1], inputs[2])
+	case tensor.Float32:
+		out, err = clip[float32](inputs[0], inputs[1], inputs[2])
+	case tensor.Float64:
+		out, err = clip[float64](inputs[0], inputs[1], inputs[2])
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), c)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *Clip) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(c, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs[1:] {
+		if input != nil && input.Dtype() != inputs[0].Dtype() {
+			return nil, ops.ErrInvalidInputType(i+1, input.Dtype().String(), c)
+		}
+	}
+
+	return inputs, nil
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (c *Clip) GetMinInputs() int {
+	return MinClipInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (c *Clip) GetMaxInputs() int {
+	return MaxClipInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *Clip) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{clipTypes, clipTypes, clipTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *Clip) String() string {
+	return "clip operator"
+}
+
+// clip limits the values of x to the range given by the min and max tensors. Both tensors
+// should hold a single value, and can be nil if there is no limit.
+func clip[T ops.Number](x, minTensor, maxTensor tensor.Tensor) (tensor.Tensor, error) {
+	minValue, hasMin, err := clipBound[T](minTensor)
+	if err != nil {
+		return nil, err
+	}
+
+	maxValue, hasMax, err := clipBound[T](maxTensor)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.Apply(func(value T) T {
+		if hasMin && value < minValue {
+			value = minValue
+		}
+
+		if hasMax && value > maxValue {
+			value = maxValue
+		}
+
+		return value
+	})
+}
+
+// clipBound returns the value of a min or max tensor of the clip operator, and whether
+// the tensor is given at all.
+func clipBound[T ops.Number](t tensor.Tensor) (T, bool, error) {
+	var zero T
+
+	if t == nil {
+		return zero, false, nil
+	}
+
+	switch data := t.Data().(type) {
+	case T:
+		return data, true, nil
+	case []T:
+		if len(data) != 1 {
+			return zero, false, ops.ErrDimension("min and max of clip should be scalars")
+		}
+
+		return data[0], true, nil
+	default:
+		return zero, false, ops.ErrTypeAssert("scalar", t.Data())
+	}
+}
@@ -0,0 +1,106 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestClip(t *testing.T) {
+	tests := []struct {
+		inputs   []tensor.Tensor
+		expected interface{}
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{-2, 0, 2}, 3),
+				tensor.New(tensor.FromScalar(float32(-1))),
+				tensor.New(tensor.FromScalar(float32(1))),
+			},
+			[]float32{-1, 0, 1},
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{-2, 0, 2}, 3),
+				nil,
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			[]float32{-2, 0, 1},
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{-128, 5, 127}, 3),
+				tensor.New(tensor.FromScalar(int8(0))),
+				nil,
+			},
+			[]int8{0, 5, 127},
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1, 2}, 2), nil, nil},
+			[]uint8{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		clip := &Clip{}
+
+		res, err := clip.Apply(test.inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestClipFail(t *testing.T) {
+	clip := &Clip{}
+
+	_, err := clip.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{0, 1}, 2),
+		nil,
+	})
+	assert.Equal(t, ops.ErrDimension("min and max of clip should be scalars"), err)
+}
+
+func TestInputValidationClip(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float64{1}, 1),
+			},
+			ops.ErrInvalidInputType(1, "float64", &Clip{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)},
+			ops.ErrInvalidInputType(0, "bool", &Clip{}),
+		},
+	}
+
+	for _, test := range tests {
+		clip := &Clip{}
+		validated, err := clip.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 3, len(validated))
+		}
+	}
+}
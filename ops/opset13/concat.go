@@ -59,14 +59,19 @@ func (c *Concat) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 func (c *Concat) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	// Because Concat can have an infinite number of inputs, we set the maximum number
 	// of inputs dynamically, based on our inputs. Every input can have any type.
-	c.maxInputs = len(inputs)
-	c.inputTypeConstraints = make([][]tensor.Dtype, len(inputs))
+	// The operator can be used by multiple runs at the same time, hence this is done
+	// on a copy of the operator.
+	validator := &Concat{
+		axis:                 c.axis,
+		maxInputs:            len(inputs),
+		inputTypeConstraints: make([][]tensor.Dtype, len(inputs)),
+	}
 
 	for i := 0; i < len(inputs); i++ {
-		c.inputTypeConstraints[i] = ops.AllTypes
+		validator.inputTypeConstraints[i] = ops.AllTypes
 	}
 
-	return ops.ValidateInputs(c, inputs)
+	return ops.ValidateInputs(validator, inputs)
 }
 
 // GetMinInputs returns the minimum number of input tensors this operator expects.
@@ -0,0 +1,134 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinConcatFromSequenceInputs     = 1
+	MaxConcatFromSequenceInputs     = 1
+	MinConcatFromSequenceAttributes = 1
+	MaxConcatFromSequenceAttributes = 2
+)
+
+// ConcatFromSequence represents the ONNX concatFromSequence operator, which concatenates
+// the tensors of a sequence along an axis. When new_axis is set, the tensors are stacked
+// along a new axis instead.
+type ConcatFromSequence struct {
+	axis    int
+	newAxis bool
+}
+
+// newConcatFromSequence creates a new concatFromSequence operator.
+func newConcatFromSequence() ops.Operator {
+	return &ConcatFromSequence{}
+}
+
+// Init initializes the concatFromSequence operator.
+func (c *ConcatFromSequence) Init(n *onnx.NodeProto) error {
+	attributes := n.GetAttribute()
+	if len(attributes) < MinConcatFromSequenceAttributes || len(attributes) > MaxConcatFromSequenceAttributes {
+		return ops.ErrInvalidOptionalAttributeCount(MinConcatFromSequenceAttributes, MaxConcatFromSequenceAttributes, len(attributes), c)
+	}
+
+	for _, attr := range attributes {
+		switch attr.GetName() {
+		case "axis":
+			c.axis = int(attr.GetI())
+		case "new_axis":
+			c.newAxis = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), c)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the concatFromSequence operator. Its input is a sequence, hence it can
+// only be applied using ApplyValues.
+func (c *ConcatFromSequence) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(c, inputs)
+}
+
+// ApplyValues applies the concatFromSequence operator.
+func (c *ConcatFromSequence) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	tensors, err := ops.SequenceTensors(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tensors) == 0 {
+		return nil, ops.ErrInvalidInput("sequence is empty", c)
+	}
+
+	// With a new axis, the axis can also refer to the dimension after the last one.
+	rank := len(tensors[0].Shape())
+	if c.newAxis {
+		rank++
+	}
+
+	if c.axis < -rank || c.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, c.axis)
+	}
+
+	axis := ops.ConvertNegativeAxis(c.axis, rank)
+
+	if c.newAxis {
+		for i, t := range tensors {
+			shape := t.Shape()
+			newShape := append(shape[:axis:axis], append([]int{1}, shape[axis:]...)...)
+
+			// The tensors of the sequence are views, which are reshaped without
+			// changing the tensors of the sequence itself.
+			tensors[i] = ops.ShallowCopy(t)
+			if err := tensors[i].Reshape(newShape...); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(tensors) == 1 {
+		return []ops.Value{tensors[0]}, nil
+	}
+
+	out, err := tensor.Concat(axis, tensors[0], tensors[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	return []ops.Value{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *ConcatFromSequence) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (c *ConcatFromSequence) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(c, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (c *ConcatFromSequence) GetMinInputs() int {
+	return MinConcatFromSequenceInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (c *ConcatFromSequence) GetMaxInputs() int {
+	return MaxConcatFromSequenceInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *ConcatFromSequence) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *ConcatFromSequence) String() string {
+	return "concatFromSequence operator"
+}
@@ -0,0 +1,122 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestConcatFromSequenceInit(t *testing.T) {
+	c := &ConcatFromSequence{}
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: -1}, {Name: "new_axis", I: 1}}})
+	assert.Nil(t, err)
+	assert.Equal(t, &ConcatFromSequence{axis: -1, newAxis: true}, c)
+
+	err = c.Init(&onnx.NodeProto{})
+	assert.Equal(t, ops.ErrInvalidOptionalAttributeCount(1, 2, 0, c), err)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", c), err)
+}
+
+func TestConcatFromSequence(t *testing.T) {
+	tests := []struct {
+		concatFromSequence *ConcatFromSequence
+		sequence           ops.Value
+		expectedShape      tensor.Shape
+		expected           []float32
+		err                error
+	}{
+		{
+			&ConcatFromSequence{axis: 0},
+			ops.Sequence{ops.Float32TensorFixture(1, 2), ops.Float32TensorFixture(2, 2)},
+			[]int{3, 2},
+			[]float32{0, 1, 0, 1, 2, 3},
+			nil,
+		},
+		{
+			&ConcatFromSequence{axis: -1},
+			ops.Sequence{ops.Float32TensorFixture(2, 1), ops.Float32TensorFixture(2, 2)},
+			[]int{2, 3},
+			[]float32{0, 0, 1, 1, 2, 3},
+			nil,
+		},
+		{
+			&ConcatFromSequence{axis: 0, newAxis: true},
+			ops.Sequence{ops.Float32TensorFixture(2), ops.Float32TensorFixture(2)},
+			[]int{2, 2},
+			[]float32{0, 1, 0, 1},
+			nil,
+		},
+		{
+			&ConcatFromSequence{axis: -1, newAxis: true},
+			ops.Sequence{ops.Float32TensorFixture(2), ops.Float32TensorFixture(2)},
+			[]int{2, 2},
+			[]float32{0, 0, 1, 1},
+			nil,
+		},
+		{
+			&ConcatFromSequence{axis: 1, newAxis: true},
+			ops.Sequence{ops.Float32TensorFixture(3)},
+			[]int{3, 1},
+			[]float32{0, 1, 2},
+			nil,
+		},
+		{
+			&ConcatFromSequence{axis: 1},
+			ops.Sequence{ops.Float32TensorFixture(2)},
+			nil,
+			nil,
+			ops.ErrAxisOutOfRange(-1, 0, 1),
+		},
+		{
+			&ConcatFromSequence{},
+			ops.Sequence{},
+			nil,
+			nil,
+			ops.ErrInvalidInput("sequence is empty", &ConcatFromSequence{}),
+		},
+		{
+			&ConcatFromSequence{},
+			ops.Sequence{ops.Sequence{}},
+			nil,
+			nil,
+			ops.ErrTypeAssert("tensor.Tensor", ops.Sequence{}),
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.concatFromSequence.ApplyValues([]ops.Value{test.sequence})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expectedShape, res[0].(tensor.Tensor).Shape())
+			assert.Equal(t, test.expected, res[0].(tensor.Tensor).Data())
+		}
+	}
+}
+
+func TestConcatFromSequenceKeepsSequence(t *testing.T) {
+	sequence := ops.Sequence{ops.Float32TensorFixture(2), ops.Float32TensorFixture(2)}
+	concatFromSequence := &ConcatFromSequence{newAxis: true}
+
+	_, err := concatFromSequence.ApplyValues([]ops.Value{sequence})
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{2}, sequence[0].(tensor.Tensor).Shape())
+}
+
+func TestInputValidationConcatFromSequence(t *testing.T) {
+	concatFromSequence := &ConcatFromSequence{}
+
+	_, err := concatFromSequence.ValidateValues([]ops.Value{ops.Sequence{ops.Float32TensorFixture(2)}})
+	assert.Nil(t, err)
+
+	_, err = concatFromSequence.ValidateValues([]ops.Value{
+		ops.Sequence{ops.TensorWithBackingFixture([]int{1}, 1)},
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "int", concatFromSequence), err)
+}
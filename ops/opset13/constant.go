@@ -16,8 +16,8 @@ func newConstant() ops.Operator {
 	return &Constant{}
 }
 
-// Init initializes the constant operator. It supports all constant types except
-// `sparse_value`, `value_string`, and `value_strings`.
+// Init initializes the constant operator. It supports all constant types, where a
+// `sparse_value` is converted to a dense tensor.
 func (c *Constant) Init(n *onnx.NodeProto) error {
 	attributes := n.GetAttribute()
 	if len(attributes) != 1 {
@@ -27,8 +27,13 @@ func (c *Constant) Init(n *onnx.NodeProto) error {
 	attr := attributes[0]
 
 	switch attr.GetName() {
-	case "sparse_value", "value_string", "value_strings":
-		return ops.ErrUnsupportedAttribute(attr.GetName(), c)
+	case "sparse_value":
+		t, err := onnx.TensorFromSparseProto(attr.GetSparseTensor())
+		if err != nil {
+			return err
+		}
+
+		c.value = t
 	case "value":
 		t, err := onnx.TensorFromProto(attr.GetT())
 		if err != nil {
@@ -46,6 +51,15 @@ func (c *Constant) Init(n *onnx.NodeProto) error {
 	case "value_ints":
 		ints := attr.GetInts()
 		c.value = tensor.New(tensor.WithShape(len(ints)), tensor.WithBacking(ints))
+	case "value_string":
+		c.value = tensor.New(tensor.FromScalar(string(attr.GetS())))
+	case "value_strings":
+		strs := make([]string, len(attr.GetStrings()))
+		for i, str := range attr.GetStrings() {
+			strs[i] = string(str)
+		}
+
+		c.value = tensor.New(tensor.WithShape(len(strs)), tensor.WithBacking(strs))
 	default:
 		return ops.ErrUnsupportedAttribute(attr.GetName(), c)
 	}
@@ -53,9 +67,11 @@ func (c *Constant) Init(n *onnx.NodeProto) error {
 	return nil
 }
 
-// Apply applies the constant operator.
+// Apply applies the constant operator. The operator is reused for every run of a
+// model, hence a shallow copy of the value is returned such that other operators
+// cannot change the shape of the constant.
 func (c *Constant) Apply(_ []tensor.Tensor) ([]tensor.Tensor, error) {
-	return []tensor.Tensor{c.value}, nil
+	return []tensor.Tensor{ops.ShallowCopy(c.value)}, nil
 }
 
 // ValidateInputs validates the inputs that will be given to Apply for this operator.
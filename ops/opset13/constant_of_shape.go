@@ -11,6 +11,14 @@ const (
 	MaxConstantOfShapeInputs = 1
 )
 
+// constantOfShapeTypes are the dtypes of the value of the constant of shape operator.
+var constantOfShapeTypes = []tensor.Dtype{
+	onnx.Float16Dtype, tensor.Float32, tensor.Float64,
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Bool,
+}
+
 // ConstantOfShape represents the ONNX constant of shape operator.
 type ConstantOfShape struct {
 	// One element tensor, giving the value and type of the output tensor
@@ -25,56 +33,24 @@ func newConstantOfShape() ops.Operator {
 
 // Init initializes the constant of shape operator.
 func (c *ConstantOfShape) Init(n *onnx.NodeProto) error {
-	attributes := n.GetAttribute()
-
-	if len(attributes) > 1 {
-		return ops.ErrInvalidAttributeCount(1, len(attributes), c)
+	value, err := ops.ConstantOfShapeValue(n, constantOfShapeTypes, c)
+	if err != nil {
+		return err
 	}
 
-	if len(attributes) == 1 {
-		attr := attributes[0]
-		if attr.GetName() == "value" {
-			t, err := onnx.TensorFromProto(attr.GetT())
-			if err != nil {
-				return err
-			}
-
-			c.value = tensor.New(tensor.WithBacking(t.Data()))
-			if c.value.Len() != 1 {
-				return ops.ErrInvalidTensor("expected tensor to have one element", c)
-			}
-		} else {
-			return ops.ErrInvalidAttribute(attr.GetName(), c)
-		}
-	} else {
-		c.value = tensor.New(tensor.FromScalar(float32(0.0)))
-	}
+	c.value = value
 
 	return nil
 }
 
 // Apply applies the constant of shape operator.
 func (c *ConstantOfShape) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
-	shape, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[0].Data()))
-	if err != nil {
-		return nil, err
-	}
-
-	// Empty dimensions in a tensor are not supported
-	for i := range shape {
-		if shape[i] <= 0 {
-			return nil, ops.ErrInvalidTensor("empty dimensions are not allowed", c)
-		}
-	}
-
-	t := tensor.New(tensor.WithShape(shape...), tensor.Of(c.value.Dtype()))
-
-	t, err = t.AddScalar(c.value, true)
+	out, err := ops.ConstantOfShape(inputs[0], c.value, c)
 	if err != nil {
 		return nil, err
 	}
 
-	return []tensor.Tensor{t}, err
+	return []tensor.Tensor{out}, nil
 }
 
 // ValidateInputs validates the inputs that will be given to Apply for this operator.
@@ -24,6 +24,29 @@ func TensorProtoFromNumber(n interface{}) *onnx.TensorProto {
 			Dims:     []int64{1},
 			RawData:  rawData,
 		}
+	case uint8:
+		return &onnx.TensorProto{
+			DataType: onnx.TensorProto_DataType_value["UINT8"],
+			Dims:     []int64{1},
+			RawData:  []byte{x},
+		}
+	case bool:
+		var data int32
+		if x {
+			data = 1
+		}
+
+		return &onnx.TensorProto{
+			DataType:  onnx.TensorProto_DataType_value["BOOL"],
+			Dims:      []int64{1},
+			Int32Data: []int32{data},
+		}
+	case onnx.Float16:
+		return &onnx.TensorProto{
+			DataType:  onnx.TensorProto_DataType_value["FLOAT16"],
+			Dims:      []int64{1},
+			Int32Data: []int32{int32(x)},
+		}
 	case int16:
 		// We have to manually make the binary version for rawData
 		size := 2
@@ -66,7 +89,6 @@ func TensorProtoFromNumber(n interface{}) *onnx.TensorProto {
 
 func TestConstantOfShape(t *testing.T) {
 	// Test cases, verifying that all these types work.
-	// Unfortunately uint* and bool are not supported.
 	tests := []struct {
 		input        interface{}
 		expectTensor interface{}
@@ -79,6 +101,9 @@ func TestConstantOfShape(t *testing.T) {
 		{int64(42), []int64{42.0, 42.0, 42.0, 42.0}},
 		{int32(-1), []int32{-1, -1, -1, -1}},
 		{int32(0), []int32{0, 0, 0, 0}},
+		{uint8(42), []uint8{42, 42, 42, 42}},
+		{true, []bool{true, true, true, true}},
+		{onnx.Float16(0x5140), []onnx.Float16{0x5140, 0x5140, 0x5140, 0x5140}},
 	}
 
 	for _, test := range tests {
@@ -93,7 +118,7 @@ func TestConstantOfShape(t *testing.T) {
 			op := ConstantOfShape{}
 			err := op.Init(node)
 			assert.NoError(t, err)
-			assert.Equal(t, test.input, op.value.Data())
+			assert.EqualValues(t, test.input, op.value.Data())
 
 			shape := []int64{2, 2}
 			input := tensor.New(tensor.WithBacking(shape))
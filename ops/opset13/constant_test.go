@@ -41,11 +41,6 @@ func TestConstantInit(t *testing.T) {
 			tensor.New(tensor.WithBacking([]int64{1, 2, 3})),
 			nil,
 		},
-		{
-			[]*onnx.AttributeProto{{Name: "sparse_value"}},
-			nil,
-			ops.ErrUnsupportedAttribute("sparse_value", &Constant{}),
-		},
 		{
 			[]*onnx.AttributeProto{{Name: "unknownAttribute"}},
 			nil,
@@ -101,6 +96,26 @@ func TestConstant(t *testing.T) {
 			ConstantValueIntsAttrProtoFixture(),
 			[]int64{1, 2, 3},
 		},
+		{
+			&Constant{},
+			[]*onnx.AttributeProto{{Name: "value_string", S: []byte("a")}},
+			"a",
+		},
+		{
+			&Constant{},
+			[]*onnx.AttributeProto{{Name: "value_strings", Strings: [][]byte{[]byte("a"), []byte("bc")}}},
+			[]string{"a", "bc"},
+		},
+		{
+			&Constant{},
+			ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{4}, []int64{1, 5}),
+			[]float32{0, 1, 0, 0, 0, 2},
+		},
+		{
+			&Constant{},
+			ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{2, 2}, []int64{0, 1, 1, 0}),
+			[]float32{0, 1, 0, 2, 0, 0},
+		},
 	}
 
 	for _, test := range tests {
@@ -112,6 +127,25 @@ func TestConstant(t *testing.T) {
 	}
 }
 
+func TestConstantSparseValueInvalid(t *testing.T) {
+	tests := []struct {
+		initAttr []*onnx.AttributeProto
+	}{
+		{ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{2}, []int64{1, 6})},
+		{ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{2, 2}, []int64{0, 1, 2, 0})},
+		{ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{1}, []int64{1})},
+		{ConstantSparseValueAttrProtoFixture([]int64{2, 3}, []int64{1, 1, 2}, []int64{1, 2})},
+		{ConstantSparseValueAttrProtoFixture([]int64{}, []int64{2}, []int64{1, 2})},
+	}
+
+	for _, test := range tests {
+		constant := &Constant{}
+		err := constant.Init(&onnx.NodeProto{Attribute: test.initAttr})
+
+		assert.ErrorIs(t, err, onnx.ErrInvalidSparseTensor)
+	}
+}
+
 func TestConstantSingleIntShapeTensor(t *testing.T) {
 	constant := &Constant{}
 	err := constant.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "value_ints", Ints: []int64{2}}}})
@@ -177,3 +211,15 @@ func ConstantValueIntAttrProtoFixture() []*onnx.AttributeProto {
 func ConstantValueIntsAttrProtoFixture() []*onnx.AttributeProto {
 	return []*onnx.AttributeProto{{Name: "value_ints", Ints: []int64{1, 2, 3}}}
 }
+
+// ConstantSparseValueAttrProtoFixture creates a sparse_value attribute with the values
+// 1 and 2 at the given indices, for a float32 tensor of the given dimensions.
+func ConstantSparseValueAttrProtoFixture(dims, indexDims, indices []int64) []*onnx.AttributeProto {
+	sp := &onnx.SparseTensorProto{
+		Values:  &onnx.TensorProto{DataType: int32(onnx.TensorProto_FLOAT), Dims: []int64{2}, FloatData: []float32{1, 2}},
+		Indices: &onnx.TensorProto{DataType: int32(onnx.TensorProto_INT64), Dims: indexDims, Int64Data: indices},
+		Dims:    dims,
+	}
+
+	return []*onnx.AttributeProto{{Name: "sparse_value", SparseTensor: sp}}
+}
@@ -86,8 +86,18 @@ func (c *Conv) Init(n *onnx.NodeProto) error {
 	return nil
 }
 
-// Apply applies the conv operator.
+// Apply applies the conv operator. Attributes that were not set are derived from the
+// inputs. The operator can be used by multiple runs at the same time, hence this is done
+// on a copy of the operator.
 func (c *Conv) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	conv := *c
+
+	return conv.apply(inputs)
+}
+
+// apply applies the conv operator, setting the attributes derived from the inputs
+// on the operator itself.
+func (c *Conv) apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	x := inputs[0]
 	kernel := inputs[1]
 	bias := inputs[2]
@@ -576,6 +586,8 @@ func (c *Conv) addBias(out, bias tensor.Tensor) (tensor.Tensor, error) {
 
 	biasShape[1] = bias.Shape()[0]
 
+	bias = ops.ShallowCopy(bias)
+
 	err := bias.Reshape(biasShape...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,68 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDequantizeLinearInit(t *testing.T) {
+	d := newDequantizeLinear().(*DequantizeLinear)
+	assert.Equal(t, 1, d.axis)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: -1}}})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, d.axis)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", d), err)
+}
+
+func TestDequantizeLinear(t *testing.T) {
+	d := &DequantizeLinear{axis: 1}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]uint8{0, 2, 4, 6}, 2, 2),
+		ops.TensorWithBackingFixture([]float32{1, 0.5}, 2),
+		ops.TensorWithBackingFixture([]uint8{2, 0}, 2),
+	}
+
+	res, err := d.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{-2, 1, 2, 3}, res[0].Data())
+
+	inputs[2] = ops.TensorWithBackingFixture([]int8{2, 0}, 2)
+	_, err = d.Apply(inputs)
+	assert.Equal(t, ops.ErrInvalidTensor("DType of 'x_zero_point' does not match DType of 'x'", d), err)
+}
+
+func TestInputValidationDequantizeLinear(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			ops.ErrInvalidInputType(0, "float32", &DequantizeLinear{}),
+		},
+	}
+
+	for _, test := range tests {
+		dequantizeLinear := &DequantizeLinear{}
+		_, err := dequantizeLinear.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
@@ -58,6 +58,18 @@ func TestEqual(t *testing.T) {
 	}
 }
 
+func TestEqualStrings(t *testing.T) {
+	equal := &Equal{}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]string{"a", "b", "c", "d"}, 2, 2),
+		ops.TensorWithBackingFixture([]string{"a", "d"}, 2),
+	}
+
+	res, err := equal.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false, false, true}, res[0].Data())
+}
+
 func TestInputValidationEqual(t *testing.T) {
 	tests := []struct {
 		inputs []tensor.Tensor
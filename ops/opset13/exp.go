@@ -0,0 +1,75 @@
+package opset13
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+// Exp represents the ONNX exp operator.
+type Exp struct{}
+
+// newExp creates a new exp operator.
+func newExp() ops.Operator {
+	return &Exp{}
+}
+
+// Init initializes the exp operator.
+func (s *Exp) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the exp operator.
+func (s *Exp) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	var (
+		out tensor.Tensor
+		err error
+	)
+
+	switch inputs[0].Dtype() {
+	case tensor.Float32:
+		out, err = inputs[0].Apply(exp[float32])
+	case tensor.Float64:
+		out, err = inputs[0].Apply(exp[float64])
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), s)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Exp) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *Exp) GetMinInputs() int {
+	return 1
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *Exp) GetMaxInputs() int {
+	return 1
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Exp) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Exp) String() string {
+	return "exp operator"
+}
+
+func exp[T ops.FloatType](x T) T {
+	return T(math.Exp(float64(x)))
+}
@@ -0,0 +1,91 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestExpInit(t *testing.T) {
+	a := &Exp{}
+
+	// since 'exp' does not have any attributes we pass in nil. This should not
+	// fail initializing the exp.
+	err := a.Init(nil)
+	assert.Nil(t, err)
+}
+
+func TestExp(t *testing.T) {
+	tests := []struct {
+		exp      *Exp
+		backing  []float32
+		shape    []int
+		expected []float32
+	}{
+		{
+			&Exp{},
+			[]float32{-1, 0, 1, 2},
+			[]int{2, 2},
+			[]float32{0.36787945, 1, 2.7182817, 7.389056},
+		},
+		{
+			&Exp{},
+			[]float32{3, 0.5},
+			[]int{2},
+			[]float32{20.085537, 1.6487212},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture(test.backing, test.shape...),
+		}
+
+		res, err := test.exp.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-6)
+	}
+}
+
+func TestInputValidationExp(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &Exp{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int{1, 2}, 2),
+			},
+			ops.ErrInvalidInputType(0, "int", &Exp{}),
+		},
+	}
+
+	for _, test := range tests {
+		exp := &Exp{}
+		validated, err := exp.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
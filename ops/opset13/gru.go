@@ -1,6 +1,8 @@
 package opset13
 
 import (
+	"context"
+
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
 	"gorgonia.org/tensor"
@@ -70,6 +72,12 @@ func (g *GRU) Init(n *onnx.NodeProto) error {
 
 // Apply applies the gru operator.
 func (g *GRU) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return g.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the gru operator. The context is checked before every time step,
+// such that the calculation stops when the context is done.
+func (g *GRU) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	if inputs[4] != nil {
 		return nil, ops.ErrUnsupportedInput("sequence lens", g)
 	}
@@ -109,6 +117,8 @@ func (g *GRU) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	// we do not support bidirectional GRU yet.
 	shapeWithoutBidir := prevH.Shape().Clone()[1:]
 
+	prevH = ops.ShallowCopy(prevH)
+
 	err = prevH.Reshape(shapeWithoutBidir...)
 	if err != nil {
 		return nil, err
@@ -127,6 +137,10 @@ func (g *GRU) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	outputs := []tensor.Tensor{}
 
 	for i := 0; i < seqLength; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		Xt, err := g.extractXt(X, i)
 		if err != nil {
 			return nil, err
@@ -1,6 +1,7 @@
 package opset13
 
 import (
+	"context"
 	"testing"
 
 	"github.com/advancedclimatesystems/gonnx/onnx"
@@ -116,6 +117,23 @@ func TestGru(t *testing.T) {
 	}
 }
 
+func TestGRUApplyContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	gru := &GRU{
+		activationAlpha: []float32{},
+		activationBeta:  []float32{},
+		activations:     []string{"sigmoid", "tanh"},
+		direction:       ops.Forward,
+		hiddenSize:      4,
+	}
+
+	res, err := gru.ApplyContext(ctx, gruInput0())
+	assert.Nil(t, res)
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestInputValidationGRU(t *testing.T) {
 	tests := []struct {
 		inputs   []tensor.Tensor
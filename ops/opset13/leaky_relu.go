@@ -0,0 +1,96 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinLeakyReluInputs    = 1
+	MaxLeakyReluInputs    = 1
+	LeakyReluDefaultAlpha = 0.01
+)
+
+// LeakyRelu represents the ONNX leakyRelu operator.
+type LeakyRelu struct {
+	alpha float32
+}
+
+// newLeakyRelu creates a new leakyRelu operator.
+func newLeakyRelu() ops.Operator {
+	return &LeakyRelu{alpha: LeakyReluDefaultAlpha}
+}
+
+// Init initializes the leakyRelu operator.
+func (l *LeakyRelu) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "alpha":
+			l.alpha = attr.GetF()
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), l)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the leakyRelu operator.
+func (l *LeakyRelu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	var (
+		out tensor.Tensor
+		err error
+	)
+
+	switch inputs[0].Dtype() {
+	case tensor.Float32:
+		out, err = inputs[0].Apply(leakyRelu(l.alpha))
+	case tensor.Float64:
+		out, err = inputs[0].Apply(leakyRelu(float64(l.alpha)))
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), l)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (l *LeakyRelu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(l, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (l *LeakyRelu) GetMinInputs() int {
+	return MinLeakyReluInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (l *LeakyRelu) GetMaxInputs() int {
+	return MaxLeakyReluInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (l *LeakyRelu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (l *LeakyRelu) String() string {
+	return "leakyRelu operator"
+}
+
+func leakyRelu[T ops.FloatType](alpha T) func(T) T {
+	return func(x T) T {
+		if x < 0 {
+			return alpha * x
+		}
+
+		return x
+	}
+}
@@ -0,0 +1,72 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestLeakyReluInit(t *testing.T) {
+	l := newLeakyRelu().(*LeakyRelu)
+	assert.Equal(t, float32(0.01), l.alpha)
+
+	err := l.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "alpha", F: 0.1}}})
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0.1), l.alpha)
+
+	err = l.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", l), err)
+}
+
+func TestLeakyRelu(t *testing.T) {
+	tests := []struct {
+		leakyRelu *LeakyRelu
+		backing   any
+		expected  any
+	}{
+		{&LeakyRelu{alpha: 0.1}, []float32{-2, -1, 0, 1}, []float32{-0.2, -0.1, 0, 1}},
+		{&LeakyRelu{alpha: 0.5}, []float64{-2, -1, 0, 1}, []float64{-1, -0.5, 0, 1}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture(test.backing, 4)}
+
+		res, err := test.leakyRelu.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationLeakyRelu(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &LeakyRelu{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "int32", &LeakyRelu{}),
+		},
+	}
+
+	for _, test := range tests {
+		leakyRelu := &LeakyRelu{}
+		validated, err := leakyRelu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestLogInit(t *testing.T) {
+	a := &Log{}
+
+	// since 'log' does not have any attributes we pass in nil. This should not
+	// fail initializing the log.
+	err := a.Init(nil)
+	assert.Nil(t, err)
+}
+
+func TestLog(t *testing.T) {
+	tests := []struct {
+		log      *Log
+		backing  []float32
+		shape    []int
+		expected []float32
+	}{
+		{
+			&Log{},
+			[]float32{1, 2.7182817, 10, 0.5},
+			[]int{2, 2},
+			[]float32{0, 0.99999994, 2.3025851, -0.6931472},
+		},
+		{
+			&Log{},
+			[]float32{100, 1},
+			[]int{2},
+			[]float32{4.6051702, 0},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture(test.backing, test.shape...),
+		}
+
+		res, err := test.log.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-6)
+	}
+}
+
+func TestInputValidationLog(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &Log{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int{1, 2}, 2),
+			},
+			ops.ErrInvalidInputType(0, "int", &Log{}),
+		},
+	}
+
+	for _, test := range tests {
+		log := &Log{}
+		validated, err := log.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
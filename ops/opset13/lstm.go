@@ -1,6 +1,8 @@
 package opset13
 
 import (
+	"context"
+
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
 	"gorgonia.org/tensor"
@@ -71,6 +73,12 @@ func (l *LSTM) Init(n *onnx.NodeProto) error {
 
 // Apply applies the lstm operator.
 func (l *LSTM) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return l.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the lstm operator. The context is checked before every time step,
+// such that the calculation stops when the context is done.
+func (l *LSTM) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	if inputs[4] != nil {
 		return nil, ops.ErrUnsupportedInput("sequence_lens", l)
 	}
@@ -123,7 +131,10 @@ func (l *LSTM) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 
 	// Reshape the hidden and cell tensor without the bidirectional dimension, as
 	// we do not support bidirectional yet. This is the dimension at
-	// index 0.
+	// index 0. The tensors are copied first, such that the inputs are not changed.
+	Ht = ops.ShallowCopy(Ht)
+	Ct = ops.ShallowCopy(Ct)
+
 	if err = Ht.Reshape(Ht.Shape().Clone()[1:]...); err != nil {
 		return nil, err
 	}
@@ -152,6 +163,10 @@ func (l *LSTM) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	// Loop over all timesteps of the input, applying the LSTM calculation to every
 	// timesteps while updating the hidden tensor.
 	for t := 0; t < seqLength; t++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		Xt, err := X.Slice(ops.NewSlicer(t, t+1), nil, nil)
 		if err != nil {
 			return nil, err
@@ -1,6 +1,7 @@
 package opset13
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -131,6 +132,24 @@ func TestLSTM(t *testing.T) {
 	}
 }
 
+func TestLSTMApplyContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	lstm := &LSTM{
+		activationAlpha: []float32{},
+		activationBeta:  []float32{},
+		activations:     []string{"sigmoid", "tanh", "tanh"},
+		direction:       ops.Forward,
+		hiddenSize:      4,
+		outputs:         []string{"Y", "Y_h", "Y_c"},
+	}
+
+	res, err := lstm.ApplyContext(ctx, lstmInput0())
+	assert.Nil(t, res)
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestInputValidationLSTM(t *testing.T) {
 	tests := []struct {
 		inputs   []tensor.Tensor
@@ -1,71 +1,100 @@
 package opset13
 
 import (
+	"slices"
+
 	"github.com/advancedclimatesystems/gonnx/ops"
 )
 
-var operators13 = map[string]func() ops.Operator{
-	"Abs":             newAbs,
-	"Acos":            newAcos,
-	"Acosh":           newAcosh,
-	"Add":             newAdd,
-	"And":             newAnd,
-	"ArgMax":          newArgMax,
-	"Asin":            newAsin,
-	"Asinh":           newAsinh,
-	"Atan":            newAtan,
-	"Atanh":           newAtanh,
-	"Cast":            newCast,
-	"Concat":          newConcat,
-	"Constant":        newConstant,
-	"ConstantOfShape": newConstantOfShape,
-	"Conv":            newConv,
-	"Cos":             newCos,
-	"Cosh":            newCosh,
-	"Div":             newDiv,
-	"Equal":           newEqual,
-	"Expand":          newExpand,
-	"Flatten":         newFlatten,
-	"Gather":          newGather,
-	"Gemm":            newGemm,
-	"Greater":         newGreater,
-	"GreaterOrEqual":  newGreaterOrEqual,
-	"GRU":             newGRU,
-	"Less":            newLess,
-	"LessOrEqual":     newLessOrEqual,
-	"LinearRegressor": newLinearRegressor,
-	"LogSoftmax":      newLogSoftmax,
-	"LSTM":            newLSTM,
-	"MatMul":          newMatMul,
-	"Mul":             newMul,
-	"Not":             newNot,
-	"Or":              newOr,
-	"PRelu":           newPRelu,
-	"ReduceMax":       newReduceMax,
-	"ReduceMin":       newReduceMin,
-	"Relu":            newRelu,
-	"Reshape":         newReshape,
-	"RNN":             newRNN,
-	"Scaler":          newScaler,
-	"Shape":           newShape,
-	"Sigmoid":         newSigmoid,
-	"Sin":             newSin,
-	"Sinh":            newSinh,
-	"Slice":           newSlice,
-	"Softmax":         newSoftmax,
-	"Squeeze":         newSqueeze,
-	"Sub":             newSub,
-	"Tan":             newTan,
-	"Tanh":            newTanh,
-	"Transpose":       newTranspose,
-	"Unsqueeze":       newUnsqueeze,
-	"Xor":             newXor,
+// operators13 holds the operators of the default ONNX domain valid in opset 13. The since
+// version of an operator is the oldest opset in which the operator behaves the same as in
+// opset 13, apart from the types added in later versions.
+var operators13 = []ops.OperatorVersion{
+	{OpType: "Abs", SinceVersion: 6, New: newAbs},
+	{OpType: "Acos", SinceVersion: 7, New: newAcos},
+	{OpType: "Acosh", SinceVersion: 9, New: newAcosh},
+	{OpType: "Add", SinceVersion: 7, New: newAdd},
+	{OpType: "And", SinceVersion: 7, New: newAnd},
+	{OpType: "ArgMax", SinceVersion: 1, New: newArgMax},
+	{OpType: "Asin", SinceVersion: 7, New: newAsin},
+	{OpType: "Asinh", SinceVersion: 9, New: newAsinh},
+	{OpType: "Atan", SinceVersion: 7, New: newAtan},
+	{OpType: "Atanh", SinceVersion: 9, New: newAtanh},
+	{OpType: "Cast", SinceVersion: 6, New: newCast},
+	{OpType: "Clip", SinceVersion: 11, New: newClip},
+	{OpType: "Concat", SinceVersion: 4, New: newConcat},
+	{OpType: "ConcatFromSequence", SinceVersion: 11, New: newConcatFromSequence},
+	{OpType: "Constant", SinceVersion: 1, New: newConstant},
+	{OpType: "ConstantOfShape", SinceVersion: 9, New: newConstantOfShape},
+	{OpType: "Conv", SinceVersion: 1, New: newConv},
+	{OpType: "Cos", SinceVersion: 7, New: newCos},
+	{OpType: "Cosh", SinceVersion: 9, New: newCosh},
+	{OpType: "DequantizeLinear", SinceVersion: 10, New: newDequantizeLinear},
+	{OpType: "Div", SinceVersion: 7, New: newDiv},
+	{OpType: "Equal", SinceVersion: 7, New: newEqual},
+	{OpType: "Exp", SinceVersion: 6, New: newExp},
+	{OpType: "Expand", SinceVersion: 8, New: newExpand},
+	{OpType: "Flatten", SinceVersion: 1, New: newFlatten},
+	{OpType: "Gather", SinceVersion: 1, New: newGather},
+	{OpType: "Gemm", SinceVersion: 7, New: newGemm},
+	{OpType: "Greater", SinceVersion: 7, New: newGreater},
+	{OpType: "GreaterOrEqual", SinceVersion: 12, New: newGreaterOrEqual},
+	{OpType: "GRU", SinceVersion: 7, New: newGRU},
+	{OpType: "LeakyRelu", SinceVersion: 6, New: newLeakyRelu},
+	{OpType: "Less", SinceVersion: 7, New: newLess},
+	{OpType: "LessOrEqual", SinceVersion: 12, New: newLessOrEqual},
+	{OpType: "Log", SinceVersion: 6, New: newLog},
+	{OpType: "LogSoftmax", SinceVersion: 13, New: newLogSoftmax},
+	{OpType: "LSTM", SinceVersion: 7, New: newLSTM},
+	{OpType: "MatMul", SinceVersion: 1, New: newMatMul},
+	{OpType: "Mul", SinceVersion: 7, New: newMul},
+	{OpType: "Not", SinceVersion: 1, New: newNot},
+	{OpType: "Or", SinceVersion: 7, New: newOr},
+	{OpType: "Pad", SinceVersion: 11, New: newPad},
+	{OpType: "PRelu", SinceVersion: 7, New: newPRelu},
+	{OpType: "QuantizeLinear", SinceVersion: 10, New: newQuantizeLinear},
+	{OpType: "ReduceMax", SinceVersion: 1, New: newReduceMax},
+	{OpType: "ReduceMin", SinceVersion: 1, New: newReduceMin},
+	{OpType: "ReduceSum", SinceVersion: 13, New: newReduceSum},
+	{OpType: "Relu", SinceVersion: 6, New: newRelu},
+	{OpType: "Reshape", SinceVersion: 5, New: newReshape},
+	{OpType: "Resize", SinceVersion: 13, New: newResize},
+	{OpType: "RNN", SinceVersion: 7, New: newRNN},
+	{OpType: "ScatterElements", SinceVersion: 11, New: newScatterElements},
+	{OpType: "ScatterND", SinceVersion: 11, New: newScatterND},
+	{OpType: "SequenceAt", SinceVersion: 11, New: newSequenceAt},
+	{OpType: "SequenceConstruct", SinceVersion: 11, New: newSequenceConstruct},
+	{OpType: "SequenceLength", SinceVersion: 11, New: newSequenceLength},
+	{OpType: "Shape", SinceVersion: 1, New: newShape},
+	{OpType: "Sigmoid", SinceVersion: 6, New: newSigmoid},
+	{OpType: "Sin", SinceVersion: 7, New: newSin},
+	{OpType: "Sinh", SinceVersion: 9, New: newSinh},
+	{OpType: "Slice", SinceVersion: 10, New: newSlice},
+	{OpType: "Softmax", SinceVersion: 13, New: newSoftmax},
+	{OpType: "Split", SinceVersion: 13, New: newSplit},
+	{OpType: "SplitToSequence", SinceVersion: 11, New: newSplitToSequence},
+	{OpType: "Squeeze", SinceVersion: 13, New: newSqueeze},
+	{OpType: "Sub", SinceVersion: 7, New: newSub},
+	{OpType: "Tan", SinceVersion: 7, New: newTan},
+	{OpType: "Tanh", SinceVersion: 6, New: newTanh},
+	{OpType: "Transpose", SinceVersion: 1, New: newTranspose},
+	{OpType: "Unsqueeze", SinceVersion: 13, New: newUnsqueeze},
+	{OpType: "Where", SinceVersion: 9, New: newWhere},
+	{OpType: "Xor", SinceVersion: 7, New: newXor},
+}
+
+// operatorsML holds the operators of the ai.onnx.ml domain. The implemented operators did
+// not change between the versions of the domain, hence a single list is used for all.
+var operatorsML = []ops.OperatorVersion{
+	{OpType: "LinearRegressor", SinceVersion: 1, New: newLinearRegressor},
+	{OpType: "Scaler", SinceVersion: 1, New: newScaler},
+	{OpType: "ZipMap", SinceVersion: 1, New: newZipMap},
 }
 
 // GetOperator maps strings as found in the ModelProto to Operators from opset 13.
 func GetOperator(operatorType string) (ops.Operator, error) {
-	if opInit, ok := operators13[operatorType]; ok {
-		return opInit(), nil
+	if op, ok := ops.NewOperator(operators13, operatorType); ok {
+		return op, nil
 	}
 
 	return nil, ops.ErrUnknownOperatorType(operatorType)
@@ -73,11 +102,27 @@ func GetOperator(operatorType string) (ops.Operator, error) {
 
 // GetOpNames returns a list with operator names for opset 13.
 func GetOpNames() []string {
-	opList := make([]string, 0, len(operators13))
+	return ops.OperatorTypes(operators13)
+}
 
-	for opName := range operators13 {
-		opList = append(opList, opName)
+// GetMLOperator maps strings as found in the ModelProto to Operators of the ai.onnx.ml
+// domain.
+func GetMLOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operatorsML, operatorType); ok {
+		return op, nil
 	}
 
-	return opList
+	return nil, ops.ErrUnknownOperatorType(operatorType)
+}
+
+// GetOperatorVersions returns the operators of the default ONNX domain valid in opset 13,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators13)
+}
+
+// GetMLOperatorVersions returns the operators of the ai.onnx.ml domain, together with the
+// version of the domain since which they are valid.
+func GetMLOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operatorsML)
 }
@@ -69,11 +69,21 @@ func TestGetOperator(t *testing.T) {
 			newCast(),
 			nil,
 		},
+		{
+			"Clip",
+			newClip(),
+			nil,
+		},
 		{
 			"Concat",
 			newConcat(),
 			nil,
 		},
+		{
+			"ConcatFromSequence",
+			newConcatFromSequence(),
+			nil,
+		},
 		{
 			"Constant",
 			newConstant(),
@@ -99,6 +109,11 @@ func TestGetOperator(t *testing.T) {
 			newCosh(),
 			nil,
 		},
+		{
+			"DequantizeLinear",
+			newDequantizeLinear(),
+			nil,
+		},
 		{
 			"Div",
 			newDiv(),
@@ -109,6 +124,11 @@ func TestGetOperator(t *testing.T) {
 			newEqual(),
 			nil,
 		},
+		{
+			"Exp",
+			newExp(),
+			nil,
+		},
 		{
 			"Expand",
 			newExpand(),
@@ -144,6 +164,11 @@ func TestGetOperator(t *testing.T) {
 			newGRU(),
 			nil,
 		},
+		{
+			"LeakyRelu",
+			newLeakyRelu(),
+			nil,
+		},
 		{
 			"Less",
 			newLess(),
@@ -155,8 +180,8 @@ func TestGetOperator(t *testing.T) {
 			nil,
 		},
 		{
-			"LinearRegressor",
-			newLinearRegressor(),
+			"Log",
+			newLog(),
 			nil,
 		},
 		{
@@ -189,11 +214,21 @@ func TestGetOperator(t *testing.T) {
 			newOr(),
 			nil,
 		},
+		{
+			"Pad",
+			newPad(),
+			nil,
+		},
 		{
 			"PRelu",
 			newPRelu(),
 			nil,
 		},
+		{
+			"QuantizeLinear",
+			newQuantizeLinear(),
+			nil,
+		},
 		{
 			"ReduceMax",
 			newReduceMax(),
@@ -204,6 +239,11 @@ func TestGetOperator(t *testing.T) {
 			newReduceMin(),
 			nil,
 		},
+		{
+			"ReduceSum",
+			newReduceSum(),
+			nil,
+		},
 		{
 			"Relu",
 			newRelu(),
@@ -214,14 +254,39 @@ func TestGetOperator(t *testing.T) {
 			newReshape(),
 			nil,
 		},
+		{
+			"Resize",
+			newResize(),
+			nil,
+		},
 		{
 			"RNN",
 			newRNN(),
 			nil,
 		},
 		{
-			"Scaler",
-			newScaler(),
+			"ScatterElements",
+			newScatterElements(),
+			nil,
+		},
+		{
+			"ScatterND",
+			newScatterND(),
+			nil,
+		},
+		{
+			"SequenceAt",
+			newSequenceAt(),
+			nil,
+		},
+		{
+			"SequenceConstruct",
+			newSequenceConstruct(),
+			nil,
+		},
+		{
+			"SequenceLength",
+			newSequenceLength(),
 			nil,
 		},
 		{
@@ -254,6 +319,16 @@ func TestGetOperator(t *testing.T) {
 			newSoftmax(),
 			nil,
 		},
+		{
+			"Split",
+			newSplit(),
+			nil,
+		},
+		{
+			"SplitToSequence",
+			newSplitToSequence(),
+			nil,
+		},
 		{
 			"Squeeze",
 			newSqueeze(),
@@ -284,6 +359,11 @@ func TestGetOperator(t *testing.T) {
 			newUnsqueeze(),
 			nil,
 		},
+		{
+			"Where",
+			newWhere(),
+			nil,
+		},
 		{
 			"Xor",
 			newXor(),
@@ -303,3 +383,55 @@ func TestGetOperator(t *testing.T) {
 		assert.Equal(t, test.err, err)
 	}
 }
+
+func TestGetMLOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{
+			"LinearRegressor",
+			newLinearRegressor(),
+			nil,
+		},
+		{
+			"Scaler",
+			newScaler(),
+			nil,
+		},
+		{
+			"ZipMap",
+			newZipMap(),
+			nil,
+		},
+		{
+			"Abs",
+			nil,
+			ops.ErrUnknownOperatorType("Abs"),
+		},
+	}
+
+	for _, test := range tests {
+		op, err := GetMLOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.GreaterOrEqual(t, version.SinceVersion, int64(1))
+		assert.LessOrEqual(t, version.SinceVersion, int64(13))
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+
+	assert.Equal(t, len(operatorsML), len(GetMLOperatorVersions()))
+}
@@ -0,0 +1,90 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinPadInputs = 2
+	MaxPadInputs = 3
+)
+
+// Pad represents the ONNX pad operator, which pads the input with the number of elements
+// given by the pads input, at the beginning and at the end of every axis.
+type Pad struct {
+	mode ops.PadMode
+}
+
+// newPad creates a new pad operator.
+func newPad() ops.Operator {
+	return &Pad{
+		mode: ops.PadConstant,
+	}
+}
+
+// Init initializes the pad operator.
+func (p *Pad) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "mode":
+			switch mode := ops.PadMode(attr.GetS()); mode {
+			case ops.PadConstant, ops.PadReflect, ops.PadEdge:
+				p.mode = mode
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), p)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), p)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the pad operator.
+func (p *Pad) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	pads, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	var constantValue any
+	if inputs[2] != nil {
+		constantValue = inputs[2].Data()
+	}
+
+	out, err := ops.Pad(inputs[0], pads, p.mode, constantValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (p *Pad) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(p, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (p *Pad) GetMinInputs() int {
+	return MinPadInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (p *Pad) GetMaxInputs() int {
+	return MaxPadInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (p *Pad) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}, ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (p *Pad) String() string {
+	return "pad operator"
+}
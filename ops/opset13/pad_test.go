@@ -0,0 +1,80 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestPadInit(t *testing.T) {
+	p := newPad().(*Pad)
+	assert.Equal(t, ops.PadConstant, p.mode)
+
+	err := p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("reflect")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.PadReflect, p.mode)
+
+	err = p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("wrap")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", p), err)
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		pad           *Pad
+		constantValue tensor.Tensor
+		expected      []float32
+	}{
+		{&Pad{mode: ops.PadConstant}, nil, []float32{0, 1, 2, 0, 3, 4}},
+		{&Pad{mode: ops.PadConstant}, tensor.New(tensor.FromScalar(float32(5))), []float32{5, 1, 2, 5, 3, 4}},
+		{&Pad{mode: ops.PadEdge}, nil, []float32{1, 1, 2, 3, 3, 4}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			ops.TensorWithBackingFixture([]int64{0, 1, 0, 0}, 4),
+			test.constantValue,
+		}
+
+		res, err := test.pad.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, tensor.Shape{2, 3}, res[0].Shape())
+	}
+}
+
+func TestInputValidationPad(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]int64{1, 1}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1, 1}, 2),
+			},
+			ops.ErrInvalidInputType(1, "int32", &Pad{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			ops.ErrInvalidOptionalInputCount(1, &Pad{}),
+		},
+	}
+
+	for _, test := range tests {
+		pad := &Pad{}
+		_, err := pad.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
@@ -0,0 +1,79 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinQuantizeLinearInputs = 2
+	MaxQuantizeLinearInputs = 3
+)
+
+// QuantizeLinear represents the ONNX quantizeLinear operator, which quantizes a tensor
+// using a scale and a zero point, per tensor or per axis.
+type QuantizeLinear struct {
+	axis int
+}
+
+// newQuantizeLinear creates a new quantizeLinear operator.
+func newQuantizeLinear() ops.Operator {
+	return &QuantizeLinear{
+		axis: 1,
+	}
+}
+
+// Init initializes the quantizeLinear operator.
+func (q *QuantizeLinear) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			q.axis = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), q)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the quantizeLinear operator.
+func (q *QuantizeLinear) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.QuantizeLinear(inputs[0], inputs[1], inputs[2], q.axis, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (q *QuantizeLinear) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(q, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (q *QuantizeLinear) GetMinInputs() int {
+	return MinQuantizeLinearInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (q *QuantizeLinear) GetMaxInputs() int {
+	return MaxQuantizeLinearInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (q *QuantizeLinear) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Int32},
+		{tensor.Float32},
+		{tensor.Int8, tensor.Uint8},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (q *QuantizeLinear) String() string {
+	return "quantizeLinear operator"
+}
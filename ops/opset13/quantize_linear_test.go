@@ -0,0 +1,83 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestQuantizeLinearInit(t *testing.T) {
+	q := newQuantizeLinear().(*QuantizeLinear)
+	assert.Equal(t, 1, q.axis)
+
+	err := q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, q.axis)
+
+	err = q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "saturate", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("saturate", q), err)
+}
+
+func TestQuantizeLinear(t *testing.T) {
+	tests := []struct {
+		quantizeLinear *QuantizeLinear
+		zeroPoint      tensor.Tensor
+		expected       any
+	}{
+		{&QuantizeLinear{axis: 1}, nil, []uint8{0, 1, 2, 0}},
+		{&QuantizeLinear{axis: 1}, tensor.New(tensor.FromScalar(int8(-1))), []int8{-1, 0, 1, -3}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{0, 2, 5, -4}, 4),
+			tensor.New(tensor.FromScalar(float32(2))),
+			test.zeroPoint,
+		}
+
+		res, err := test.quantizeLinear.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationQuantizeLinear(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+			},
+			ops.ErrInvalidInputType(2, "int32", &QuantizeLinear{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			ops.ErrInvalidOptionalInputCount(1, &QuantizeLinear{}),
+		},
+	}
+
+	for _, test := range tests {
+		quantizeLinear := &QuantizeLinear{}
+		validated, err := quantizeLinear.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 3, len(validated))
+		}
+	}
+}
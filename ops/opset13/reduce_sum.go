@@ -0,0 +1,83 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinReduceSumInputs = 1
+	MaxReduceSumInputs = 2
+)
+
+// reduceTypes are the types which can be reduced by the reduce operators.
+var reduceTypes = []tensor.Dtype{
+	tensor.Uint32, tensor.Uint64, tensor.Int32, tensor.Int64, tensor.Float32, tensor.Float64,
+}
+
+// ReduceSum represents the ONNX reduceSum operator. The axes to reduce are given as an
+// optional input.
+type ReduceSum struct {
+	keepDims          bool
+	noopWithEmptyAxes bool
+}
+
+// newReduceSum creates a new reduceSum operator.
+func newReduceSum() ops.Operator {
+	return &ReduceSum{
+		keepDims: true,
+	}
+}
+
+// Init initializes the reduceSum operator.
+func (r *ReduceSum) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "keepdims":
+			r.keepDims = ops.Int64ToBool(attr.GetI())
+		case "noop_with_empty_axes":
+			r.noopWithEmptyAxes = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the reduceSum operator.
+func (r *ReduceSum) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ReduceWithAxes(inputs[0], inputs[1], r.keepDims, r.noopWithEmptyAxes, ops.ReductionSum)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *ReduceSum) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *ReduceSum) GetMinInputs() int {
+	return MinReduceSumInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *ReduceSum) GetMaxInputs() int {
+	return MaxReduceSumInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *ReduceSum) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{reduceTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *ReduceSum) String() string {
+	return "reduceSum operator"
+}
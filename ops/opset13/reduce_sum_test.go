@@ -0,0 +1,122 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReduceSumInit(t *testing.T) {
+	r := newReduceSum().(*ReduceSum)
+	assert.True(t, r.keepDims)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "keepdims", I: 0},
+		{Name: "noop_with_empty_axes", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, r.keepDims)
+	assert.True(t, r.noopWithEmptyAxes)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", r), err)
+}
+
+func TestReduceSum(t *testing.T) {
+	tests := []struct {
+		reduceSum     *ReduceSum
+		axes          tensor.Tensor
+		expected      any
+		expectedShape tensor.Shape
+	}{
+		{
+			&ReduceSum{keepDims: true},
+			ops.TensorWithBackingFixture([]int64{1}, 1),
+			[]float32{3, 7},
+			tensor.Shape{2, 1},
+		},
+		{
+			&ReduceSum{keepDims: false},
+			ops.TensorWithBackingFixture([]int64{-2}, 1),
+			[]float32{4, 6},
+			tensor.Shape{2},
+		},
+		{
+			&ReduceSum{keepDims: true},
+			nil,
+			[]float32{10},
+			tensor.Shape{1, 1},
+		},
+		{
+			&ReduceSum{keepDims: true, noopWithEmptyAxes: true},
+			nil,
+			[]float32{1, 2, 3, 4},
+			tensor.Shape{2, 2},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			test.axes,
+		}
+
+		res, err := test.reduceSum.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationReduceSum(t *testing.T) {
+	tests := []struct {
+		inputs   []tensor.Tensor
+		expected []tensor.Tensor
+		err      error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2), nil},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{0}, 1),
+			},
+			nil,
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{0}, 1),
+			},
+			nil,
+			ops.ErrInvalidInputType(1, "int32", &ReduceSum{}),
+		},
+		{
+			[]tensor.Tensor{},
+			nil,
+			ops.ErrInvalidOptionalInputCount(0, &ReduceSum{}),
+		},
+	}
+
+	for _, test := range tests {
+		reduceSum := &ReduceSum{}
+		validated, err := reduceSum.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			if test.expected != nil {
+				assert.Equal(t, test.expected, validated)
+			} else {
+				assert.Equal(t, test.inputs, validated)
+			}
+		}
+	}
+}
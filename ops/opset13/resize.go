@@ -0,0 +1,87 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinResizeInputs = 1
+	MaxResizeInputs = 4
+)
+
+// Resize represents the ONNX resize operator, which resizes the input by the given scales
+// or to the given sizes, using the nearest value, linear or cubic interpolation.
+type Resize struct {
+	attributes ops.ResizeAttributes
+}
+
+// newResize creates a new resize operator.
+func newResize() ops.Operator {
+	return &Resize{
+		attributes: ops.NewResizeAttributes(),
+	}
+}
+
+// Init initializes the resize operator.
+func (r *Resize) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "antialias", "axes", "keep_aspect_ratio_policy":
+			// These attributes are introduced in opset 18.
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		case "coordinate_transformation_mode":
+			if ops.CoordinateTransformation(attr.GetS()) == ops.TransformHalfPixelSymmetric {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), r)
+			}
+		}
+
+		if err := r.attributes.SetAttribute(attr, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the resize operator.
+func (r *Resize) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ResizeWithInputs(inputs[0], inputs[1], inputs[2], inputs[3], r.attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *Resize) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateResizeInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *Resize) GetMinInputs() int {
+	return MinResizeInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *Resize) GetMaxInputs() int {
+	return MaxResizeInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *Resize) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		ops.ResizeTypes,
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32},
+		{tensor.Int64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *Resize) String() string {
+	return "resize operator"
+}
@@ -0,0 +1,128 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestResizeInit(t *testing.T) {
+	r := newResize().(*Resize)
+	assert.Equal(t, ops.NewResizeAttributes(), r.attributes)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "mode", S: []byte("cubic")},
+		{Name: "coordinate_transformation_mode", S: []byte("align_corners")},
+		{Name: "cubic_coeff_a", F: -0.5},
+		{Name: "exclude_outside", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.ResizeCubic, r.attributes.Mode)
+	assert.Equal(t, ops.TransformAlignCorners, r.attributes.CoordinateTransformation)
+	assert.Equal(t, -0.5, r.attributes.CubicCoeffA)
+	assert.True(t, r.attributes.ExcludeOutside)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("area")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", r), err)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "coordinate_transformation_mode", S: []byte("half_pixel_symmetric")},
+	}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("coordinate_transformation_mode", r), err)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "antialias", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("antialias", r), err)
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		resize   *Resize
+		scales   tensor.Tensor
+		sizes    tensor.Tensor
+		expected []float32
+		shape    tensor.Shape
+	}{
+		{
+			&Resize{attributes: ops.NewResizeAttributes()},
+			ops.TensorWithBackingFixture([]float32{1, 1, 2, 2}, 4),
+			nil,
+			[]float32{1, 1, 2, 2, 1, 1, 2, 2, 3, 3, 4, 4, 3, 3, 4, 4},
+			tensor.Shape{1, 1, 4, 4},
+		},
+		{
+			&Resize{attributes: ops.ResizeAttributes{
+				Mode:                     ops.ResizeLinear,
+				CoordinateTransformation: ops.TransformAsymmetric,
+			}},
+			nil,
+			ops.TensorWithBackingFixture([]int64{1, 1, 2, 4}, 4),
+			[]float32{1, 1.5, 2, 2, 3, 3.5, 4, 4},
+			tensor.Shape{1, 1, 2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 1, 2, 2),
+			nil,
+			test.scales,
+			test.sizes,
+		}
+
+		res, err := test.resize.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.shape, res[0].Shape())
+	}
+}
+
+func TestInputValidationResize(t *testing.T) {
+	tests := []struct {
+		inputs   []tensor.Tensor
+		expected []tensor.Tensor
+		err      error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]float32{2}, 1),
+			},
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]float32{2}, 1),
+				nil,
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			nil,
+			ops.ErrInvalidInput("exactly one of 'scales' and 'sizes' should be given", &Resize{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]float32{2}, 1),
+			},
+			nil,
+			ops.ErrInvalidInputType(0, "bool", &Resize{}),
+		},
+	}
+
+	for _, test := range tests {
+		resize := &Resize{}
+		validated, err := resize.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, validated)
+		}
+	}
+}
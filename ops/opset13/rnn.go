@@ -1,6 +1,8 @@
 package opset13
 
 import (
+	"context"
+
 	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
 	"gorgonia.org/tensor"
@@ -62,6 +64,12 @@ func (r *RNN) Init(n *onnx.NodeProto) error {
 
 // Apply applies the rnn operator.
 func (r *RNN) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return r.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the rnn operator. The context is checked before every time step,
+// such that the calculation stops when the context is done.
+func (r *RNN) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	if inputs[4] != nil {
 		return nil, ops.ErrUnsupportedInput("sequence lens", r)
 	}
@@ -99,7 +107,9 @@ func (r *RNN) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 
 	// Reshape the hidden tensor without the bidirectional dimension, as
 	// we do not support bidirectional RNN yet. This is the dimension at
-	// index 0.
+	// index 0. The tensor is copied first, such that the input is not changed.
+	Ht = ops.ShallowCopy(Ht)
+
 	if err = Ht.Reshape(Ht.Shape().Clone()[1:]...); err != nil {
 		return nil, err
 	}
@@ -114,6 +124,10 @@ func (r *RNN) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
 	// Loop over all timesteps of the input, applying the RNN calculation to every
 	// timesteps while updating the hidden tensor.
 	for t := 0; t < seqLength; t++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		Xt, err := X.Slice(ops.NewSlicer(t, t+1), nil, nil)
 		if err != nil {
 			return nil, err
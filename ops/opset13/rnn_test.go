@@ -1,6 +1,7 @@
 package opset13
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -128,6 +129,23 @@ func TestRNN(t *testing.T) {
 	}
 }
 
+func TestRNNApplyContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rnn := &RNN{
+		activationAlpha: []float32{},
+		activationBeta:  []float32{},
+		activations:     []string{"tanh"},
+		direction:       ops.Forward,
+		hiddenSize:      4,
+	}
+
+	res, err := rnn.ApplyContext(ctx, rnnInput0())
+	assert.Nil(t, res)
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestInputValidationRNN(t *testing.T) {
 	tests := []struct {
 		inputs   []tensor.Tensor
@@ -0,0 +1,74 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinScatterElementsInputs = 3
+	MaxScatterElementsInputs = 3
+)
+
+// ScatterElements represents the ONNX scatterElements operator, which creates a copy of
+// the data and replaces the elements of the data given by the indices along an axis with
+// the updates.
+type ScatterElements struct {
+	axis int
+}
+
+// newScatterElements creates a new scatterElements operator.
+func newScatterElements() ops.Operator {
+	return &ScatterElements{}
+}
+
+// Init initializes the scatterElements operator.
+func (s *ScatterElements) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the scatterElements operator.
+func (s *ScatterElements) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ScatterElements(inputs[0], inputs[1], inputs[2], s.axis, ops.ScatterNone)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *ScatterElements) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateScatterInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *ScatterElements) GetMinInputs() int {
+	return MinScatterElementsInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *ScatterElements) GetMaxInputs() int {
+	return MaxScatterElementsInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *ScatterElements) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int32, tensor.Int64}, ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *ScatterElements) String() string {
+	return "scatterElements operator"
+}
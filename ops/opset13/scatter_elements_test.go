@@ -0,0 +1,82 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestScatterElementsInit(t *testing.T) {
+	s := &ScatterElements{}
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: -1}}})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, s.axis)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("add")}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("reduction", s), err)
+}
+
+func TestScatterElements(t *testing.T) {
+	tests := []struct {
+		scatterElements *ScatterElements
+		expected        []float32
+	}{
+		{&ScatterElements{axis: 0}, []float32{1, 2, 3, 4, 10, 6}},
+		{&ScatterElements{axis: 1}, []float32{1, 10, 3, 4, 5, 6}},
+		{&ScatterElements{axis: -1}, []float32{1, 10, 3, 4, 5, 6}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, 3, 2),
+			ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+			ops.TensorWithBackingFixture([]float32{10}, 1, 1),
+		}
+
+		if test.scatterElements.axis == 0 {
+			inputs[1] = ops.TensorWithBackingFixture([]int64{2}, 1, 1)
+		}
+
+		res, err := test.scatterElements.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationScatterElements(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+				ops.TensorWithBackingFixture([]int8{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+			},
+			ops.ErrInvalidInputCount(2, &ScatterElements{}),
+		},
+	}
+
+	for _, test := range tests {
+		scatterElements := &ScatterElements{}
+		validated, err := scatterElements.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
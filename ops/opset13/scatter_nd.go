@@ -0,0 +1,62 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinScatterNDInputs = 3
+	MaxScatterNDInputs = 3
+)
+
+// ScatterND represents the ONNX scatterND operator, which creates a copy of the data and
+// replaces the slices of the data given by the indices with the updates.
+type ScatterND struct{}
+
+// newScatterND creates a new scatterND operator.
+func newScatterND() ops.Operator {
+	return &ScatterND{}
+}
+
+// Init initializes the scatterND operator.
+func (s *ScatterND) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the scatterND operator.
+func (s *ScatterND) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ScatterND(inputs[0], inputs[1], inputs[2], ops.ScatterNone)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *ScatterND) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateScatterInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *ScatterND) GetMinInputs() int {
+	return MinScatterNDInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *ScatterND) GetMaxInputs() int {
+	return MaxScatterNDInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *ScatterND) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}, ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *ScatterND) String() string {
+	return "scatterND operator"
+}
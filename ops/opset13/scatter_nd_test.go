@@ -0,0 +1,66 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestScatterND(t *testing.T) {
+	scatterND := &ScatterND{}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6, 7, 8}, 2, 2, 2),
+		ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+		ops.TensorWithBackingFixture([]float32{9, 10, 11, 12}, 1, 2, 2),
+	}
+
+	res, err := scatterND.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2, 3, 4, 9, 10, 11, 12}, res[0].Data())
+	assert.Equal(t, []float32{1, 2, 3, 4, 5, 6, 7, 8}, inputs[0].Data())
+}
+
+func TestInputValidationScatterND(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+				ops.TensorWithBackingFixture([]bool{true}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1}, 1, 1),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			ops.ErrInvalidInputType(1, "int32", &ScatterND{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+				ops.TensorWithBackingFixture([]float64{1}, 1),
+			},
+			ops.ErrInvalidTensor("DType of 'updates' does not match DType of 'data'", &ScatterND{}),
+		},
+	}
+
+	for _, test := range tests {
+		scatterND := &ScatterND{}
+		validated, err := scatterND.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSequenceAtInputs = 2
+	MaxSequenceAtInputs = 2
+)
+
+// SequenceAt represents the ONNX sequenceAt operator, which returns the tensor at a
+// position of a sequence. A negative position counts from the end of the sequence.
+type SequenceAt struct{}
+
+// newSequenceAt creates a new sequenceAt operator.
+func newSequenceAt() ops.Operator {
+	return &SequenceAt{}
+}
+
+// Init initializes the sequenceAt operator.
+func (s *SequenceAt) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the sequenceAt operator. Its first input is a sequence, hence it can only
+// be applied using ApplyValues.
+func (s *SequenceAt) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(s, inputs)
+}
+
+// ApplyValues applies the sequenceAt operator.
+func (s *SequenceAt) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	sequence, ok := inputs[0].(ops.Sequence)
+	if !ok {
+		return nil, ops.ErrTypeAssert("ops.Sequence", inputs[0])
+	}
+
+	position, ok := inputs[1].(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", inputs[1])
+	}
+
+	i, err := ops.ScalarInt(position)
+	if err != nil {
+		return nil, err
+	}
+
+	if i < -len(sequence) || i >= len(sequence) {
+		return nil, ops.ErrInvalidInput("position is out of range", s)
+	}
+
+	return []ops.Value{sequence[ops.ConvertNegativeAxis(i, len(sequence))]}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *SequenceAt) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (s *SequenceAt) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *SequenceAt) GetMinInputs() int {
+	return MinSequenceAtInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *SequenceAt) GetMaxInputs() int {
+	return MaxSequenceAtInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *SequenceAt) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int32, tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *SequenceAt) String() string {
+	return "sequenceAt operator"
+}
@@ -0,0 +1,68 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSequenceAt(t *testing.T) {
+	a := ops.TensorWithBackingFixture([]float32{1, 2}, 2)
+	b := ops.TensorWithBackingFixture([]float32{3, 4, 5}, 3)
+
+	tests := []struct {
+		sequence ops.Value
+		position tensor.Tensor
+		expected ops.Value
+		err      error
+	}{
+		{ops.Sequence{a, b}, tensor.New(tensor.FromScalar(int64(1))), b, nil},
+		{ops.Sequence{a, b}, tensor.New(tensor.FromScalar(int32(-2))), a, nil},
+		{
+			ops.Sequence{a, b},
+			tensor.New(tensor.FromScalar(int64(2))),
+			nil,
+			ops.ErrInvalidInput("position is out of range", &SequenceAt{}),
+		},
+		{a, tensor.New(tensor.FromScalar(int64(0))), nil, ops.ErrTypeAssert("ops.Sequence", a)},
+	}
+
+	for _, test := range tests {
+		sequenceAt := &SequenceAt{}
+
+		res, err := sequenceAt.ApplyValues([]ops.Value{test.sequence, test.position})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Same(t, test.expected, res[0])
+		}
+	}
+}
+
+func TestInputValidationSequenceAt(t *testing.T) {
+	tests := []struct {
+		inputs []ops.Value
+		err    error
+	}{
+		{[]ops.Value{ops.Sequence{ops.Float32TensorFixture(2)}, ops.TensorWithBackingFixture([]int64{0}, 1)}, nil},
+		{[]ops.Value{ops.Sequence{}, ops.TensorWithBackingFixture([]int32{0}, 1)}, nil},
+		{[]ops.Value{ops.Sequence{}}, ops.ErrInvalidInputCount(1, &SequenceAt{})},
+		{
+			[]ops.Value{ops.Sequence{}, ops.TensorWithBackingFixture([]float32{0}, 1)},
+			ops.ErrInvalidInputType(1, "float32", &SequenceAt{}),
+		},
+	}
+
+	for _, test := range tests {
+		sequenceAt := &SequenceAt{}
+		validated, err := sequenceAt.ValidateValues(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSequenceConstructInputs = 1
+)
+
+// SequenceConstruct represents the ONNX sequenceConstruct operator, which creates a
+// sequence of its input tensors. All input tensors must have the same dtype.
+type SequenceConstruct struct {
+	maxInputs            int
+	inputTypeConstraints [][]tensor.Dtype
+}
+
+// newSequenceConstruct creates a new sequenceConstruct operator.
+func newSequenceConstruct() ops.Operator {
+	return &SequenceConstruct{}
+}
+
+// Init initializes the sequenceConstruct operator.
+func (s *SequenceConstruct) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the sequenceConstruct operator. Its output is a sequence, hence it can
+// only be applied using ApplyValues.
+func (s *SequenceConstruct) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(s, inputs)
+}
+
+// ApplyValues applies the sequenceConstruct operator.
+func (s *SequenceConstruct) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	sequence := make(ops.Sequence, len(inputs))
+
+	var dtype tensor.Dtype
+
+	for i, input := range inputs {
+		t, ok := input.(tensor.Tensor)
+		if !ok {
+			return nil, ops.ErrTypeAssert("tensor.Tensor", input)
+		}
+
+		if i == 0 {
+			dtype = t.Dtype()
+		} else if t.Dtype() != dtype {
+			return nil, ops.ErrInvalidInput("all tensors should have the same dtype", s)
+		}
+
+		sequence[i] = t
+	}
+
+	return []ops.Value{sequence}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *SequenceConstruct) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s.validator(len(inputs)), inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (s *SequenceConstruct) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(s.validator(len(inputs)), inputs)
+}
+
+// validator returns a copy of the operator which accepts the given number of inputs of
+// any type. Like for Concat, the maximum number of inputs is set dynamically, on a copy
+// because the operator can be used by multiple runs at the same time.
+func (s *SequenceConstruct) validator(nInputs int) *SequenceConstruct {
+	validator := &SequenceConstruct{
+		maxInputs:            nInputs,
+		inputTypeConstraints: make([][]tensor.Dtype, nInputs),
+	}
+
+	for i := 0; i < nInputs; i++ {
+		validator.inputTypeConstraints[i] = ops.AllTypes
+	}
+
+	return validator
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *SequenceConstruct) GetMinInputs() int {
+	return MinSequenceConstructInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *SequenceConstruct) GetMaxInputs() int {
+	return s.maxInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *SequenceConstruct) GetInputTypeConstraints() [][]tensor.Dtype {
+	return s.inputTypeConstraints
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *SequenceConstruct) String() string {
+	return "sequenceConstruct operator"
+}
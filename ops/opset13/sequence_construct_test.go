@@ -0,0 +1,68 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSequenceConstruct(t *testing.T) {
+	a := ops.TensorWithBackingFixture([]float32{1, 2}, 2)
+	b := ops.TensorWithBackingFixture([]float32{3, 4, 5}, 3)
+
+	tests := []struct {
+		inputs   []ops.Value
+		expected ops.Value
+		err      error
+	}{
+		{[]ops.Value{a}, ops.Sequence{a}, nil},
+		{[]ops.Value{a, b}, ops.Sequence{a, b}, nil},
+		{
+			[]ops.Value{a, ops.TensorWithBackingFixture([]int64{3}, 1)},
+			nil,
+			ops.ErrInvalidInput("all tensors should have the same dtype", &SequenceConstruct{}),
+		},
+		{[]ops.Value{ops.Sequence{a}}, nil, ops.ErrTypeAssert("tensor.Tensor", ops.Sequence{a})},
+	}
+
+	for _, test := range tests {
+		sequenceConstruct := &SequenceConstruct{}
+
+		res, err := sequenceConstruct.ApplyValues(test.inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0])
+		}
+	}
+}
+
+func TestInputValidationSequenceConstruct(t *testing.T) {
+	tests := []struct {
+		inputs []ops.Value
+		err    error
+	}{
+		{[]ops.Value{ops.Float32TensorFixture(2), ops.Float32TensorFixture(3), ops.Float32TensorFixture(1)}, nil},
+		{[]ops.Value{}, ops.ErrInvalidOptionalInputCount(0, &SequenceConstruct{inputTypeConstraints: [][]tensor.Dtype{}})},
+		{
+			[]ops.Value{ops.TensorWithBackingFixture([]int{1}, 1)},
+			ops.ErrInvalidInputType(0, "int", &SequenceConstruct{maxInputs: 1, inputTypeConstraints: [][]tensor.Dtype{ops.AllTypes}}),
+		},
+	}
+
+	for _, test := range tests {
+		sequenceConstruct := &SequenceConstruct{}
+		validated, err := sequenceConstruct.ValidateValues(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+
+	_, err := (&SequenceConstruct{}).Apply([]tensor.Tensor{ops.Float32TensorFixture(2)})
+	assert.Equal(t, ops.ErrTypeAssert("tensor.Tensor", ops.Sequence{ops.Float32TensorFixture(2)}), err)
+}
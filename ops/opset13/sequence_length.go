@@ -0,0 +1,73 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSequenceLengthInputs = 1
+	MaxSequenceLengthInputs = 1
+)
+
+// SequenceLength represents the ONNX sequenceLength operator, which returns the number of
+// tensors in a sequence as a scalar int64 tensor.
+type SequenceLength struct{}
+
+// newSequenceLength creates a new sequenceLength operator.
+func newSequenceLength() ops.Operator {
+	return &SequenceLength{}
+}
+
+// Init initializes the sequenceLength operator.
+func (s *SequenceLength) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the sequenceLength operator. Its input is a sequence, hence it can only be
+// applied using ApplyValues.
+func (s *SequenceLength) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(s, inputs)
+}
+
+// ApplyValues applies the sequenceLength operator.
+func (s *SequenceLength) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	sequence, ok := inputs[0].(ops.Sequence)
+	if !ok {
+		return nil, ops.ErrTypeAssert("ops.Sequence", inputs[0])
+	}
+
+	return []ops.Value{tensor.New(tensor.FromScalar(int64(len(sequence))))}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *SequenceLength) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (s *SequenceLength) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *SequenceLength) GetMinInputs() int {
+	return MinSequenceLengthInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *SequenceLength) GetMaxInputs() int {
+	return MaxSequenceLengthInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *SequenceLength) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *SequenceLength) String() string {
+	return "sequenceLength operator"
+}
@@ -0,0 +1,43 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSequenceLength(t *testing.T) {
+	tests := []struct {
+		sequence ops.Value
+		expected int64
+		err      error
+	}{
+		{ops.Sequence{ops.Float32TensorFixture(2), ops.Float32TensorFixture(3)}, 2, nil},
+		{ops.Sequence{}, 0, nil},
+		{ops.Optional{}, 0, ops.ErrTypeAssert("ops.Sequence", ops.Optional{})},
+	}
+
+	for _, test := range tests {
+		sequenceLength := &SequenceLength{}
+
+		res, err := sequenceLength.ApplyValues([]ops.Value{test.sequence})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].(tensor.Tensor).Data())
+			assert.Equal(t, tensor.ScalarShape(), res[0].(tensor.Tensor).Shape())
+		}
+	}
+}
+
+func TestInputValidationSequenceLength(t *testing.T) {
+	sequenceLength := &SequenceLength{}
+
+	_, err := sequenceLength.ValidateValues([]ops.Value{ops.Sequence{ops.Float32TensorFixture(2)}})
+	assert.Nil(t, err)
+
+	_, err = sequenceLength.ValidateValues([]ops.Value{})
+	assert.Equal(t, ops.ErrInvalidInputCount(0, sequenceLength), err)
+}
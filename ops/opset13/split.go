@@ -0,0 +1,93 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSplitInputs = 1
+	MaxSplitInputs = 2
+)
+
+// Split represents the ONNX split operator, which splits the input along an axis into
+// parts with the sizes given by the optional split input. Without the split input, the
+// axis is split into equal parts, one for every output.
+type Split struct {
+	axis     int
+	nOutputs int
+}
+
+// newSplit creates a new split operator.
+func newSplit() ops.Operator {
+	return &Split{}
+}
+
+// Init initializes the split operator.
+func (s *Split) Init(n *onnx.NodeProto) error {
+	s.nOutputs = len(n.GetOutput())
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the split operator.
+func (s *Split) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if inputs[1] != nil {
+		sizes, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+		if err != nil {
+			return nil, err
+		}
+
+		return ops.Split(inputs[0], s.axis, sizes)
+	}
+
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	if s.axis < -rank || s.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, s.axis)
+	}
+
+	size := shape[ops.ConvertNegativeAxis(s.axis, rank)]
+	if s.nOutputs == 0 || size%s.nOutputs != 0 {
+		return nil, ops.ErrInvalidInput("the axis can not be split into equal parts", s)
+	}
+
+	return ops.Split(inputs[0], s.axis, ops.EqualSplitSizes(size, s.nOutputs))
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Split) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *Split) GetMinInputs() int {
+	return MinSplitInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *Split) GetMaxInputs() int {
+	return MaxSplitInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Split) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Split) String() string {
+	return "split operator"
+}
@@ -0,0 +1,85 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSplitInit(t *testing.T) {
+	s := &Split{}
+
+	err := s.Init(&onnx.NodeProto{
+		Output:    []string{"a", "b"},
+		Attribute: []*onnx.AttributeProto{{Name: "axis", I: 1}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &Split{axis: 1, nOutputs: 2}, s)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "split"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("split", s), err)
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		split    *Split
+		sizes    tensor.Tensor
+		expected [][]float32
+	}{
+		{&Split{axis: 0, nOutputs: 3}, nil, [][]float32{{1, 2}, {3, 4}, {5, 6}}},
+		{&Split{axis: 0, nOutputs: 2}, ops.TensorWithBackingFixture([]int64{2, 4}, 2), [][]float32{{1, 2}, {3, 4, 5, 6}}},
+		{&Split{axis: -1, nOutputs: 2}, nil, [][]float32{{1, 2, 3}, {4, 5, 6}}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, 6),
+			test.sizes,
+		}
+
+		res, err := test.split.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, len(test.expected), len(res))
+
+		for i, expected := range test.expected {
+			assert.Equal(t, expected, res[i].Data())
+		}
+	}
+}
+
+func TestSplitUnequal(t *testing.T) {
+	split := &Split{nOutputs: 4}
+	inputs := []tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, 6), nil}
+
+	_, err := split.Apply(inputs)
+	assert.Equal(t, ops.ErrInvalidInput("the axis can not be split into equal parts", split), err)
+}
+
+func TestInputValidationSplit(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int8{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1, 1}, 2),
+			},
+			ops.ErrInvalidInputType(1, "int32", &Split{}),
+		},
+	}
+
+	for _, test := range tests {
+		split := &Split{}
+		_, err := split.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
@@ -0,0 +1,157 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSplitToSequenceInputs = 1
+	MaxSplitToSequenceInputs = 2
+)
+
+// SplitToSequence represents the ONNX splitToSequence operator, which splits the input
+// along an axis into a sequence of tensors. The optional split input either holds the
+// size of every part, or is a scalar with the size of all parts but the last one, which
+// is smaller when the axis is not divisible by it. Without the split input, the axis is
+// split into parts of size 1, which are removed unless keepdims is set.
+type SplitToSequence struct {
+	axis     int
+	keepDims bool
+}
+
+// newSplitToSequence creates a new splitToSequence operator.
+func newSplitToSequence() ops.Operator {
+	return &SplitToSequence{
+		keepDims: true,
+	}
+}
+
+// Init initializes the splitToSequence operator.
+func (s *SplitToSequence) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		case "keepdims":
+			s.keepDims = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the splitToSequence operator. Its output is a sequence, hence it can only
+// be applied using ApplyValues.
+func (s *SplitToSequence) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(s, inputs)
+}
+
+// ApplyValues applies the splitToSequence operator.
+func (s *SplitToSequence) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	input, ok := inputs[0].(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", inputs[0])
+	}
+
+	shape := input.Shape()
+	rank := len(shape)
+
+	if s.axis < -rank || s.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, s.axis)
+	}
+
+	axis := ops.ConvertNegativeAxis(s.axis, rank)
+
+	sizes, err := s.splitSizes(inputs[1], shape[axis])
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := ops.Split(input, axis, sizes)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := make(ops.Sequence, len(parts))
+
+	for i, part := range parts {
+		if inputs[1] == nil && !s.keepDims {
+			partShape := append(shape[:axis:axis], shape[axis+1:]...)
+
+			if err := part.Reshape(partShape...); err != nil {
+				return nil, err
+			}
+		}
+
+		sequence[i] = part
+	}
+
+	return []ops.Value{sequence}, nil
+}
+
+// splitSizes returns the sizes of the parts in which an axis of the given size is split.
+func (s *SplitToSequence) splitSizes(split ops.Value, size int) ([]int, error) {
+	if split == nil {
+		return ops.EqualSplitSizes(size, size), nil
+	}
+
+	splitTensor, ok := split.(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", split)
+	}
+
+	if !splitTensor.Shape().IsScalar() {
+		return ops.AnyToIntSlice(splitTensor.Data())
+	}
+
+	partSize, err := ops.ScalarInt(splitTensor)
+	if err != nil {
+		return nil, err
+	}
+
+	if partSize <= 0 {
+		return nil, ops.ErrInvalidInput("split should be positive", s)
+	}
+
+	var sizes []int
+	for ; size > partSize; size -= partSize {
+		sizes = append(sizes, partSize)
+	}
+
+	return append(sizes, size), nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *SplitToSequence) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (s *SplitToSequence) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *SplitToSequence) GetMinInputs() int {
+	return MinSplitToSequenceInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *SplitToSequence) GetMaxInputs() int {
+	return MaxSplitToSequenceInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *SplitToSequence) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int32, tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *SplitToSequence) String() string {
+	return "splitToSequence operator"
+}
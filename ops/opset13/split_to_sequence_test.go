@@ -0,0 +1,112 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSplitToSequenceInit(t *testing.T) {
+	s := newSplitToSequence().(*SplitToSequence)
+	assert.Equal(t, &SplitToSequence{keepDims: true}, s)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 1}, {Name: "keepdims", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, &SplitToSequence{axis: 1, keepDims: false}, s)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "split"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("split", s), err)
+}
+
+func TestSplitToSequence(t *testing.T) {
+	tests := []struct {
+		splitToSequence *SplitToSequence
+		split           tensor.Tensor
+		expectedShapes  []tensor.Shape
+		expected        [][]float32
+		err             error
+	}{
+		{
+			&SplitToSequence{axis: 0, keepDims: true},
+			nil,
+			[]tensor.Shape{{1, 3}, {1, 3}},
+			[][]float32{{0, 1, 2}, {3, 4, 5}},
+			nil,
+		},
+		{
+			&SplitToSequence{axis: 1, keepDims: false},
+			nil,
+			[]tensor.Shape{{2}, {2}, {2}},
+			[][]float32{{0, 3}, {1, 4}, {2, 5}},
+			nil,
+		},
+		{
+			&SplitToSequence{axis: -1, keepDims: false},
+			ops.TensorWithBackingFixture([]int64{1, 2}, 2),
+			[]tensor.Shape{{2, 1}, {2, 2}},
+			[][]float32{{0, 3}, {1, 2, 4, 5}},
+			nil,
+		},
+		{
+			&SplitToSequence{axis: 1, keepDims: true},
+			tensor.New(tensor.FromScalar(int32(2))),
+			[]tensor.Shape{{2, 2}, {2, 1}},
+			[][]float32{{0, 1, 3, 4}, {2, 5}},
+			nil,
+		},
+		{
+			&SplitToSequence{axis: 1, keepDims: true},
+			tensor.New(tensor.FromScalar(int32(0))),
+			nil,
+			nil,
+			ops.ErrInvalidInput("split should be positive", &SplitToSequence{axis: 1, keepDims: true}),
+		},
+		{
+			&SplitToSequence{axis: 2, keepDims: true},
+			nil,
+			nil,
+			nil,
+			ops.ErrAxisOutOfRange(-2, 1, 2),
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []ops.Value{ops.Float32TensorFixture(2, 3), nil}
+		if test.split != nil {
+			inputs[1] = test.split
+		}
+
+		res, err := test.splitToSequence.ApplyValues(inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err != nil {
+			continue
+		}
+
+		sequence, ok := res[0].(ops.Sequence)
+		assert.True(t, ok)
+		assert.Equal(t, len(test.expected), len(sequence))
+
+		for i, expected := range test.expected {
+			assert.Equal(t, test.expectedShapes[i], sequence[i].(tensor.Tensor).Shape())
+			assert.Equal(t, expected, sequence[i].(tensor.Tensor).Data())
+		}
+	}
+}
+
+func TestInputValidationSplitToSequence(t *testing.T) {
+	splitToSequence := &SplitToSequence{}
+
+	validated, err := splitToSequence.ValidateValues([]ops.Value{ops.Float32TensorFixture(2)})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(validated))
+
+	_, err = splitToSequence.ValidateValues([]ops.Value{
+		ops.Float32TensorFixture(2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(1, "float32", splitToSequence), err)
+}
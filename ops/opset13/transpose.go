@@ -25,6 +25,11 @@ func newTranspose() ops.Operator {
 func (t *Transpose) Init(n *onnx.NodeProto) error {
 	attributes := n.GetAttribute()
 
+	// Without a perm attribute, the order of the dims is reversed.
+	if len(attributes) == 0 {
+		return nil
+	}
+
 	if len(attributes) != 1 {
 		return ops.ErrInvalidAttributeCount(1, len(attributes), t)
 	}
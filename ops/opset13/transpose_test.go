@@ -25,11 +25,19 @@ func TestTransposeInitFailWrongAttribute(t *testing.T) {
 	assert.Equal(t, expected, err)
 }
 
-func TestTransposeInitFailAttrCount(t *testing.T) {
+func TestTransposeInitDefaultPerm(t *testing.T) {
 	trans := &Transpose{}
 	err := trans.Init(ops.EmptyNodeProto())
 
-	expected := ops.ErrInvalidAttributeCount(1, 0, trans)
+	assert.Nil(t, err)
+	assert.Nil(t, trans.perm)
+}
+
+func TestTransposeInitFailAttrCount(t *testing.T) {
+	trans := &Transpose{}
+	err := trans.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "perm"}, {Name: "perm"}}})
+
+	expected := ops.ErrInvalidAttributeCount(1, 2, trans)
 	assert.Equal(t, expected, err)
 }
 
@@ -0,0 +1,187 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinWhereInputs = 3
+	MaxWhereInputs = 3
+)
+
+// Where represents the ONNX where operator, which selects elements from X where the
+// condition is true, and from Y where it is false. The condition, X and Y are broadcasted
+// multidirectionally.
+type Where struct{}
+
+// newWhere creates a new where operator.
+func newWhere() ops.Operator {
+	return &Where{}
+}
+
+// Init initializes the where operator.
+func (w *Where) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the where operator.
+func (w *Where) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	condition, X, Y, err := broadcastWhereInputs(inputs[0], inputs[1], inputs[2])
+	if err != nil {
+		return nil, err
+	}
+
+	allScalar := inputs[0].Shape().IsScalar() && inputs[1].Shape().IsScalar() && inputs[2].Shape().IsScalar()
+
+	out := tensor.NewDense(X.Dtype(), X.Shape())
+
+	switch X.Dtype() {
+	case tensor.Uint8:
+		err = calcWhere[uint8](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Uint16:
+		err = calcWhere[uint16](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Uint32:
+		err = calcWhere[uint32](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Uint64:
+		err = calcWhere[uint64](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Int8:
+		err = calcWhere[int8](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Int16:
+		err = calcWhere[int16](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Int32:
+		err = calcWhere[int32](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Int64:
+		err = calcWhere[int64](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Float32:
+		err = calcWhere[float32](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Float64:
+		err = calcWhere[float64](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Complex64:
+		err = calcWhere[complex64](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Complex128:
+		err = calcWhere[complex128](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.String:
+		err = calcWhere[string](out.Data(), condition.Data(), X.Data(), Y.Data())
+	case tensor.Bool:
+		err = calcWhere[bool](out.Data(), condition.Data(), X.Data(), Y.Data())
+	default:
+		return nil, ops.ErrInvalidInputType(1, X.Dtype().String(), w)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if allScalar {
+		if err := out.Reshape(); err != nil {
+			return nil, err
+		}
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (w *Where) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(w, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if inputs[1].Dtype() != inputs[2].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'Y' does not match DType of 'X'", w)
+	}
+
+	return inputs, nil
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (w *Where) GetMinInputs() int {
+	return MinWhereInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (w *Where) GetMaxInputs() int {
+	return MaxWhereInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (w *Where) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Bool}, ops.AllTypes, ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (w *Where) String() string {
+	return "where operator"
+}
+
+// broadcastWhereInputs broadcasts the condition, X and Y to a common shape. Scalars are
+// reshaped to tensors with a single element first, such that their data is a list.
+func broadcastWhereInputs(condition, X, Y tensor.Tensor) (tensor.Tensor, tensor.Tensor, tensor.Tensor, error) {
+	tensors := []tensor.Tensor{condition, X, Y}
+
+	for i, t := range tensors {
+		if !t.Shape().IsScalar() {
+			continue
+		}
+
+		tensors[i] = ops.ShallowCopy(t)
+		if err := tensors[i].Reshape(1); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	condition, X, Y = tensors[0], tensors[1], tensors[2]
+
+	condition, X, err := ops.MultidirectionalBroadcast(condition, X)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	condition, Y, err = ops.MultidirectionalBroadcast(condition, Y)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	X, Y, err = ops.MultidirectionalBroadcast(X, Y)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return condition, X, Y, nil
+}
+
+func calcWhere[T any](result, condition, x, y any) error {
+	convertedResult, ok := result.([]T)
+	if !ok {
+		return ops.ErrTypeAssert("list", result)
+	}
+
+	convertedCondition, ok := condition.([]bool)
+	if !ok {
+		return ops.ErrTypeAssert("bool list", condition)
+	}
+
+	convertedX, ok := x.([]T)
+	if !ok {
+		return ops.ErrTypeAssert("list", x)
+	}
+
+	convertedY, ok := y.([]T)
+	if !ok {
+		return ops.ErrTypeAssert("list", y)
+	}
+
+	for i, c := range convertedCondition {
+		if c {
+			convertedResult[i] = convertedX[i]
+		} else {
+			convertedResult[i] = convertedY[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestWhereInit(t *testing.T) {
+	w := &Where{}
+
+	// since 'where' does not have any attributes we pass in nil. This should not
+	// fail initializing the where.
+	err := w.Init(nil)
+	assert.Nil(t, err)
+}
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		inputs        []tensor.Tensor
+		expected      any
+		expectedShape tensor.Shape
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false, true, true}, 2, 2),
+				ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+				ops.TensorWithBackingFixture([]float32{9, 8, 7, 6}, 2, 2),
+			},
+			[]float32{1, 8, 3, 4},
+			tensor.Shape{2, 2},
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{false, true}, 2),
+				ops.TensorWithBackingFixture([]int64{1, 2, 3, 4}, 2, 2),
+				tensor.New(tensor.FromScalar(int64(0))),
+			},
+			[]int64{0, 2, 0, 4},
+			tensor.Shape{2, 2},
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2, 1),
+				ops.TensorWithBackingFixture([]string{"a", "b"}, 2),
+				ops.TensorWithBackingFixture([]string{"c"}, 1),
+			},
+			[]string{"a", "b", "c", "c"},
+			tensor.Shape{2, 2},
+		},
+		{
+			[]tensor.Tensor{
+				tensor.New(tensor.FromScalar(false)),
+				tensor.New(tensor.FromScalar(float64(1))),
+				tensor.New(tensor.FromScalar(float64(2))),
+			},
+			float64(2),
+			tensor.ScalarShape(),
+		},
+	}
+
+	for _, test := range tests {
+		where := &Where{}
+
+		res, err := where.Apply(test.inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationWhere(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]uint8{3, 4}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+			},
+			ops.ErrInvalidInputCount(2, &Where{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int32{1, 0}, 2),
+				ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]uint8{3, 4}, 2),
+			},
+			ops.ErrInvalidInputType(0, "int32", &Where{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{3, 4}, 2),
+			},
+			ops.ErrInvalidTensor("DType of 'Y' does not match DType of 'X'", &Where{}),
+		},
+	}
+
+	for _, test := range tests {
+		where := &Where{}
+		validated, err := where.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package opset13
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	ZipMapExpectedAttributes = 1
+	MinZipMapInputs          = 1
+	MaxZipMapInputs          = 1
+)
+
+// ZipMap represents the ONNX-ml zipMap operator, which turns every row of the input into a
+// map from the class labels to the values of the row. The output is a sequence with a map
+// for every row, of which the values are scalar float32 tensors. A 1D input is a single row.
+type ZipMap struct {
+	labels []any
+}
+
+// newZipMap creates a new zipMap operator.
+func newZipMap() ops.Operator {
+	return &ZipMap{}
+}
+
+// Init initializes the zipMap operator.
+func (z *ZipMap) Init(n *onnx.NodeProto) error {
+	attributes := n.GetAttribute()
+	if len(attributes) != ZipMapExpectedAttributes {
+		return ops.ErrInvalidAttributeCount(ZipMapExpectedAttributes, len(attributes), z)
+	}
+
+	switch attr := attributes[0]; attr.GetName() {
+	case "classlabels_int64s":
+		z.labels = make([]any, len(attr.GetInts()))
+		for i, label := range attr.GetInts() {
+			z.labels[i] = label
+		}
+	case "classlabels_strings":
+		z.labels = make([]any, len(attr.GetStrings()))
+		for i, label := range attr.GetStrings() {
+			z.labels[i] = string(label)
+		}
+	default:
+		return ops.ErrInvalidAttribute(attr.GetName(), z)
+	}
+
+	return nil
+}
+
+// Apply applies the zipMap operator. Its output is a sequence, hence it can only be
+// applied using ApplyValues.
+func (z *ZipMap) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(z, inputs)
+}
+
+// ApplyValues applies the zipMap operator.
+func (z *ZipMap) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	input, ok := inputs[0].(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", inputs[0])
+	}
+
+	shape := input.Shape()
+	if len(shape) == 0 || len(shape) > 2 || shape[len(shape)-1] != len(z.labels) {
+		return nil, ops.ErrInvalidInput("the input should have a column for every class label", z)
+	}
+
+	values, ok := input.Data().([]float32)
+	if !ok {
+		return nil, ops.ErrTypeAssert("[]float32", input.Data())
+	}
+
+	nRows := len(values) / len(z.labels)
+	sequence := make(ops.Sequence, nRows)
+
+	for row := range sequence {
+		m := make(ops.Map, len(z.labels))
+		for col, label := range z.labels {
+			m[label] = tensor.New(tensor.FromScalar(values[row*len(z.labels)+col]))
+		}
+
+		sequence[row] = m
+	}
+
+	return []ops.Value{sequence}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (z *ZipMap) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(z, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (z *ZipMap) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(z, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (z *ZipMap) GetMinInputs() int {
+	return MinZipMapInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (z *ZipMap) GetMaxInputs() int {
+	return MaxZipMapInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (z *ZipMap) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (z *ZipMap) String() string {
+	return "zipMap operator"
+}
@@ -0,0 +1,80 @@
+package opset13
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestZipMapInit(t *testing.T) {
+	z := &ZipMap{}
+
+	err := z.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "classlabels_int64s", Ints: []int64{3, 5}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, []any{int64(3), int64(5)}, z.labels)
+
+	err = z.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "classlabels_strings", Strings: [][]byte{[]byte("a")}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"a"}, z.labels)
+
+	err = z.Init(&onnx.NodeProto{})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, z), err)
+
+	err = z.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "classlabels"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("classlabels", z), err)
+}
+
+func TestZipMap(t *testing.T) {
+	tests := []struct {
+		zipMap   *ZipMap
+		input    tensor.Tensor
+		expected ops.Sequence
+		err      error
+	}{
+		{
+			&ZipMap{labels: []any{"a", "b"}},
+			ops.TensorWithBackingFixture([]float32{0.25, 0.75, 1, 0}, 2, 2),
+			ops.Sequence{
+				ops.Map{"a": tensor.New(tensor.FromScalar(float32(0.25))), "b": tensor.New(tensor.FromScalar(float32(0.75)))},
+				ops.Map{"a": tensor.New(tensor.FromScalar(float32(1))), "b": tensor.New(tensor.FromScalar(float32(0)))},
+			},
+			nil,
+		},
+		{
+			&ZipMap{labels: []any{int64(1), int64(2)}},
+			ops.TensorWithBackingFixture([]float32{0.5, 0.5}, 2),
+			ops.Sequence{
+				ops.Map{int64(1): tensor.New(tensor.FromScalar(float32(0.5))), int64(2): tensor.New(tensor.FromScalar(float32(0.5)))},
+			},
+			nil,
+		},
+		{
+			&ZipMap{labels: []any{"a"}},
+			ops.TensorWithBackingFixture([]float32{0.5, 0.5}, 2),
+			nil,
+			ops.ErrInvalidInput("the input should have a column for every class label", &ZipMap{labels: []any{"a"}}),
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.zipMap.ApplyValues([]ops.Value{test.input})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0])
+		}
+	}
+}
+
+func TestInputValidationZipMap(t *testing.T) {
+	zipMap := &ZipMap{}
+
+	_, err := zipMap.ValidateValues([]ops.Value{ops.Float32TensorFixture(2, 2)})
+	assert.Nil(t, err)
+
+	_, err = zipMap.ValidateValues([]ops.Value{ops.TensorWithBackingFixture([]float64{1}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "float64", zipMap), err)
+}
@@ -0,0 +1,34 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Add represents the ONNX add operator of opset 14, which adds support for 8 and 16 bit
+// integers to the add operator of opset 13.
+type Add struct {
+	opset13.Add
+}
+
+// newAdd creates a new add operator.
+func newAdd() ops.Operator {
+	return &Add{}
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (a *Add) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(a, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (a *Add) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{arithmeticTypes, arithmeticTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (a *Add) String() string {
+	return "add operator"
+}
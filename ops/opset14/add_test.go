@@ -0,0 +1,90 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestAddSmallIntegers(t *testing.T) {
+	tests := []struct {
+		backings [2]any
+		expected any
+	}{
+		{
+			[2]any{[]uint8{1, 2, 3, 4}, []uint8{2, 3, 4, 5}},
+			[]uint8{3, 5, 7, 9},
+		},
+		{
+			[2]any{[]int8{-4, -2, 0, 2}, []int8{2, 2, 2, 2}},
+			[]int8{-2, 0, 2, 4},
+		},
+		{
+			[2]any{[]int16{-4, -2, 0, 2}, []int16{2, 2, 2, 2}},
+			[]int16{-2, 0, 2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		a := newAdd()
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture(test.backings[0], 4),
+			ops.TensorWithBackingFixture(test.backings[1], 4),
+		}
+
+		validated, err := a.ValidateInputs(inputs)
+		assert.Nil(t, err)
+
+		res, err := a.Apply(validated)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationAdd(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]uint16{1, 2}, 2),
+				ops.TensorWithBackingFixture([]uint16{3, 4}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{3, 4}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int{1, 2}, 2),
+			},
+			ops.ErrInvalidInputCount(1, &Add{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]bool{false, true}, 2),
+			},
+			ops.ErrInvalidInputType(0, "bool", &Add{}),
+		},
+	}
+
+	for _, test := range tests {
+		a := &Add{}
+		validated, err := a.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinBatchNormalizationInputs       = 5
+	MaxBatchNormalizationInputs       = 5
+	BatchNormalizationDefaultEpsilon  = 1e-5
+	BatchNormalizationDefaultMomentum = 0.9
+)
+
+// BatchNormalization represents the ONNX batchNormalization operator. Only inference
+// mode is supported, in which the given mean and variance are used to normalize the
+// input. Training mode is not supported.
+type BatchNormalization struct {
+	epsilon  float32
+	momentum float32
+}
+
+// newBatchNormalization creates a new batchNormalization operator.
+func newBatchNormalization() ops.Operator {
+	return &BatchNormalization{
+		epsilon:  BatchNormalizationDefaultEpsilon,
+		momentum: BatchNormalizationDefaultMomentum,
+	}
+}
+
+// Init initializes the batchNormalization operator.
+func (b *BatchNormalization) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "epsilon":
+			b.epsilon = attr.GetF()
+		case "momentum":
+			// The momentum is only used in training mode.
+			b.momentum = attr.GetF()
+		case "training_mode":
+			if ops.Int64ToBool(attr.GetI()) {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), b)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), b)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the batchNormalization operator. The output is calculated as
+// Y = X * factor + shift, where factor = scale / sqrt(var + epsilon) and
+// shift = B - mean * factor, which are broadcasted along the channel dim.
+func (b *BatchNormalization) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	X, scale, B, mean, variance := inputs[0], inputs[1], inputs[2], inputs[3], inputs[4]
+
+	if len(X.Shape()) < 2 {
+		return nil, ops.ErrInvalidInput("input must have at least 2 dimensions", b)
+	}
+
+	epsilon, err := ops.GetValueAsTensorType(float64(b.epsilon), variance.Dtype())
+	if err != nil {
+		return nil, err
+	}
+
+	stdDev, err := tensor.Add(variance, epsilon)
+	if err != nil {
+		return nil, err
+	}
+
+	stdDev, err = tensor.Sqrt(stdDev)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := tensor.Div(scale, stdDev)
+	if err != nil {
+		return nil, err
+	}
+
+	shift, err := tensor.Mul(mean, factor)
+	if err != nil {
+		return nil, err
+	}
+
+	shift, err = tensor.Sub(B, shift)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reshape the factor and shift to [C, 1, ..., 1], such that they are broadcasted
+	// along all dims of the input after the channel dim.
+	channelShape := make([]int, len(X.Shape())-1)
+	for i := range channelShape {
+		channelShape[i] = 1
+	}
+
+	channelShape[0] = X.Shape()[1]
+
+	if err := factor.Reshape(channelShape...); err != nil {
+		return nil, err
+	}
+
+	if err := shift.Reshape(channelShape...); err != nil {
+		return nil, err
+	}
+
+	out, err := ops.ApplyBinaryOperation(X, factor, ops.Mul, ops.UnidirectionalBroadcasting)
+	if err != nil {
+		return nil, err
+	}
+
+	return ops.ApplyBinaryOperation(out[0], shift, ops.Add, ops.UnidirectionalBroadcasting)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (b *BatchNormalization) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(b, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (b *BatchNormalization) GetMinInputs() int {
+	return MinBatchNormalizationInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (b *BatchNormalization) GetMaxInputs() int {
+	return MaxBatchNormalizationInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (b *BatchNormalization) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (b *BatchNormalization) String() string {
+	return "batchNormalization operator"
+}
@@ -0,0 +1,109 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestBatchNormalizationInit(t *testing.T) {
+	b := newBatchNormalization().(*BatchNormalization)
+	err := b.Init(&onnx.NodeProto{
+		Attribute: []*onnx.AttributeProto{{Name: "epsilon", F: 0.001}, {Name: "training_mode", I: 0}},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0.001), b.epsilon)
+	assert.Equal(t, float32(0.9), b.momentum)
+}
+
+func TestBatchNormalizationInitFail(t *testing.T) {
+	b := &BatchNormalization{}
+
+	err := b.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "training_mode", I: 1}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("training_mode", b), err)
+
+	err = b.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", b), err)
+}
+
+func TestBatchNormalization(t *testing.T) {
+	tests := []struct {
+		epsilon  float32
+		shape    []int
+		backings [][]float32
+		expected []float32
+	}{
+		{
+			0,
+			[]int{1, 2, 2},
+			[][]float32{{1, 1}, {0, 0}, {0, 0}, {1, 1}},
+			[]float32{0, 1, 2, 3},
+		},
+		{
+			0,
+			[]int{1, 2, 2},
+			[][]float32{{2, 1}, {1, 0}, {1, 2}, {4, 1}},
+			[]float32{0, 1, 0, 1},
+		},
+		{
+			0,
+			[]int{2, 2},
+			[][]float32{{1, 2}, {0, 1}, {0, 0}, {1, 1}},
+			[]float32{0, 3, 2, 7},
+		},
+		{
+			3,
+			[]int{1, 1, 2},
+			[][]float32{{1}, {0}, {0}, {1}},
+			[]float32{0, 0.5},
+		},
+	}
+
+	for _, test := range tests {
+		b := &BatchNormalization{epsilon: test.epsilon}
+		c := test.shape[1]
+		inputs := []tensor.Tensor{
+			ops.Float32TensorFixture(test.shape...),
+			ops.TensorWithBackingFixture(test.backings[0], c),
+			ops.TensorWithBackingFixture(test.backings[1], c),
+			ops.TensorWithBackingFixture(test.backings[2], c),
+			ops.TensorWithBackingFixture(test.backings[3], c),
+		}
+
+		res, err := b.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-6)
+		assert.Equal(t, tensor.Shape(test.shape), res[0].Shape())
+	}
+}
+
+func TestInputValidationBatchNormalization(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			ops.TensorInputsFixture(5),
+			nil,
+		},
+		{
+			ops.TensorInputsFixture(4),
+			ops.ErrInvalidInputCount(4, &BatchNormalization{}),
+		},
+	}
+
+	for _, test := range tests {
+		b := &BatchNormalization{}
+		validated, err := b.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
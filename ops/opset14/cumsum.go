@@ -0,0 +1,146 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinCumSumInputs = 2
+	MaxCumSumInputs = 2
+)
+
+// CumSum represents the ONNX cumsum operator, which calculates the cumulative sum of a
+// tensor along an axis.
+type CumSum struct {
+	exclusive bool
+	reverse   bool
+}
+
+// newCumSum creates a new cumsum operator.
+func newCumSum() ops.Operator {
+	return &CumSum{}
+}
+
+// Init initializes the cumsum operator.
+func (c *CumSum) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "exclusive":
+			c.exclusive = ops.Int64ToBool(attr.GetI())
+		case "reverse":
+			c.reverse = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), c)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the cumsum operator.
+func (c *CumSum) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	axes, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(axes) != 1 {
+		return nil, ops.ErrInvalidInput("axis must be a single value", c)
+	}
+
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	if axes[0] < -rank || axes[0] >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, axes[0])
+	}
+
+	axis := ops.ConvertNegativeAxis(axes[0], rank)
+
+	out, ok := inputs[0].Clone().(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", inputs[0].Clone())
+	}
+
+	// The data is seen as a [outer, n, inner] tensor, where n is the size of the axis.
+	n := shape[axis]
+	inner := ops.NElements(shape[axis+1:]...)
+
+	switch data := out.Data().(type) {
+	case []uint32:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	case []uint64:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	case []int32:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	case []int64:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	case []float32:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	case []float64:
+		cumSum(data, n, inner, c.exclusive, c.reverse)
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), c)
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *CumSum) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (c *CumSum) GetMinInputs() int {
+	return MinCumSumInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (c *CumSum) GetMaxInputs() int {
+	return MaxCumSumInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *CumSum) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Uint32, tensor.Uint64, tensor.Int32, tensor.Int64, tensor.Float32, tensor.Float64},
+		{tensor.Int32, tensor.Int64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *CumSum) String() string {
+	return "cumsum operator"
+}
+
+// cumSum replaces the data, seen as a [outer, n, inner] tensor, by its cumulative sum
+// along the middle axis. When exclusive is true, an element itself is not included in
+// its sum. When reverse is true, the sum is calculated from the end of the axis.
+func cumSum[T ops.Number](data []T, n, inner int, exclusive, reverse bool) {
+	for offset := 0; offset < len(data); offset += n * inner {
+		for j := 0; j < inner; j++ {
+			var sum T
+
+			for i := 0; i < n; i++ {
+				index := i
+				if reverse {
+					index = n - 1 - i
+				}
+
+				value := data[offset+index*inner+j]
+
+				if exclusive {
+					data[offset+index*inner+j] = sum
+					sum += value
+				} else {
+					sum += value
+					data[offset+index*inner+j] = sum
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCumSumInit(t *testing.T) {
+	c := &CumSum{}
+	err := c.Init(&onnx.NodeProto{
+		Attribute: []*onnx.AttributeProto{{Name: "exclusive", I: 1}, {Name: "reverse", I: 1}},
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, c.exclusive)
+	assert.True(t, c.reverse)
+}
+
+func TestCumSum(t *testing.T) {
+	tests := []struct {
+		cumSum   *CumSum
+		shape    []int
+		axis     any
+		expected []float32
+	}{
+		{&CumSum{}, []int{5}, int64(0), []float32{0, 1, 3, 6, 10}},
+		{&CumSum{exclusive: true}, []int{5}, int64(0), []float32{0, 0, 1, 3, 6}},
+		{&CumSum{reverse: true}, []int{5}, int64(0), []float32{10, 10, 9, 7, 4}},
+		{&CumSum{exclusive: true, reverse: true}, []int{5}, int64(0), []float32{10, 9, 7, 4, 0}},
+		{&CumSum{}, []int{2, 3}, int32(0), []float32{0, 1, 2, 3, 5, 7}},
+		{&CumSum{}, []int{2, 3}, int64(1), []float32{0, 1, 3, 3, 7, 12}},
+		{&CumSum{}, []int{2, 3}, int64(-1), []float32{0, 1, 3, 3, 7, 12}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.Float32TensorFixture(test.shape...),
+			tensor.New(tensor.FromScalar(test.axis)),
+		}
+
+		res, err := test.cumSum.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestCumSumFail(t *testing.T) {
+	c := &CumSum{}
+
+	_, err := c.Apply([]tensor.Tensor{ops.Float32TensorFixture(2, 3), tensor.New(tensor.FromScalar(int64(2)))})
+	assert.Equal(t, ops.ErrAxisOutOfRange(-2, 1, 2), err)
+}
+
+func TestInputValidationCumSum(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]uint32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{0}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{0}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{0}, 1),
+			},
+			ops.ErrInvalidInputType(0, "int8", &CumSum{}),
+		},
+	}
+
+	for _, test := range tests {
+		c := &CumSum{}
+		validated, err := c.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Div represents the ONNX div operator of opset 14, which adds support for 8 and 16 bit
+// integers to the div operator of opset 13.
+type Div struct {
+	opset13.Div
+}
+
+// newDiv creates a new div operator.
+func newDiv() ops.Operator {
+	return &Div{}
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (d *Div) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(d, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (d *Div) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{arithmeticTypes, arithmeticTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (d *Div) String() string {
+	return "div operator"
+}
@@ -0,0 +1,70 @@
+package opset14
+
+import (
+	"context"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// GRU represents the ONNX gru operator of opset 14, which adds the layout attribute to the
+// gru operator of opset 13.
+type GRU struct {
+	opset13.GRU
+	batchMajor bool
+}
+
+// newGRU creates a new gru operator.
+func newGRU() ops.Operator {
+	g, ok := operator13("GRU").(*opset13.GRU)
+	if !ok {
+		panic(ops.ErrTypeAssert("*opset13.GRU", g))
+	}
+
+	return &GRU{GRU: *g}
+}
+
+// Init initializes the gru operator.
+func (g *GRU) Init(n *onnx.NodeProto) error {
+	node, batchMajor, err := withoutLayout(n, g)
+	if err != nil {
+		return err
+	}
+
+	g.batchMajor = batchMajor
+
+	return g.GRU.Init(node)
+}
+
+// Apply applies the gru operator.
+func (g *GRU) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return g.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the gru operator. With the batch major layout, the inputs are
+// transposed to the sequence major layout used by the gru operator of opset 13, and the
+// outputs are transposed back.
+func (g *GRU) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if !g.batchMajor {
+		return g.GRU.ApplyContext(ctx, inputs)
+	}
+
+	inputs, err := toSequenceMajor(inputs, 0, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := g.GRU.ApplyContext(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBatchMajor(outputs)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (g *GRU) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(g, inputs)
+}
@@ -0,0 +1,70 @@
+package opset14
+
+import (
+	"context"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// LSTM represents the ONNX lstm operator of opset 14, which adds the layout attribute to the
+// lstm operator of opset 13.
+type LSTM struct {
+	opset13.LSTM
+	batchMajor bool
+}
+
+// newLSTM creates a new lstm operator.
+func newLSTM() ops.Operator {
+	l, ok := operator13("LSTM").(*opset13.LSTM)
+	if !ok {
+		panic(ops.ErrTypeAssert("*opset13.LSTM", l))
+	}
+
+	return &LSTM{LSTM: *l}
+}
+
+// Init initializes the lstm operator.
+func (l *LSTM) Init(n *onnx.NodeProto) error {
+	node, batchMajor, err := withoutLayout(n, l)
+	if err != nil {
+		return err
+	}
+
+	l.batchMajor = batchMajor
+
+	return l.LSTM.Init(node)
+}
+
+// Apply applies the lstm operator.
+func (l *LSTM) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return l.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the lstm operator. With the batch major layout, the inputs are
+// transposed to the sequence major layout used by the lstm operator of opset 13, and the
+// outputs are transposed back.
+func (l *LSTM) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if !l.batchMajor {
+		return l.LSTM.ApplyContext(ctx, inputs)
+	}
+
+	inputs, err := toSequenceMajor(inputs, 0, 5, 6)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := l.LSTM.ApplyContext(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBatchMajor(outputs)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (l *LSTM) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(l, inputs)
+}
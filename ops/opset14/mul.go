@@ -0,0 +1,34 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Mul represents the ONNX mul operator of opset 14, which adds support for 8 and 16 bit
+// integers to the mul operator of opset 13.
+type Mul struct {
+	opset13.Mul
+}
+
+// newMul creates a new mul operator.
+func newMul() ops.Operator {
+	return &Mul{}
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (m *Mul) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(m, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (m *Mul) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{arithmeticTypes, arithmeticTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (m *Mul) String() string {
+	return "mul operator"
+}
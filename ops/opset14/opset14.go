@@ -0,0 +1,59 @@
+package opset14
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+)
+
+// operators14 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 14. All other operators are the same as in opset 13.
+var operators14 = []ops.OperatorVersion{
+	{OpType: "Add", SinceVersion: 14, New: newAdd},
+	{OpType: "BatchNormalization", SinceVersion: 14, New: newBatchNormalization},
+	{OpType: "CumSum", SinceVersion: 14, New: newCumSum},
+	{OpType: "Div", SinceVersion: 14, New: newDiv},
+	{OpType: "GRU", SinceVersion: 14, New: newGRU},
+	{OpType: "LSTM", SinceVersion: 14, New: newLSTM},
+	{OpType: "Mul", SinceVersion: 14, New: newMul},
+	{OpType: "Relu", SinceVersion: 14, New: newRelu},
+	{OpType: "Reshape", SinceVersion: 14, New: newReshape},
+	{OpType: "RNN", SinceVersion: 14, New: newRNN},
+	{OpType: "Sub", SinceVersion: 14, New: newSub},
+	{OpType: "Trilu", SinceVersion: 14, New: newTrilu},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 14. Operators
+// which did not change since opset 13 are taken from opset 13.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators14, operatorType); ok {
+		return op, nil
+	}
+
+	return opset13.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 14.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators14)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 14,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators14)
+}
+
+// operator13 returns a new operator of opset 13, which is extended by an operator of
+// opset 14.
+func operator13(operatorType string) ops.Operator {
+	op, err := opset13.GetOperator(operatorType)
+	if err != nil {
+		// Only operators which exist in opset 13 are extended.
+		panic(err)
+	}
+
+	return op
+}
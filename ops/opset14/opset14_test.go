@@ -0,0 +1,53 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"Add", newAdd(), nil},
+		{"BatchNormalization", newBatchNormalization(), nil},
+		{"CumSum", newCumSum(), nil},
+		{"GRU", newGRU(), nil},
+		{"Reshape", newReshape(), nil},
+		{"Trilu", newTrilu(), nil},
+		{"Abs", operator13("Abs"), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(14), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
+
+func TestExtendedOperatorsExistInOpset13(t *testing.T) {
+	for _, opType := range []string{"Add", "Div", "GRU", "LSTM", "Mul", "Relu", "Reshape", "RNN", "Sub"} {
+		_, err := opset13.GetOperator(opType)
+		assert.Nil(t, err)
+	}
+}
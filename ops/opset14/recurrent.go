@@ -0,0 +1,100 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+// layoutAttr is the name of the attribute added to the recurrent operators in opset 14.
+// With layout 0, the first dim of the inputs and outputs is the sequence length. With
+// layout 1, the first dim is the batch size.
+const layoutAttr = "layout"
+
+// Permutations to switch between the batch major and sequence major layout.
+var (
+	perm3D = []int{1, 0, 2}
+	permY  = []int{2, 0, 1, 3}
+)
+
+// withoutLayout returns a copy of the node without the layout attribute, together with
+// whether the batch major layout is used, such that the node can be given to the
+// recurrent operators of opset 13.
+func withoutLayout(n *onnx.NodeProto, op ops.Operator) (*onnx.NodeProto, bool, error) {
+	node := &onnx.NodeProto{
+		Name:   n.GetName(),
+		OpType: n.GetOpType(),
+		Domain: n.GetDomain(),
+		Input:  n.GetInput(),
+		Output: n.GetOutput(),
+	}
+
+	batchMajor := false
+
+	for _, attr := range n.GetAttribute() {
+		if attr.GetName() != layoutAttr {
+			node.Attribute = append(node.Attribute, attr)
+			continue
+		}
+
+		switch attr.GetI() {
+		case 0:
+			batchMajor = false
+		case 1:
+			batchMajor = true
+		default:
+			return nil, false, ops.ErrInvalidAttribute(attr.GetName(), op)
+		}
+	}
+
+	return node, batchMajor, nil
+}
+
+// toSequenceMajor transposes the inputs at the given indices from the batch major layout
+// to the sequence major layout. The other inputs are kept as is.
+func toSequenceMajor(inputs []tensor.Tensor, indices ...int) ([]tensor.Tensor, error) {
+	transposed := make([]tensor.Tensor, len(inputs))
+	copy(transposed, inputs)
+
+	for _, i := range indices {
+		if i >= len(inputs) || inputs[i] == nil {
+			continue
+		}
+
+		t, err := tensor.Transpose(inputs[i], perm3D...)
+		if err != nil {
+			return nil, err
+		}
+
+		transposed[i] = t
+	}
+
+	return transposed, nil
+}
+
+// toBatchMajor transposes the outputs of a recurrent operator from the sequence major
+// layout to the batch major layout. The sequence of hidden states is the only output
+// with 4 dims, the other outputs are the last hidden and cell states with 3 dims.
+func toBatchMajor(outputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	transposed := make([]tensor.Tensor, len(outputs))
+
+	for i, output := range outputs {
+		if output == nil {
+			continue
+		}
+
+		perm := perm3D
+		if len(output.Shape()) == len(permY) {
+			perm = permY
+		}
+
+		t, err := tensor.Transpose(output, perm...)
+		if err != nil {
+			return nil, err
+		}
+
+		transposed[i] = t
+	}
+
+	return transposed, nil
+}
@@ -0,0 +1,141 @@
+package opset14
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestRecurrentInitLayout(t *testing.T) {
+	tests := []struct {
+		op       ops.Operator
+		layout   int64
+		expected bool
+		invalid  bool
+	}{
+		{newGRU(), 0, false, false},
+		{newGRU(), 1, true, false},
+		{newLSTM(), 1, true, false},
+		{newRNN(), 1, true, false},
+		{newRNN(), 2, false, true},
+	}
+
+	for _, test := range tests {
+		err := test.op.Init(&onnx.NodeProto{
+			Attribute: []*onnx.AttributeProto{{Name: "hidden_size", I: 4}, {Name: "layout", I: test.layout}},
+		})
+
+		if test.invalid {
+			assert.Equal(t, ops.ErrInvalidAttribute("layout", test.op), err)
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		switch op := test.op.(type) {
+		case *GRU:
+			assert.Equal(t, test.expected, op.batchMajor)
+		case *LSTM:
+			assert.Equal(t, test.expected, op.batchMajor)
+		case *RNN:
+			assert.Equal(t, test.expected, op.batchMajor)
+		}
+	}
+}
+
+func TestRecurrentBatchMajor(t *testing.T) {
+	const (
+		seqLength  = 3
+		batchSize  = 2
+		inputSize  = 3
+		hiddenSize = 4
+	)
+
+	tests := []struct {
+		newOp    func() ops.Operator
+		nGates   int
+		nOutputs int
+	}{
+		{newGRU, 3, 2},
+		{newLSTM, 4, 3},
+		{newRNN, 1, 2},
+	}
+
+	r := rand.New(rand.NewSource(42)) //nolint:gosec // Weak random numbers are fine for tests.
+
+	for _, test := range tests {
+		W := ops.RandomFloat32TensorFixture(r, 1, test.nGates*hiddenSize, inputSize)
+		R := ops.RandomFloat32TensorFixture(r, 1, test.nGates*hiddenSize, hiddenSize)
+		B := ops.RandomFloat32TensorFixture(r, 1, 2*test.nGates*hiddenSize)
+		X := ops.RandomFloat32TensorFixture(r, batchSize, seqLength, inputSize)
+		H := ops.RandomFloat32TensorFixture(r, batchSize, 1, hiddenSize)
+		C := ops.RandomFloat32TensorFixture(r, batchSize, 1, hiddenSize)
+
+		sequenceMajor, err := toSequenceMajor([]tensor.Tensor{X, W, R, B, nil, H, C}, 0, 5, 6)
+		assert.Nil(t, err)
+
+		batchMajorInputs := []tensor.Tensor{X, W, R, B, nil, H, C}
+		if test.nOutputs == 2 {
+			sequenceMajor = sequenceMajor[:6]
+			batchMajorInputs = batchMajorInputs[:6]
+		}
+
+		expected := applyRecurrentFixture(t, test.newOp(), 0, sequenceMajor)
+		actual := applyRecurrentFixture(t, test.newOp(), 1, batchMajorInputs)
+
+		assert.Len(t, actual, test.nOutputs)
+		assert.Equal(t, tensor.Shape{batchSize, seqLength, 1, hiddenSize}, actual[0].Shape())
+
+		for b := 0; b < batchSize; b++ {
+			for s := 0; s < seqLength; s++ {
+				for h := 0; h < hiddenSize; h++ {
+					want, err := expected[0].At(s, 0, b, h)
+					assert.Nil(t, err)
+
+					got, err := actual[0].At(b, s, 0, h)
+					assert.Nil(t, err)
+					assert.Equal(t, want, got)
+				}
+			}
+		}
+
+		for i := 1; i < test.nOutputs; i++ {
+			assert.Equal(t, tensor.Shape{batchSize, 1, hiddenSize}, actual[i].Shape())
+
+			for b := 0; b < batchSize; b++ {
+				for h := 0; h < hiddenSize; h++ {
+					want, err := expected[i].At(0, b, h)
+					assert.Nil(t, err)
+
+					got, err := actual[i].At(b, 0, h)
+					assert.Nil(t, err)
+					assert.Equal(t, want, got)
+				}
+			}
+		}
+	}
+}
+
+// applyRecurrentFixture initializes the recurrent operator with the given layout and
+// applies it to the inputs.
+func applyRecurrentFixture(t *testing.T, op ops.Operator, layout int64, inputs []tensor.Tensor) []tensor.Tensor {
+	t.Helper()
+
+	err := op.Init(&onnx.NodeProto{
+		Output:    []string{"Y", "Y_h", "Y_c"},
+		Attribute: []*onnx.AttributeProto{{Name: "hidden_size", I: 4}, {Name: "layout", I: layout}},
+	})
+	assert.Nil(t, err)
+
+	validated, err := op.ValidateInputs(inputs)
+	assert.Nil(t, err)
+
+	outputs, err := op.Apply(validated)
+	assert.Nil(t, err)
+
+	return outputs
+}
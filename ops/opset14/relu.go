@@ -0,0 +1,45 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Relu represents the ONNX relu operator of opset 14, which adds support for signed
+// integers and bfloat16 to the relu operator of opset 13.
+type Relu struct {
+	opset13.Relu
+}
+
+// newRelu creates a new relu operator.
+func newRelu() ops.Operator {
+	return &Relu{}
+}
+
+// Apply applies the relu operator. A bfloat16 input is applied as float32.
+func (r *Relu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyBFloat16AsFloat32(inputs, r.Relu.Apply)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *Relu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *Relu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{
+			tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+			onnx.BFloat16Dtype, tensor.Float32, tensor.Float64,
+		},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *Relu) String() string {
+	return "relu operator"
+}
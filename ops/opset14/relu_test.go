@@ -0,0 +1,70 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestRelu(t *testing.T) {
+	tests := []struct {
+		backing  any
+		expected any
+	}{
+		{[]int8{-1, 0, 1}, []int8{0, 0, 1}},
+		{[]int32{-4, 2, -3}, []int32{0, 2, 0}},
+		{[]float32{-0.5, 0.5, 2}, []float32{0, 0.5, 2}},
+		// Like for float32, a negative value results in a negative zero.
+		{[]onnx.BFloat16{0xbf00, 0x3f00, 0x4000}, []onnx.BFloat16{0x8000, 0x3f00, 0x4000}},
+	}
+
+	for _, test := range tests {
+		relu := newRelu()
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture(test.backing, 3)}
+
+		validated, err := relu.ValidateInputs(inputs)
+		assert.Nil(t, err)
+
+		res, err := relu.Apply(validated)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationRelu(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int16{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "uint8", &Relu{}),
+		},
+	}
+
+	for _, test := range tests {
+		relu := &Relu{}
+		validated, err := relu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
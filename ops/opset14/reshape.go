@@ -0,0 +1,136 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	ReshapeMinInputs = 2
+	ReshapeMaxInputs = 2
+)
+
+// Reshape represents the ONNX reshape operator of opset 14, which adds the allowzero
+// attribute to the reshape operator of opset 13.
+type Reshape struct {
+	allowZero bool
+}
+
+// newReshape creates a new reshape operator.
+func newReshape() ops.Operator {
+	return &Reshape{}
+}
+
+// Init initializes the reshape operator.
+func (r *Reshape) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "allowzero":
+			r.allowZero = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the reshape operator.
+func (r *Reshape) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	t := inputs[0]
+
+	newShape, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = processShape(newShape, t.Shape(), r.allowZero)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := t.Clone().(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", t.Clone())
+	}
+
+	err = out.Reshape(newShape...)
+
+	return []tensor.Tensor{out}, err
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *Reshape) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *Reshape) GetMinInputs() int {
+	return ReshapeMinInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *Reshape) GetMaxInputs() int {
+	return ReshapeMaxInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *Reshape) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *Reshape) String() string {
+	return "reshape operator"
+}
+
+// processShape replaces the 0 and -1 dim sizes of the new shape. A 0 dim size copies the
+// dim size of the current shape, unless allowZero is set, in which case it is an actual
+// dim size of 0. As empty tensors are not supported, this results in an error.
+func processShape(newShape, currentShape []int, allowZero bool) error {
+	for i := 0; i < len(newShape); i++ {
+		if newShape[i] != 0 {
+			continue
+		}
+
+		if allowZero {
+			return ops.ErrDimension("empty tensors are not supported")
+		}
+
+		if i >= len(currentShape) {
+			return ops.ErrDimension("could not infer dim size")
+		}
+
+		newShape[i] = currentShape[i]
+	}
+
+	// Calculate the total number of elements in the original tensor.
+	totalSize := ops.NElements(currentShape...)
+
+	for i := 0; i < len(newShape); i++ {
+		// When encountering a -1 dim size, calculate which size this should be.
+		if newShape[i] == -1 {
+			remainingSize := totalSize
+
+			for j := 0; j < len(newShape); j++ {
+				if j == i {
+					continue
+				}
+
+				if newShape[j] == -1 {
+					return ops.ErrDimension("at most one -1 dim size is allowed")
+				}
+
+				remainingSize /= newShape[j]
+			}
+
+			newShape[i] = remainingSize
+
+			break
+		}
+	}
+
+	return nil
+}
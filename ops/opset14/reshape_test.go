@@ -0,0 +1,129 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReshapeInit(t *testing.T) {
+	r := &Reshape{}
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "allowzero", I: 1}}})
+
+	assert.Nil(t, err)
+	assert.True(t, r.allowZero)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", r), err)
+}
+
+func TestReshape(t *testing.T) {
+	tests := []struct {
+		reshape    *Reshape
+		inputShape []int
+		newShape   []int64
+		expected   tensor.Shape
+		err        error
+	}{
+		{
+			&Reshape{},
+			[]int{2, 3},
+			[]int64{1, 6},
+			[]int{1, 6},
+			nil,
+		},
+		{
+			&Reshape{},
+			[]int{3, 4, 2},
+			[]int64{1, 0, -1},
+			[]int{1, 4, 6},
+			nil,
+		},
+		{
+			&Reshape{allowZero: true},
+			[]int{3, 4, 2},
+			[]int64{3, 4, -1},
+			[]int{3, 4, 2},
+			nil,
+		},
+		{
+			&Reshape{allowZero: true},
+			[]int{3, 4, 2},
+			[]int64{3, 0, 8},
+			nil,
+			ops.ErrDimension("empty tensors are not supported"),
+		},
+		{
+			&Reshape{},
+			[]int{3, 4, 2},
+			[]int64{-1, -1},
+			nil,
+			ops.ErrDimension("at most one -1 dim size is allowed"),
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.Float32TensorFixture(test.inputShape...),
+			tensor.New(tensor.WithBacking(test.newShape)),
+		}
+
+		res, err := test.reshape.Apply(inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Shape())
+		}
+	}
+}
+
+func TestReshapeDoesNotChangeInput(t *testing.T) {
+	input := ops.Float32TensorFixture(2, 3)
+	reshape := &Reshape{}
+
+	_, err := reshape.Apply([]tensor.Tensor{input, tensor.New(tensor.WithBacking([]int64{6}))})
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{2, 3}, input.Shape())
+}
+
+func TestInputValidationReshape(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{3, 4}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			ops.ErrInvalidInputCount(1, &Reshape{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{3, 4}, 2),
+			},
+			ops.ErrInvalidInputType(1, "int32", &Reshape{}),
+		},
+	}
+
+	for _, test := range tests {
+		reshape := &Reshape{}
+		validated, err := reshape.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
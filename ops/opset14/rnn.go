@@ -0,0 +1,70 @@
+package opset14
+
+import (
+	"context"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// RNN represents the ONNX rnn operator of opset 14, which adds the layout attribute to the
+// rnn operator of opset 13.
+type RNN struct {
+	opset13.RNN
+	batchMajor bool
+}
+
+// newRNN creates a new rnn operator.
+func newRNN() ops.Operator {
+	r, ok := operator13("RNN").(*opset13.RNN)
+	if !ok {
+		panic(ops.ErrTypeAssert("*opset13.RNN", r))
+	}
+
+	return &RNN{RNN: *r}
+}
+
+// Init initializes the rnn operator.
+func (r *RNN) Init(n *onnx.NodeProto) error {
+	node, batchMajor, err := withoutLayout(n, r)
+	if err != nil {
+		return err
+	}
+
+	r.batchMajor = batchMajor
+
+	return r.RNN.Init(node)
+}
+
+// Apply applies the rnn operator.
+func (r *RNN) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return r.ApplyContext(context.Background(), inputs)
+}
+
+// ApplyContext applies the rnn operator. With the batch major layout, the inputs are
+// transposed to the sequence major layout used by the rnn operator of opset 13, and the
+// outputs are transposed back.
+func (r *RNN) ApplyContext(ctx context.Context, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if !r.batchMajor {
+		return r.RNN.ApplyContext(ctx, inputs)
+	}
+
+	inputs, err := toSequenceMajor(inputs, 0, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := r.RNN.ApplyContext(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBatchMajor(outputs)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *RNN) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
@@ -0,0 +1,34 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Sub represents the ONNX sub operator of opset 14, which adds support for 8 and 16 bit
+// integers to the sub operator of opset 13.
+type Sub struct {
+	opset13.Sub
+}
+
+// newSub creates a new sub operator.
+func newSub() ops.Operator {
+	return &Sub{}
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Sub) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Sub) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{arithmeticTypes, arithmeticTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Sub) String() string {
+	return "sub operator"
+}
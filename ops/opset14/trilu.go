@@ -0,0 +1,146 @@
+package opset14
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinTriluInputs = 1
+	MaxTriluInputs = 2
+)
+
+// Trilu represents the ONNX trilu operator, which keeps the upper or lower triangular
+// part of the last two dims of a tensor and sets all other elements to zero.
+type Trilu struct {
+	upper bool
+}
+
+// newTrilu creates a new trilu operator.
+func newTrilu() ops.Operator {
+	return &Trilu{upper: true}
+}
+
+// Init initializes the trilu operator.
+func (t *Trilu) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "upper":
+			t.upper = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), t)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the trilu operator.
+func (t *Trilu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	shape := inputs[0].Shape()
+	if len(shape) < 2 {
+		return nil, ops.ErrInvalidInput("input must have at least 2 dimensions", t)
+	}
+
+	k := 0
+
+	if inputs[1] != nil {
+		values, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(values) != 1 {
+			return nil, ops.ErrInvalidInput("k must be a single value", t)
+		}
+
+		k = values[0]
+	}
+
+	out, ok := inputs[0].Clone().(tensor.Tensor)
+	if !ok {
+		return nil, ops.ErrTypeAssert("tensor.Tensor", inputs[0].Clone())
+	}
+
+	rows, cols := shape[len(shape)-2], shape[len(shape)-1]
+
+	switch data := out.Data().(type) {
+	case []uint8:
+		trilu(data, rows, cols, k, t.upper)
+	case []uint16:
+		trilu(data, rows, cols, k, t.upper)
+	case []uint32:
+		trilu(data, rows, cols, k, t.upper)
+	case []uint64:
+		trilu(data, rows, cols, k, t.upper)
+	case []int8:
+		trilu(data, rows, cols, k, t.upper)
+	case []int16:
+		trilu(data, rows, cols, k, t.upper)
+	case []int32:
+		trilu(data, rows, cols, k, t.upper)
+	case []int64:
+		trilu(data, rows, cols, k, t.upper)
+	case []float32:
+		trilu(data, rows, cols, k, t.upper)
+	case []float64:
+		trilu(data, rows, cols, k, t.upper)
+	case []complex64:
+		trilu(data, rows, cols, k, t.upper)
+	case []complex128:
+		trilu(data, rows, cols, k, t.upper)
+	case []string:
+		trilu(data, rows, cols, k, t.upper)
+	case []bool:
+		trilu(data, rows, cols, k, t.upper)
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), t)
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (t *Trilu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(t, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (t *Trilu) GetMinInputs() int {
+	return MinTriluInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (t *Trilu) GetMaxInputs() int {
+	return MaxTriluInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (t *Trilu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (t *Trilu) String() string {
+	return "trilu operator"
+}
+
+// trilu sets the elements outside the triangular part of every rows x cols matrix in the
+// data to zero. The diagonal is shifted by k, where a positive k shifts it upwards. When
+// upper is true, the elements on and above the diagonal are kept, otherwise the elements
+// on and below the diagonal are kept.
+func trilu[T any](data []T, rows, cols, k int, upper bool) {
+	var zero T
+
+	for offset := 0; offset < len(data); offset += rows * cols {
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				if (upper && j-i < k) || (!upper && j-i > k) {
+					data[offset+i*cols+j] = zero
+				}
+			}
+		}
+	}
+}
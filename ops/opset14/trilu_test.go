@@ -0,0 +1,108 @@
+package opset14
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestTriluInit(t *testing.T) {
+	trilu := newTrilu().(*Trilu)
+	assert.True(t, trilu.upper)
+
+	err := trilu.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "upper", I: 0}}})
+	assert.Nil(t, err)
+	assert.False(t, trilu.upper)
+}
+
+func TestTrilu(t *testing.T) {
+	tests := []struct {
+		trilu    *Trilu
+		shape    []int
+		k        tensor.Tensor
+		expected []float32
+	}{
+		{
+			&Trilu{upper: true},
+			[]int{3, 3},
+			nil,
+			[]float32{0, 1, 2, 0, 4, 5, 0, 0, 8},
+		},
+		{
+			&Trilu{upper: false},
+			[]int{3, 3},
+			nil,
+			[]float32{0, 0, 0, 3, 4, 0, 6, 7, 8},
+		},
+		{
+			&Trilu{upper: true},
+			[]int{2, 3},
+			tensor.New(tensor.FromScalar(int64(1))),
+			[]float32{0, 1, 2, 0, 0, 5},
+		},
+		{
+			&Trilu{upper: false},
+			[]int{3, 2},
+			tensor.New(tensor.FromScalar(int64(-1))),
+			[]float32{0, 0, 2, 0, 4, 5},
+		},
+		{
+			&Trilu{upper: true},
+			[]int{2, 2, 2},
+			nil,
+			[]float32{0, 1, 0, 3, 4, 5, 0, 7},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.Float32TensorFixture(test.shape...), test.k}
+
+		res, err := test.trilu.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, tensor.Shape(test.shape), res[0].Shape())
+	}
+}
+
+func TestTriluFail(t *testing.T) {
+	trilu := &Trilu{}
+
+	_, err := trilu.Apply([]tensor.Tensor{ops.Float32TensorFixture(3), nil})
+	assert.Equal(t, ops.ErrInvalidInput("input must have at least 2 dimensions", trilu), err)
+}
+
+func TestInputValidationTrilu(t *testing.T) {
+	tests := []struct {
+		inputs   []tensor.Tensor
+		expected []tensor.Tensor
+		err      error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true, false, true, false}, 2, 2)},
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true, false, true, false}, 2, 2), nil},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+			},
+			nil,
+			ops.ErrInvalidInputType(1, "int32", &Trilu{}),
+		},
+	}
+
+	for _, test := range tests {
+		trilu := &Trilu{}
+		validated, err := trilu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, validated)
+		}
+	}
+}
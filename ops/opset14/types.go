@@ -0,0 +1,10 @@
+package opset14
+
+import "gorgonia.org/tensor"
+
+// arithmeticTypes are the types supported by the arithmetic operators since opset 14.
+var arithmeticTypes = []tensor.Dtype{
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Float32, tensor.Float64,
+}
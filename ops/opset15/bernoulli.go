@@ -0,0 +1,124 @@
+package opset15
+
+import (
+	"math/rand"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinBernoulliInputs = 1
+	MaxBernoulliInputs = 1
+)
+
+// Bernoulli represents the ONNX bernoulli operator, which draws binary random numbers
+// (0 or 1) from a Bernoulli distribution. Every element of the input is the probability
+// of drawing a 1 for the corresponding element of the output.
+type Bernoulli struct {
+	dtype    int32
+	hasDtype bool
+	seed     int64
+	hasSeed  bool
+}
+
+// newBernoulli creates a new bernoulli operator.
+func newBernoulli() ops.Operator {
+	return &Bernoulli{}
+}
+
+// Init initializes the bernoulli operator.
+func (b *Bernoulli) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "dtype":
+			b.dtype = int32(attr.GetI())
+			b.hasDtype = true
+		case "seed":
+			b.seed = int64(attr.GetF())
+			b.hasSeed = true
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), b)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the bernoulli operator. When a seed is given, every run draws the same
+// numbers. Otherwise, the numbers are drawn from the global random source.
+func (b *Bernoulli) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	probabilities, err := ops.ConvertTensorDtype(inputs[0], int32(onnx.TensorProto_DOUBLE))
+	if err != nil {
+		return nil, err
+	}
+
+	draw := rand.Float64
+	if b.hasSeed {
+		draw = rand.New(rand.NewSource(b.seed)).Float64
+	}
+
+	p, ok := ops.IfScalarToSlice(probabilities.Data()).([]float64)
+	if !ok {
+		return nil, ops.ErrTypeAssert("[]float64", probabilities.Data())
+	}
+
+	backing := make([]float64, len(p))
+
+	for i, probability := range p {
+		if draw() < probability {
+			backing[i] = 1
+		}
+	}
+
+	var out tensor.Tensor = tensor.New(tensor.WithShape(inputs[0].Shape()...), tensor.WithBacking(backing))
+
+	if b.hasDtype {
+		out, err = ops.ConvertTensorDtype(out, b.dtype)
+	} else {
+		out, err = ops.ConvertTensorDtypeLike(out, inputs[0])
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (b *Bernoulli) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(b, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (b *Bernoulli) GetMinInputs() int {
+	return MinBernoulliInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (b *Bernoulli) GetMaxInputs() int {
+	return MaxBernoulliInputs
+}
+
+// GetOutputTypeInputs returns for every output the input of which it has the dtype. The
+// output has the dtype of the input, unless the dtype attribute is set.
+func (b *Bernoulli) GetOutputTypeInputs() []int {
+	if b.hasDtype {
+		return []int{-1}
+	}
+
+	return []int{0}
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (b *Bernoulli) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (b *Bernoulli) String() string {
+	return "bernoulli operator"
+}
@@ -0,0 +1,84 @@
+package opset15
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestBernoulliInit(t *testing.T) {
+	b := &Bernoulli{}
+	err := b.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "dtype", I: 11}, {Name: "seed", F: 3}}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Bernoulli{dtype: 11, hasDtype: true, seed: 3, hasSeed: true}, b)
+}
+
+func TestBernoulli(t *testing.T) {
+	tests := []struct {
+		bernoulli *Bernoulli
+		input     tensor.Tensor
+		expected  any
+	}{
+		{
+			&Bernoulli{},
+			ops.TensorWithBackingFixture([]float32{0, 1, 1, 0}, 2, 2),
+			[]float32{0, 1, 1, 0},
+		},
+		{
+			&Bernoulli{dtype: int32(onnx.TensorProto_INT32), hasDtype: true},
+			ops.TensorWithBackingFixture([]float64{1, 0, 1}, 3),
+			[]int32{1, 0, 1},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.bernoulli.Apply([]tensor.Tensor{test.input})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.input.Shape(), res[0].Shape())
+	}
+}
+
+func TestBernoulliSeed(t *testing.T) {
+	b := &Bernoulli{seed: 42, hasSeed: true}
+	input := ops.TensorWithBackingFixture([]float32{0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5}, 8)
+
+	first, err := b.Apply([]tensor.Tensor{input})
+	assert.Nil(t, err)
+
+	second, err := b.Apply([]tensor.Tensor{input})
+	assert.Nil(t, err)
+
+	assert.Equal(t, first[0].Data(), second[0].Data())
+}
+
+func TestInputValidationBernoulli(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{0.5}, 1)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1}, 1)},
+			ops.ErrInvalidInputType(0, "int32", &Bernoulli{}),
+		},
+	}
+
+	for _, test := range tests {
+		b := &Bernoulli{}
+		validated, err := b.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package opset15
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinCastLikeInputs = 2
+	MaxCastLikeInputs = 2
+)
+
+// castLikeTypes are the types which can be cast from and to.
+var castLikeTypes = []tensor.Dtype{
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Float32, tensor.Float64, onnx.Float16Dtype, onnx.BFloat16Dtype,
+	tensor.String,
+}
+
+// CastLike represents the ONNX castLike operator, which casts the first input to the
+// type of the second input.
+type CastLike struct{}
+
+// newCastLike creates a new castLike operator.
+func newCastLike() ops.Operator {
+	return &CastLike{}
+}
+
+// Init initializes the castLike operator.
+func (c *CastLike) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the castLike operator.
+func (c *CastLike) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ConvertTensorDtypeLike(inputs[0], inputs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *CastLike) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (c *CastLike) GetMinInputs() int {
+	return MinCastLikeInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (c *CastLike) GetMaxInputs() int {
+	return MaxCastLikeInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *CastLike) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{castLikeTypes, castLikeTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *CastLike) String() string {
+	return "castLike operator"
+}
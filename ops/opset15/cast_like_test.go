@@ -0,0 +1,79 @@
+package opset15
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCastLike(t *testing.T) {
+	tests := []struct {
+		input    tensor.Tensor
+		like     tensor.Tensor
+		expected any
+	}{
+		{
+			ops.TensorWithBackingFixture([]float64{1.5, -2.5}, 2),
+			ops.TensorWithBackingFixture([]float32{0}, 1),
+			[]float32{1.5, -2.5},
+		},
+		{
+			ops.TensorWithBackingFixture([]float32{1.5, -2.5}, 2),
+			ops.TensorWithBackingFixture([]int64{0, 0, 0}, 3),
+			[]int64{1, -2},
+		},
+		{
+			ops.TensorWithBackingFixture([]uint8{1, 2}, 2),
+			ops.TensorWithBackingFixture([]float64{0}, 1),
+			[]float64{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		castLike := &CastLike{}
+
+		res, err := castLike.Apply([]tensor.Tensor{test.input, test.like})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.input.Shape(), res[0].Shape())
+	}
+}
+
+func TestInputValidationCastLike(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int8{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int8{1, 2}, 2)},
+			ops.ErrInvalidInputCount(1, &CastLike{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]bool{true}, 1),
+			},
+			ops.ErrInvalidInputType(1, "bool", &CastLike{}),
+		},
+	}
+
+	for _, test := range tests {
+		castLike := &CastLike{}
+		validated, err := castLike.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
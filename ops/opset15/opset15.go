@@ -0,0 +1,41 @@
+package opset15
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+)
+
+// operators15 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 15. All other operators are the same as in opset 14.
+var operators15 = []ops.OperatorVersion{
+	{OpType: "Bernoulli", SinceVersion: 15, New: newBernoulli},
+	{OpType: "CastLike", SinceVersion: 15, New: newCastLike},
+	{OpType: "Optional", SinceVersion: 15, New: newOptional},
+	{OpType: "OptionalGetElement", SinceVersion: 15, New: newOptionalGetElement},
+	{OpType: "OptionalHasElement", SinceVersion: 15, New: newOptionalHasElement},
+	{OpType: "Shape", SinceVersion: 15, New: newShape},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 15. Operators
+// which did not change since opset 14 are taken from opset 14.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators15, operatorType); ok {
+		return op, nil
+	}
+
+	return opset14.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 15.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators15)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 15,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators15)
+}
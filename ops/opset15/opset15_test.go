@@ -0,0 +1,54 @@
+package opset15
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"Bernoulli", newBernoulli(), nil},
+		{"CastLike", newCastLike(), nil},
+		{"Optional", newOptional(), nil},
+		{"OptionalGetElement", newOptionalGetElement(), nil},
+		{"OptionalHasElement", newOptionalHasElement(), nil},
+		{"Shape", newShape(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("Trilu")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset14.Trilu{}, op)
+
+	op, err = GetOperator("Abs")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset13.Abs{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(15), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
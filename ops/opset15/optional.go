@@ -0,0 +1,80 @@
+package opset15
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinOptionalInputs = 0
+	MaxOptionalInputs = 1
+)
+
+// Optional represents the ONNX optional operator, which creates an optional value that
+// either contains the input, or is empty when no input is given. The input is either a
+// tensor or a sequence.
+type Optional struct{}
+
+// newOptional creates a new optional operator.
+func newOptional() ops.Operator {
+	return &Optional{}
+}
+
+// Init initializes the optional operator.
+func (o *Optional) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "type":
+			if attr.GetTp().GetTensorType() == nil && attr.GetTp().GetSequenceType() == nil {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), o)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), o)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the optional operator. Its output is an optional, hence it can only be
+// applied using ApplyValues.
+func (o *Optional) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(o, inputs)
+}
+
+// ApplyValues applies the optional operator.
+func (o *Optional) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	return []ops.Value{ops.Optional{Element: inputs[0]}}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (o *Optional) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(o, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (o *Optional) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(o, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (o *Optional) GetMinInputs() int {
+	return MinOptionalInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (o *Optional) GetMaxInputs() int {
+	return MaxOptionalInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (o *Optional) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (o *Optional) String() string {
+	return "optional operator"
+}
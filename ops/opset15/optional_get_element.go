@@ -0,0 +1,77 @@
+package opset15
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinOptionalGetElementInputs = 1
+	MaxOptionalGetElementInputs = 1
+)
+
+// OptionalGetElement represents the ONNX optionalGetElement operator, which returns the
+// element of the optional input. It is an error if the optional input is empty. An input
+// which is not an optional is returned as it is.
+type OptionalGetElement struct{}
+
+// newOptionalGetElement creates a new optionalGetElement operator.
+func newOptionalGetElement() ops.Operator {
+	return &OptionalGetElement{}
+}
+
+// Init initializes the optionalGetElement operator.
+func (o *OptionalGetElement) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the optionalGetElement operator.
+func (o *OptionalGetElement) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(o, inputs)
+}
+
+// ApplyValues applies the optionalGetElement operator.
+func (o *OptionalGetElement) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	element := inputs[0]
+	if optional, ok := element.(ops.Optional); ok {
+		element = optional.Element
+	}
+
+	if element == nil {
+		return nil, ops.ErrInvalidInput("optional input is empty", o)
+	}
+
+	return []ops.Value{element}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (o *OptionalGetElement) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(o, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (o *OptionalGetElement) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(o, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (o *OptionalGetElement) GetMinInputs() int {
+	return MinOptionalGetElementInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (o *OptionalGetElement) GetMaxInputs() int {
+	return MaxOptionalGetElementInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (o *OptionalGetElement) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (o *OptionalGetElement) String() string {
+	return "optionalGetElement operator"
+}
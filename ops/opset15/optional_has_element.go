@@ -0,0 +1,73 @@
+package opset15
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinOptionalHasElementInputs = 1
+	MaxOptionalHasElementInputs = 1
+)
+
+// OptionalHasElement represents the ONNX optionalHasElement operator, which returns a
+// scalar boolean tensor telling whether the optional input contains an element. An input
+// which is not an optional always contains an element, unless the input is skipped.
+type OptionalHasElement struct{}
+
+// newOptionalHasElement creates a new optionalHasElement operator.
+func newOptionalHasElement() ops.Operator {
+	return &OptionalHasElement{}
+}
+
+// Init initializes the optionalHasElement operator.
+func (o *OptionalHasElement) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the optionalHasElement operator.
+func (o *OptionalHasElement) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyTensors(o, inputs)
+}
+
+// ApplyValues applies the optionalHasElement operator.
+func (o *OptionalHasElement) ApplyValues(inputs []ops.Value) ([]ops.Value, error) {
+	element := inputs[0]
+	if optional, ok := element.(ops.Optional); ok {
+		element = optional.Element
+	}
+
+	return []ops.Value{tensor.New(tensor.FromScalar(element != nil))}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (o *OptionalHasElement) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(o, inputs)
+}
+
+// ValidateValues validates the values that will be given to ApplyValues for this operator.
+func (o *OptionalHasElement) ValidateValues(inputs []ops.Value) ([]ops.Value, error) {
+	return ops.ValidateValues(o, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (o *OptionalHasElement) GetMinInputs() int {
+	return MinOptionalHasElementInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (o *OptionalHasElement) GetMaxInputs() int {
+	return MaxOptionalHasElementInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (o *OptionalHasElement) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (o *OptionalHasElement) String() string {
+	return "optionalHasElement operator"
+}
@@ -0,0 +1,126 @@
+package opset15
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestOptionalInit(t *testing.T) {
+	tests := []struct {
+		attr []*onnx.AttributeProto
+		err  error
+	}{
+		{nil, nil},
+		{
+			[]*onnx.AttributeProto{{Name: "type", Tp: &onnx.TypeProto{
+				Value: &onnx.TypeProto_TensorType{TensorType: &onnx.TypeProto_Tensor{}},
+			}}},
+			nil,
+		},
+		{
+			[]*onnx.AttributeProto{{Name: "type", Tp: &onnx.TypeProto{
+				Value: &onnx.TypeProto_SequenceType{SequenceType: &onnx.TypeProto_Sequence{}},
+			}}},
+			nil,
+		},
+		{
+			[]*onnx.AttributeProto{{Name: "type", Tp: &onnx.TypeProto{
+				Value: &onnx.TypeProto_MapType{MapType: &onnx.TypeProto_Map{}},
+			}}},
+			ops.ErrUnsupportedAttribute("type", &Optional{}),
+		},
+		{
+			[]*onnx.AttributeProto{{Name: "unknown"}},
+			ops.ErrInvalidAttribute("unknown", &Optional{}),
+		},
+	}
+
+	for _, test := range tests {
+		o := &Optional{}
+		err := o.Init(&onnx.NodeProto{Attribute: test.attr})
+		assert.Equal(t, test.err, err)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	input := ops.Float32TensorFixture(2, 2)
+	tests := []struct {
+		inputs     []ops.Value
+		hasElement bool
+	}{
+		{[]ops.Value{input}, true},
+		{[]ops.Value{ops.Sequence{input}}, true},
+		{[]ops.Value{}, false},
+	}
+
+	for _, test := range tests {
+		optional := &Optional{}
+		inputs, err := optional.ValidateValues(test.inputs)
+		assert.Nil(t, err)
+
+		value, err := optional.ApplyValues(inputs)
+		assert.Nil(t, err)
+		assert.IsType(t, ops.Optional{}, value[0])
+
+		has, err := (&OptionalHasElement{}).ApplyValues(value)
+		assert.Nil(t, err)
+		assert.Equal(t, test.hasElement, has[0].(tensor.Tensor).Data())
+		assert.Equal(t, tensor.ScalarShape(), has[0].(tensor.Tensor).Shape())
+
+		element, err := (&OptionalGetElement{}).ApplyValues(value)
+		if test.hasElement {
+			assert.Nil(t, err)
+			assert.Equal(t, test.inputs[0], element[0])
+		} else {
+			assert.Equal(t, ops.ErrInvalidInput("optional input is empty", &OptionalGetElement{}), err)
+		}
+	}
+}
+
+func TestOptionalElementOfTensor(t *testing.T) {
+	input := ops.Float32TensorFixture(2, 2)
+
+	has, err := (&OptionalHasElement{}).Apply([]tensor.Tensor{input})
+	assert.Nil(t, err)
+	assert.Equal(t, true, has[0].Data())
+
+	has, err = (&OptionalHasElement{}).Apply([]tensor.Tensor{nil})
+	assert.Nil(t, err)
+	assert.Equal(t, false, has[0].Data())
+
+	element, err := (&OptionalGetElement{}).Apply([]tensor.Tensor{input})
+	assert.Nil(t, err)
+	assert.Same(t, input, element[0])
+
+	_, err = (&Optional{}).Apply([]tensor.Tensor{input})
+	assert.Equal(t, ops.ErrTypeAssert("tensor.Tensor", ops.Optional{Element: input}), err)
+}
+
+func TestInputValidationOptional(t *testing.T) {
+	tests := []struct {
+		op     ops.Operator
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{&Optional{}, []tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)}, nil},
+		{&Optional{}, ops.TensorInputsFixture(2), ops.ErrInvalidOptionalInputCount(2, &Optional{})},
+		{&OptionalHasElement{}, []tensor.Tensor{nil}, nil},
+		{&OptionalHasElement{}, []tensor.Tensor{}, ops.ErrInvalidInputCount(0, &OptionalHasElement{})},
+		{&OptionalGetElement{}, ops.TensorInputsFixture(1), nil},
+		{&OptionalGetElement{}, ops.TensorInputsFixture(2), ops.ErrInvalidInputCount(2, &OptionalGetElement{})},
+	}
+
+	for _, test := range tests {
+		validated, err := test.op.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package opset15
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Shape represents the ONNX shape operator of opset 15, which adds the start and end
+// attributes to the shape operator of opset 13. These select a slice of the dims of the
+// input.
+type Shape struct {
+	opset13.Shape
+	start  int
+	end    int
+	hasEnd bool
+}
+
+// newShape creates a new shape operator.
+func newShape() ops.Operator {
+	return &Shape{}
+}
+
+// Init initializes the shape operator.
+func (s *Shape) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "start":
+			s.start = int(attr.GetI())
+		case "end":
+			s.end = int(attr.GetI())
+			s.hasEnd = true
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply the shape operator to the graph. It creates a node that holds the dims of the
+// input from start up to end as 1D int64 tensor. Negative values of start and end count
+// from the last dim, and both are clamped to the rank of the input.
+func (s *Shape) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	nodeShape := inputs[0].Shape()
+	rank := len(nodeShape)
+
+	end := rank
+	if s.hasEnd {
+		end = clampAxis(s.end, rank)
+	}
+
+	start := clampAxis(s.start, rank)
+	if start >= end {
+		return nil, ops.ErrDimension("empty tensors are not supported")
+	}
+
+	shape := make([]int64, 0, end-start)
+	for _, dimSize := range nodeShape[start:end] {
+		shape = append(shape, int64(dimSize))
+	}
+
+	out := tensor.New(tensor.WithShape(len(shape)), tensor.WithBacking(shape))
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Shape) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Shape) String() string {
+	return "shape operator"
+}
+
+// clampAxis converts a negative axis to a positive one and clamps it to [0, rank].
+func clampAxis(axis, rank int) int {
+	if axis < 0 {
+		axis += rank
+	}
+
+	return min(max(axis, 0), rank)
+}
@@ -0,0 +1,75 @@
+package opset15
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestShapeInit(t *testing.T) {
+	s := &Shape{}
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "start", I: 1}, {Name: "end", I: -1}}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, s.start)
+	assert.Equal(t, -1, s.end)
+	assert.True(t, s.hasEnd)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", s), err)
+}
+
+func TestShape(t *testing.T) {
+	tests := []struct {
+		shape    *Shape
+		expected []int64
+		err      error
+	}{
+		{&Shape{}, []int64{2, 3, 4, 5}, nil},
+		{&Shape{start: 1}, []int64{3, 4, 5}, nil},
+		{&Shape{start: -1}, []int64{5}, nil},
+		{&Shape{start: 1, end: 2, hasEnd: true}, []int64{3}, nil},
+		{&Shape{end: -1, hasEnd: true}, []int64{2, 3, 4}, nil},
+		{&Shape{start: -10, end: 10, hasEnd: true}, []int64{2, 3, 4, 5}, nil},
+		{&Shape{start: 2, end: 1, hasEnd: true}, nil, ops.ErrDimension("empty tensors are not supported")},
+	}
+
+	for _, test := range tests {
+		res, err := test.shape.Apply([]tensor.Tensor{ops.Float32TensorFixture(2, 3, 4, 5)})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Data())
+		}
+	}
+}
+
+func TestInputValidationShape(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint32{3, 4}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &Shape{}),
+		},
+	}
+
+	for _, test := range tests {
+		shape := &Shape{}
+		validated, err := shape.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
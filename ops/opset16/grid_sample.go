@@ -0,0 +1,291 @@
+package opset16
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinGridSampleInputs = 2
+	MaxGridSampleInputs = 2
+
+	// gridSampleCubicAlpha is the coefficient used for bicubic interpolation.
+	gridSampleCubicAlpha = -0.75
+
+	// gridSampleRank is the only supported rank of the input, which is (N, C, H, W).
+	gridSampleRank = 4
+)
+
+// GridSample represents the ONNX gridSample operator, which samples the input at the
+// (normalized) locations given by the grid, using an interpolation mode and a padding
+// mode for locations outside of the input. Only 4D inputs are supported.
+type GridSample struct {
+	mode         string
+	paddingMode  string
+	alignCorners bool
+}
+
+// newGridSample creates a new gridSample operator.
+func newGridSample() ops.Operator {
+	return &GridSample{
+		mode:        "bilinear",
+		paddingMode: "zeros",
+	}
+}
+
+// Init initializes the gridSample operator.
+func (g *GridSample) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "align_corners":
+			g.alignCorners = ops.Int64ToBool(attr.GetI())
+		case "mode":
+			// The names 'linear' and 'cubic' are used from opset 20 onwards.
+			switch mode := string(attr.GetS()); mode {
+			case "bilinear", "linear":
+				g.mode = "bilinear"
+			case "bicubic", "cubic":
+				g.mode = "bicubic"
+			case "nearest":
+				g.mode = mode
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), g)
+			}
+		case "padding_mode":
+			switch paddingMode := string(attr.GetS()); paddingMode {
+			case "zeros", "border", "reflection":
+				g.paddingMode = paddingMode
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), g)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), g)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the gridSample operator.
+func (g *GridSample) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	x, grid := inputs[0], inputs[1]
+
+	xShape, gridShape := x.Shape(), grid.Shape()
+	if len(xShape) != gridSampleRank {
+		return nil, ops.ErrInvalidInput("only 4D inputs are supported", g)
+	}
+
+	if len(gridShape) != gridSampleRank || gridShape[0] != xShape[0] || gridShape[3] != 2 {
+		return nil, ops.ErrInvalidInput("grid should have shape (N, H_out, W_out, 2)", g)
+	}
+
+	xData, err := ops.Float64Data(x)
+	if err != nil {
+		return nil, err
+	}
+
+	gridData, err := ops.Float64Data(grid)
+	if err != nil {
+		return nil, err
+	}
+
+	n, c, h, w := xShape[0], xShape[1], xShape[2], xShape[3]
+	hOut, wOut := gridShape[1], gridShape[2]
+
+	out := make([]float64, n*c*hOut*wOut)
+
+	for b := 0; b < n; b++ {
+		for oy := 0; oy < hOut; oy++ {
+			for ox := 0; ox < wOut; ox++ {
+				gridIdx := ((b*hOut+oy)*wOut + ox) * 2
+				sx := g.denormalize(gridData[gridIdx], w)
+				sy := g.denormalize(gridData[gridIdx+1], h)
+
+				for ch := 0; ch < c; ch++ {
+					plane := xData[(b*c+ch)*h*w : (b*c+ch+1)*h*w]
+					out[((b*c+ch)*hOut+oy)*wOut+ox] = g.sample(plane, h, w, sy, sx)
+				}
+			}
+		}
+	}
+
+	res := tensor.New(tensor.WithShape(n, c, hOut, wOut), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtypeLike(res, x)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (g *GridSample) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(g, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (g *GridSample) GetMinInputs() int {
+	return MinGridSampleInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (g *GridSample) GetMaxInputs() int {
+	return MaxGridSampleInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (g *GridSample) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}, {tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (g *GridSample) String() string {
+	return "gridSample operator"
+}
+
+// sample computes the value of the plane, which has height h and width w, at the
+// unnormalized location (y, x).
+func (g *GridSample) sample(plane []float64, h, w int, y, x float64) float64 {
+	if g.mode == "nearest" {
+		y, x = math.RoundToEven(y), math.RoundToEven(x)
+	}
+
+	y = g.pad(y, h)
+	x = g.pad(x, w)
+
+	switch g.mode {
+	case "nearest":
+		return g.pixel(plane, h, w, int(y), int(x))
+	case "bicubic":
+		y0, x0 := math.Floor(y)-1, math.Floor(x)-1
+		yCoeffs, xCoeffs := cubicCoeffs(y-math.Floor(y)), cubicCoeffs(x-math.Floor(x))
+
+		result := 0.0
+
+		for i := 0; i < 4; i++ {
+			for j := 0; j < 4; j++ {
+				pixel := g.pixel(plane, h, w, int(y0)+i, int(x0)+j)
+				result += yCoeffs[i] * xCoeffs[j] * pixel
+			}
+		}
+
+		return result
+	default:
+		y0, x0 := math.Floor(y), math.Floor(x)
+		dy, dx := y-y0, x-x0
+
+		return (1-dy)*(1-dx)*g.pixel(plane, h, w, int(y0), int(x0)) +
+			(1-dy)*dx*g.pixel(plane, h, w, int(y0), int(x0)+1) +
+			dy*(1-dx)*g.pixel(plane, h, w, int(y0)+1, int(x0)) +
+			dy*dx*g.pixel(plane, h, w, int(y0)+1, int(x0)+1)
+	}
+}
+
+// denormalize maps a grid coordinate in the range [-1, 1] to a coordinate of an axis
+// with the given length.
+func (g *GridSample) denormalize(coord float64, length int) float64 {
+	if g.alignCorners {
+		return (coord + 1) / 2 * float64(length-1)
+	}
+
+	return ((coord+1)*float64(length) - 1) / 2
+}
+
+// bounds returns the range of coordinates of an axis with the given length which are
+// considered to be inside of the input.
+func (g *GridSample) bounds(length int) (float64, float64) {
+	if g.alignCorners {
+		return 0, float64(length - 1)
+	}
+
+	return -0.5, float64(length) - 0.5
+}
+
+// pad moves a coordinate which lies outside of the input back inside for the border and
+// reflection padding modes.
+func (g *GridSample) pad(coord float64, length int) float64 {
+	lower, upper := g.bounds(length)
+	if coord >= lower && coord <= upper {
+		return coord
+	}
+
+	switch g.paddingMode {
+	case "border":
+		return math.Min(math.Max(coord, 0), float64(length-1))
+	case "reflection":
+		return reflect(coord, lower, upper)
+	default:
+		return coord
+	}
+}
+
+// pixel returns the value of the plane at the given indices, taking the padding mode into
+// account for indices outside of the input.
+func (g *GridSample) pixel(plane []float64, h, w, y, x int) float64 {
+	switch g.paddingMode {
+	case "border":
+		y, x = min(max(y, 0), h-1), min(max(x, 0), w-1)
+	case "reflection":
+		yLower, yUpper := g.bounds(h)
+		xLower, xUpper := g.bounds(w)
+		y = int(reflect(float64(y), yLower, yUpper))
+		x = int(reflect(float64(x), xLower, xUpper))
+	default:
+		if y < 0 || y >= h || x < 0 || x >= w {
+			return 0
+		}
+	}
+
+	return plane[y*w+x]
+}
+
+// reflect reflects a coordinate at the bounds until it lies within the bounds.
+func reflect(coord, lower, upper float64) float64 {
+	rng := upper - lower
+	if rng <= 0 {
+		return lower
+	}
+
+	switch {
+	case coord < lower:
+		dist := lower - coord
+		n := math.Floor(dist / rng)
+		rest := dist - n*rng
+
+		if int(n)%2 == 0 {
+			return lower + rest
+		}
+
+		return upper - rest
+	case coord > upper:
+		dist := coord - upper
+		n := math.Floor(dist / rng)
+		rest := dist - n*rng
+
+		if int(n)%2 == 0 {
+			return upper - rest
+		}
+
+		return lower + rest
+	default:
+		return coord
+	}
+}
+
+// cubicCoeffs returns the weights of the 4 neighbouring pixels for bicubic interpolation,
+// given the distance to the pixel before the coordinate.
+func cubicCoeffs(dist float64) [4]float64 {
+	a := gridSampleCubicAlpha
+
+	return [4]float64{
+		((a*(dist+1)-5*a)*(dist+1)+8*a)*(dist+1) - 4*a,
+		((a+2)*dist-(a+3))*dist*dist + 1,
+		((a+2)*(1-dist)-(a+3))*(1-dist)*(1-dist) + 1,
+		((a*(2-dist)-5*a)*(2-dist)+8*a)*(2-dist) - 4*a,
+	}
+}
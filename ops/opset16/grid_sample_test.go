@@ -0,0 +1,131 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestGridSampleInit(t *testing.T) {
+	g := newGridSample().(*GridSample)
+
+	err := g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "align_corners", I: 1},
+		{Name: "mode", S: []byte("cubic")},
+		{Name: "padding_mode", S: []byte("reflection")},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &GridSample{mode: "bicubic", paddingMode: "reflection", alignCorners: true}, g)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("area")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", g), err)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "padding_mode", S: []byte("wrap")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("padding_mode", g), err)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", g), err)
+}
+
+func TestGridSample(t *testing.T) {
+	tests := []struct {
+		gridSample *GridSample
+		grid       []float32
+		expected   []float32
+	}{
+		{
+			&GridSample{mode: "bilinear", paddingMode: "zeros"},
+			[]float32{-1, -1, 0, 0, 1, 1},
+			[]float32{0.25, 2.5, 1},
+		},
+		{
+			&GridSample{mode: "bilinear", paddingMode: "zeros", alignCorners: true},
+			[]float32{-1, -1, 0, 0, 1, 1},
+			[]float32{1, 2.5, 4},
+		},
+		{
+			&GridSample{mode: "nearest", paddingMode: "zeros"},
+			[]float32{-1, -1, 0, 0, 1, 1},
+			[]float32{1, 1, 0},
+		},
+		{
+			&GridSample{mode: "bilinear", paddingMode: "border"},
+			[]float32{-2, -2, 0, 0, 2, 2},
+			[]float32{1, 2.5, 4},
+		},
+		{
+			&GridSample{mode: "bilinear", paddingMode: "reflection", alignCorners: true},
+			[]float32{-2, -1, 0, 0, 1, 2},
+			[]float32{1.5, 2.5, 3},
+		},
+		{
+			&GridSample{mode: "bicubic", paddingMode: "border", alignCorners: true},
+			[]float32{-1, -1, 1, -1, 1, 1},
+			[]float32{1, 2, 4},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 1, 2, 2),
+			ops.TensorWithBackingFixture(test.grid, 1, 1, 3, 2),
+		}
+
+		res, err := test.gridSample.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, tensor.Shape{1, 1, 1, 3}, res[0].Shape())
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestGridSampleFloat64(t *testing.T) {
+	gridSample := &GridSample{mode: "bilinear", paddingMode: "zeros", alignCorners: true}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float64{1, 2, 3, 4, 5, 6, 7, 8}, 1, 2, 2, 2),
+		ops.TensorWithBackingFixture([]float32{0, 0}, 1, 1, 1, 2),
+	}
+
+	res, err := gridSample.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{2.5, 6.5}, res[0].Data())
+}
+
+func TestInputValidationGridSample(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			ops.ErrInvalidInputCount(1, &GridSample{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			ops.ErrInvalidInputType(0, "int32", &GridSample{}),
+		},
+	}
+
+	for _, test := range tests {
+		gridSample := &GridSample{}
+		validated, err := gridSample.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package opset16
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// LeakyRelu represents the ONNX leakyRelu operator of opset 16, which adds support for
+// bfloat16 to the leakyRelu operator of opset 6.
+type LeakyRelu struct {
+	opset13.LeakyRelu
+}
+
+// newLeakyRelu creates a new leakyRelu operator.
+func newLeakyRelu() ops.Operator {
+	l, ok := operator15("LeakyRelu").(*opset13.LeakyRelu)
+	if !ok {
+		panic(ops.ErrTypeAssert("*opset13.LeakyRelu", l))
+	}
+
+	return &LeakyRelu{LeakyRelu: *l}
+}
+
+// Apply applies the leakyRelu operator. A bfloat16 input is applied as float32.
+func (l *LeakyRelu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyBFloat16AsFloat32(inputs, l.LeakyRelu.Apply)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (l *LeakyRelu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(l, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (l *LeakyRelu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{onnx.BFloat16Dtype, tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (l *LeakyRelu) String() string {
+	return "leakyRelu operator"
+}
@@ -0,0 +1,71 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestLeakyRelu(t *testing.T) {
+	tests := []struct {
+		backing  any
+		expected any
+	}{
+		{[]float32{-2, -1, 0, 1}, []float32{-0.02, -0.01, 0, 1}},
+		{
+			[]onnx.BFloat16{0xc000, 0xbf80, 0x0000, 0x3f80},
+			// -0.02, -0.01, 0 and 1, truncated to bfloat16.
+			[]onnx.BFloat16{0xbca3, 0xbc23, 0x0000, 0x3f80},
+		},
+	}
+
+	for _, test := range tests {
+		leakyRelu := newLeakyRelu()
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture(test.backing, 4)}
+
+		validated, err := leakyRelu.ValidateInputs(inputs)
+		assert.Nil(t, err)
+
+		res, err := leakyRelu.Apply(validated)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationLeakyRelu(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &LeakyRelu{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.Float16{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "Float16", &LeakyRelu{}),
+		},
+	}
+
+	for _, test := range tests {
+		leakyRelu := &LeakyRelu{}
+		validated, err := leakyRelu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package opset16
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset15"
+)
+
+// operators16 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 16. All other operators are the same as in opset 15.
+var operators16 = []ops.OperatorVersion{
+	{OpType: "GridSample", SinceVersion: 16, New: newGridSample},
+	{OpType: "LeakyRelu", SinceVersion: 16, New: newLeakyRelu},
+	{OpType: "PRelu", SinceVersion: 16, New: newPRelu},
+	{OpType: "ScatterElements", SinceVersion: 16, New: newScatterElements},
+	{OpType: "ScatterND", SinceVersion: 16, New: newScatterND},
+	{OpType: "Where", SinceVersion: 16, New: newWhere},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 16. Operators
+// which did not change since opset 15 are taken from opset 15.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators16, operatorType); ok {
+		return op, nil
+	}
+
+	return opset15.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 16.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators16)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 16,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators16)
+}
+
+func operator15(operatorType string) ops.Operator {
+	op, err := opset15.GetOperator(operatorType)
+	if err != nil {
+		// Only operators which exist in opset 15 are extended.
+		panic(err)
+	}
+
+	return op
+}
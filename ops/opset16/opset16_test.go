@@ -0,0 +1,54 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+	"github.com/advancedclimatesystems/gonnx/ops/opset15"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"GridSample", newGridSample(), nil},
+		{"LeakyRelu", newLeakyRelu(), nil},
+		{"PRelu", newPRelu(), nil},
+		{"ScatterElements", newScatterElements(), nil},
+		{"ScatterND", newScatterND(), nil},
+		{"Where", newWhere(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("Shape")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset15.Shape{}, op)
+
+	op, err = GetOperator("Relu")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset14.Relu{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(16), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
@@ -0,0 +1,55 @@
+package opset16
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// preluTypes are the dtypes of the inputs of the prelu operator of opset 16.
+var preluTypes = []tensor.Dtype{
+	tensor.Uint32, tensor.Uint64, tensor.Int32, tensor.Int64,
+	onnx.BFloat16Dtype, tensor.Float32, tensor.Float64,
+}
+
+// PRelu represents the ONNX prelu operator of opset 16, which adds support for bfloat16
+// to the prelu operator of opset 9.
+type PRelu struct {
+	opset13.PRelu
+}
+
+// newPRelu creates a new prelu operator.
+func newPRelu() ops.Operator {
+	return &PRelu{}
+}
+
+// Apply applies the prelu operator. Bfloat16 inputs are applied as float32.
+func (op *PRelu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyBFloat16AsFloat32(inputs, op.PRelu.Apply)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (op *PRelu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(op, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if inputs[0].Dtype() != inputs[1].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'slope' does not match DType of 'x'", op)
+	}
+
+	return inputs, nil
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (op *PRelu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{preluTypes, preluTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (op *PRelu) String() string {
+	return "prelu operator"
+}
@@ -0,0 +1,81 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestPRelu(t *testing.T) {
+	tests := []struct {
+		backing  any
+		slope    any
+		expected any
+	}{
+		{
+			[]float32{-2, -1, 1, 2},
+			[]float32{0.5, 2, 0.5, 2},
+			[]float32{-1, -2, 1, 2},
+		},
+		{
+			[]onnx.BFloat16{0xc000, 0xbf80, 0x3f80, 0x4000},
+			[]onnx.BFloat16{0x3f00, 0x4000, 0x3f00, 0x4000},
+			[]onnx.BFloat16{0xbf80, 0xc000, 0x3f80, 0x4000},
+		},
+	}
+
+	for _, test := range tests {
+		prelu := newPRelu()
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture(test.backing, 2, 2),
+			ops.TensorWithBackingFixture(test.slope, 2, 2),
+		}
+
+		validated, err := prelu.ValidateInputs(inputs)
+		assert.Nil(t, err)
+
+		res, err := prelu.Apply(validated)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationPRelu(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &PRelu{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			ops.ErrInvalidTensor("DType of 'slope' does not match DType of 'x'", &PRelu{}),
+		},
+	}
+
+	for _, test := range tests {
+		prelu := &PRelu{}
+		validated, err := prelu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
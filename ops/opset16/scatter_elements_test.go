@@ -0,0 +1,48 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestScatterElementsInit(t *testing.T) {
+	s := newScatterElements().(*ScatterElements)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "axis", I: 1},
+		{Name: "reduction", S: []byte("add")},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, s.axis)
+	assert.Equal(t, ops.ScatterAdd, s.reduction)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("min")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("reduction", s), err)
+}
+
+func TestScatterElements(t *testing.T) {
+	tests := []struct {
+		scatterElements *ScatterElements
+		expected        []float32
+	}{
+		{&ScatterElements{axis: 1, reduction: ops.ScatterNone}, []float32{1, 10, 3, 4, 5, 30}},
+		{&ScatterElements{axis: 1, reduction: ops.ScatterAdd}, []float32{1, 12, 3, 4, 5, 36}},
+		{&ScatterElements{axis: -1, reduction: ops.ScatterMul}, []float32{1, 20, 3, 4, 5, 180}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, 2, 3),
+			ops.TensorWithBackingFixture([]int64{1, 2}, 2, 1),
+			ops.TensorWithBackingFixture([]float32{10, 30}, 2, 1),
+		}
+
+		res, err := test.scatterElements.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
@@ -0,0 +1,70 @@
+package opset16
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// ScatterND represents the ONNX scatterND operator. Since opset 16 the updates can be
+// combined with the data using a reduction.
+type ScatterND struct {
+	opset13.ScatterND
+	reduction ops.ScatterReduction
+}
+
+// newScatterND creates a new scatterND operator.
+func newScatterND() ops.Operator {
+	return &ScatterND{reduction: ops.ScatterNone}
+}
+
+// Init initializes the scatterND operator.
+func (s *ScatterND) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "reduction":
+			reduction, err := parseReduction(attr, s)
+			if err != nil {
+				return err
+			}
+
+			s.reduction = reduction
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the scatterND operator.
+func (s *ScatterND) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ScatterND(inputs[0], inputs[1], inputs[2], s.reduction)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *ScatterND) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateScatterInputs(s, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *ScatterND) String() string {
+	return "scatterND operator"
+}
+
+// parseReduction parses the reduction attribute of a scatter operator. Opset 16 supports
+// the 'none', 'add' and 'mul' reductions.
+func parseReduction(attr *onnx.AttributeProto, op ops.Operator) (ops.ScatterReduction, error) {
+	switch reduction := ops.ScatterReduction(attr.GetS()); reduction {
+	case ops.ScatterNone, ops.ScatterAdd, ops.ScatterMul:
+		return reduction, nil
+	default:
+		return "", ops.ErrUnsupportedAttribute(attr.GetName(), op)
+	}
+}
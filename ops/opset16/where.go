@@ -0,0 +1,53 @@
+package opset16
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Where represents the ONNX where operator of opset 16, which adds support for bfloat16
+// to the where operator of opset 13.
+type Where struct {
+	opset13.Where
+}
+
+// newWhere creates a new where operator.
+func newWhere() ops.Operator {
+	return &Where{}
+}
+
+// Apply applies the where operator. Bfloat16 inputs are applied as float32.
+func (w *Where) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ApplyBFloat16AsFloat32(inputs, w.Where.Apply)
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (w *Where) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(w, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if inputs[1].Dtype() != inputs[2].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'Y' does not match DType of 'X'", w)
+	}
+
+	return inputs, nil
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (w *Where) GetInputTypeConstraints() [][]tensor.Dtype {
+	types := append(slices.Clone(ops.AllTypes), onnx.BFloat16Dtype)
+
+	return [][]tensor.Dtype{{tensor.Bool}, types, types}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (w *Where) String() string {
+	return "where operator"
+}
@@ -0,0 +1,87 @@
+package opset16
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		inputs   []tensor.Tensor
+		expected any
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false, false, true}, 2, 2),
+				ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+				ops.TensorWithBackingFixture([]float32{-1, -2, -3, -4}, 2, 2),
+			},
+			[]float32{1, -2, -3, 4},
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false, false, true}, 2, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{0x3f80, 0x4000, 0x4040, 0x4080}, 2, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{0xbf80, 0xc000}, 2),
+			},
+			[]onnx.BFloat16{0x3f80, 0xc000, 0xbf80, 0x4080},
+		},
+	}
+
+	for _, test := range tests {
+		where := newWhere()
+
+		validated, err := where.ValidateInputs(test.inputs)
+		assert.Nil(t, err)
+
+		res, err := where.Apply(validated)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationWhere(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{3, 4}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+			},
+			ops.ErrInvalidInputCount(2, &Where{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]bool{true, false}, 2),
+				ops.TensorWithBackingFixture([]onnx.BFloat16{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{3, 4}, 2),
+			},
+			ops.ErrInvalidTensor("DType of 'Y' does not match DType of 'X'", &Where{}),
+		},
+	}
+
+	for _, test := range tests {
+		where := &Where{}
+		validated, err := where.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDFTInit(t *testing.T) {
+	d := newDFT().(*DFT)
+	assert.Equal(t, 1, d.axis)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "axis", I: 2},
+		{Name: "inverse", I: 1},
+		{Name: "onesided", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &DFT{axis: 2, inverse: true, onesided: true}, d)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", d), err)
+}
+
+func TestDFT(t *testing.T) {
+	tests := []struct {
+		dft           *DFT
+		input         tensor.Tensor
+		dftLength     tensor.Tensor
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{
+			&DFT{axis: 1},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 4, 1),
+			nil,
+			[]float32{10, 0, -2, 2, -2, 0, -2, -2},
+			tensor.Shape{1, 4, 2},
+		},
+		{
+			&DFT{axis: 1, onesided: true},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 4, 1),
+			nil,
+			[]float32{10, 0, -2, 2, -2, 0},
+			tensor.Shape{1, 3, 2},
+		},
+		{
+			&DFT{axis: 1},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 4, 1),
+			tensor.New(tensor.FromScalar(int64(2))),
+			[]float32{3, 0, -1, 0},
+			tensor.Shape{1, 2, 2},
+		},
+		{
+			&DFT{axis: 1, inverse: true},
+			ops.TensorWithBackingFixture([]float32{10, 0, -2, 2, -2, 0, -2, -2}, 1, 4, 2),
+			nil,
+			[]float32{1, 0, 2, 0, 3, 0, 4, 0},
+			tensor.Shape{1, 4, 2},
+		},
+		{
+			&DFT{axis: 2},
+			ops.TensorWithBackingFixture([]float32{1, 1, 1, -1}, 1, 2, 2, 1),
+			nil,
+			[]float32{2, 0, 0, 0, 0, 0, 2, 0},
+			tensor.Shape{1, 2, 2, 2},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.dft.Apply([]tensor.Tensor{test.input, test.dftLength})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestDFTInvalidAxis(t *testing.T) {
+	dft := &DFT{axis: 2}
+
+	_, err := dft.Apply([]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2, 1), nil})
+	assert.Equal(t, ops.ErrAxisOutOfRange(1, 1, 2), err)
+}
+
+func TestInputValidationDFT(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{1, 2}, 1, 2, 1)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 1, 2, 1)},
+			ops.ErrInvalidInputType(0, "int32", &DFT{}),
+		},
+	}
+
+	for _, test := range tests {
+		dft := &DFT{}
+		_, err := dft.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
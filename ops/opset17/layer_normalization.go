@@ -0,0 +1,217 @@
+package opset17
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinLayerNormalizationInputs = 2
+	MaxLayerNormalizationInputs = 3
+
+	// LayerNormalizationDefaultEpsilon is the default value of the epsilon attribute.
+	LayerNormalizationDefaultEpsilon = 1e-5
+)
+
+// LayerNormalization represents the ONNX layerNormalization operator, which normalizes
+// the input over the axis and all following axes, and then scales and shifts the result.
+// Besides the result, the mean and the inverse standard deviation can be returned.
+type LayerNormalization struct {
+	axis     int
+	epsilon  float32
+	nOutputs int
+}
+
+// newLayerNormalization creates a new layerNormalization operator.
+func newLayerNormalization() ops.Operator {
+	return &LayerNormalization{
+		axis:     -1,
+		epsilon:  LayerNormalizationDefaultEpsilon,
+		nOutputs: 1,
+	}
+}
+
+// Init initializes the layerNormalization operator.
+func (l *LayerNormalization) Init(n *onnx.NodeProto) error {
+	if len(n.GetOutput()) > 0 {
+		l.nOutputs = len(n.GetOutput())
+	}
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			l.axis = int(attr.GetI())
+		case "epsilon":
+			l.epsilon = attr.GetF()
+		case "stash_type":
+			// The computation is always done in the type of the input.
+			if attr.GetI() != int64(onnx.TensorProto_FLOAT) {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), l)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), l)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the layerNormalization operator.
+func (l *LayerNormalization) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	X := inputs[0]
+	shape := X.Shape()
+	rank := len(shape)
+
+	if l.axis < -rank || l.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, l.axis)
+	}
+
+	axis := ops.ConvertNegativeAxis(l.axis, rank)
+
+	// The scale and bias are broadcasted to the shape of the input.
+	_, scale, err := ops.UnidirectionalBroadcast(X, inputs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var bias any
+
+	if inputs[2] != nil {
+		_, B, err := ops.UnidirectionalBroadcast(X, inputs[2])
+		if err != nil {
+			return nil, err
+		}
+
+		bias = ops.IfScalarToSlice(B.Data())
+	}
+
+	var Y, mean, invStdDev any
+
+	switch x := X.Data().(type) {
+	case []float32:
+		Y, mean, invStdDev, err = layerNormalization(x, ops.IfScalarToSlice(scale.Data()), bias, shape[axis:].TotalSize(), float64(l.epsilon))
+	case []float64:
+		Y, mean, invStdDev, err = layerNormalization(x, ops.IfScalarToSlice(scale.Data()), bias, shape[axis:].TotalSize(), float64(l.epsilon))
+	default:
+		return nil, ops.ErrInvalidInputType(0, X.Dtype().String(), l)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	statShape := shape.Clone()
+	for i := axis; i < rank; i++ {
+		statShape[i] = 1
+	}
+
+	outputs := []tensor.Tensor{
+		tensor.New(tensor.WithShape(shape...), tensor.WithBacking(Y)),
+		tensor.New(tensor.WithShape(statShape...), tensor.WithBacking(mean)),
+		tensor.New(tensor.WithShape(statShape...), tensor.WithBacking(invStdDev)),
+	}
+
+	return outputs[:l.nOutputs], nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (l *LayerNormalization) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(l, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs[1:] {
+		if input != nil && input.Dtype() != inputs[0].Dtype() {
+			return nil, ops.ErrInvalidInputType(i+1, input.Dtype().String(), l)
+		}
+	}
+
+	return inputs, nil
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (l *LayerNormalization) GetMinInputs() int {
+	return MinLayerNormalizationInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (l *LayerNormalization) GetMaxInputs() int {
+	return MaxLayerNormalizationInputs
+}
+
+// GetOutputTypeInputs returns for every output the input of which it has the dtype. The
+// mean and inverse standard deviation have the dtype of the stash_type attribute.
+func (l *LayerNormalization) GetOutputTypeInputs() []int {
+	return []int{0, -1, -1}
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (l *LayerNormalization) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (l *LayerNormalization) String() string {
+	return "layerNormalization operator"
+}
+
+// layerNormalization normalizes every consecutive group of size elements of x, and returns
+// the result after scaling and shifting it, together with the mean and the inverse standard
+// deviation of every group. The scale and bias have the same length as x, the bias can be nil.
+func layerNormalization[T ops.FloatType](x []T, scale, bias any, size int, epsilon float64) (y, mean, invStdDev []T, err error) {
+	scaleData, ok := scale.([]T)
+	if !ok {
+		return nil, nil, nil, ops.ErrTypeAssert("list", scale)
+	}
+
+	biasData, ok := bias.([]T)
+	if bias != nil && !ok {
+		return nil, nil, nil, ops.ErrTypeAssert("list", bias)
+	}
+
+	groups := len(x) / size
+	y = make([]T, len(x))
+	mean = make([]T, groups)
+	invStdDev = make([]T, groups)
+
+	for g := 0; g < groups; g++ {
+		values := x[g*size : (g+1)*size]
+
+		sum := 0.0
+		for _, value := range values {
+			sum += float64(value)
+		}
+
+		groupMean := sum / float64(size)
+
+		variance := 0.0
+		for _, value := range values {
+			variance += (float64(value) - groupMean) * (float64(value) - groupMean)
+		}
+
+		groupInvStdDev := 1 / math.Sqrt(variance/float64(size)+epsilon)
+
+		for i, value := range values {
+			j := g*size + i
+			y[j] = T((float64(value)-groupMean)*groupInvStdDev) * scaleData[j]
+
+			if biasData != nil {
+				y[j] += biasData[j]
+			}
+		}
+
+		mean[g] = T(groupMean)
+		invStdDev[g] = T(groupInvStdDev)
+	}
+
+	return y, mean, invStdDev, nil
+}
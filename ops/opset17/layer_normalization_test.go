@@ -0,0 +1,118 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestLayerNormalizationInit(t *testing.T) {
+	l := newLayerNormalization().(*LayerNormalization)
+
+	err := l.Init(&onnx.NodeProto{
+		Output: []string{"y", "mean", "inv_std_dev"},
+		Attribute: []*onnx.AttributeProto{
+			{Name: "axis", I: 1},
+			{Name: "epsilon", F: 0.1},
+			{Name: "stash_type", I: 1},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &LayerNormalization{axis: 1, epsilon: 0.1, nOutputs: 3}, l)
+
+	err = l.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "stash_type", I: 11}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("stash_type", l), err)
+}
+
+func TestLayerNormalization(t *testing.T) {
+	tests := []struct {
+		layerNormalization *LayerNormalization
+		scale              tensor.Tensor
+		bias               tensor.Tensor
+		expected           []float32
+		expectedMean       []float32
+		expectedShape      tensor.Shape
+	}{
+		{
+			&LayerNormalization{axis: -1, nOutputs: 3},
+			ops.TensorWithBackingFixture([]float32{1, 1}, 2),
+			nil,
+			[]float32{-1, 1, -1, 1},
+			[]float32{1.5, 3.5},
+			tensor.Shape{2, 1},
+		},
+		{
+			&LayerNormalization{axis: 0, nOutputs: 3},
+			ops.TensorWithBackingFixture([]float32{2, 2, 2, 2}, 2, 2),
+			ops.TensorWithBackingFixture([]float32{1}, 1),
+			[]float32{-1.6832815, 0.1055728, 1.8944272, 3.6832815},
+			[]float32{2.5},
+			tensor.Shape{1, 1},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			test.scale,
+			test.bias,
+		}
+
+		res, err := test.layerNormalization.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(res))
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-4)
+		assert.Equal(t, test.expectedShape, res[1].Shape())
+		assert.InDeltaSlice(t, test.expectedMean, ops.IfScalarToSlice(res[1].Data()), 1e-6)
+	}
+}
+
+func TestLayerNormalizationSingleOutput(t *testing.T) {
+	layerNormalization := &LayerNormalization{axis: 1, nOutputs: 1}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float64{1, 3}, 1, 2),
+		ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float64{0, 1}, 2),
+	}
+
+	res, err := layerNormalization.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.InDeltaSlice(t, []float64{-1, 3}, res[0].Data(), 1e-9)
+}
+
+func TestInputValidationLayerNormalization(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+			},
+			ops.ErrInvalidInputType(1, "float64", &LayerNormalization{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			ops.ErrInvalidOptionalInputCount(1, &LayerNormalization{}),
+		},
+	}
+
+	for _, test := range tests {
+		layerNormalization := &LayerNormalization{}
+		_, err := layerNormalization.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
@@ -0,0 +1,153 @@
+package opset17
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinMelWeightMatrixInputs = 5
+	MaxMelWeightMatrixInputs = 5
+)
+
+// MelWeightMatrix represents the ONNX melWeightMatrix operator, which generates a matrix to
+// map the bins of a onesided spectrum to bins of the mel scale. The matrix has shape
+// (dft_length / 2 + 1, num_mel_bins), and holds a triangular filter for every mel bin.
+type MelWeightMatrix struct {
+	outputDatatype int32
+}
+
+// newMelWeightMatrix creates a new melWeightMatrix operator.
+func newMelWeightMatrix() ops.Operator {
+	return &MelWeightMatrix{
+		outputDatatype: int32(onnx.TensorProto_FLOAT),
+	}
+}
+
+// Init initializes the melWeightMatrix operator.
+func (m *MelWeightMatrix) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "output_datatype":
+			m.outputDatatype = int32(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), m)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the melWeightMatrix operator.
+func (m *MelWeightMatrix) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	params := make([]float64, len(inputs))
+
+	for i, input := range inputs {
+		values, err := ops.Float64Data(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(values) != 1 {
+			return nil, ops.ErrInvalidInput("all inputs should be scalars", m)
+		}
+
+		params[i] = values[0]
+	}
+
+	nMelBins, dftLength, sampleRate := int(params[0]), int(params[1]), params[2]
+	lowerEdgeHertz, upperEdgeHertz := params[3], params[4]
+
+	if nMelBins < 1 || dftLength < 1 || sampleRate <= 0 {
+		return nil, ops.ErrInvalidInput("number of mel bins, dft length and sample rate should be positive", m)
+	}
+
+	nSpectrogramBins := dftLength/2 + 1
+	lowerMel := hertzToMel(lowerEdgeHertz)
+	melStep := (hertzToMel(upperEdgeHertz) - lowerMel) / float64(nMelBins+2)
+
+	// The edges of the triangular filters, as index of the spectrogram bins.
+	edges := make([]int, nMelBins+2)
+	for i := range edges {
+		hertz := melToHertz(float64(i)*melStep + lowerMel)
+		edges[i] = int(math.Floor(float64(dftLength+1) * hertz / sampleRate))
+	}
+
+	out := make([]float64, nSpectrogramBins*nMelBins)
+	set := func(bin, melBin int, value float64) {
+		if bin >= 0 && bin < nSpectrogramBins {
+			out[bin*nMelBins+melBin] = value
+		}
+	}
+
+	for i := 0; i < nMelBins; i++ {
+		lower, center, upper := edges[i], edges[i+1], edges[i+2]
+
+		if center == lower {
+			set(center, i, 1)
+		} else {
+			for j := lower; j <= center; j++ {
+				set(j, i, float64(j-lower)/float64(center-lower))
+			}
+		}
+
+		for j := center; j < upper; j++ {
+			set(j, i, float64(upper-j)/float64(upper-center))
+		}
+	}
+
+	res := tensor.New(tensor.WithShape(nSpectrogramBins, nMelBins), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtype(res, m.outputDatatype)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (m *MelWeightMatrix) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(m, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (m *MelWeightMatrix) GetMinInputs() int {
+	return MinMelWeightMatrixInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (m *MelWeightMatrix) GetMaxInputs() int {
+	return MaxMelWeightMatrixInputs
+}
+
+// GetOutputTypeInputs returns for every output the input of which it has the dtype. The
+// dtype of the output is set by the output_datatype attribute.
+func (m *MelWeightMatrix) GetOutputTypeInputs() []int {
+	return []int{-1}
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (m *MelWeightMatrix) GetInputTypeConstraints() [][]tensor.Dtype {
+	intTypes := []tensor.Dtype{tensor.Int32, tensor.Int64}
+	floatTypes := []tensor.Dtype{tensor.Float32, tensor.Float64}
+
+	return [][]tensor.Dtype{intTypes, intTypes, intTypes, floatTypes, floatTypes}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (m *MelWeightMatrix) String() string {
+	return "melWeightMatrix operator"
+}
+
+func hertzToMel(hertz float64) float64 {
+	return 2595 * math.Log10(1+hertz/700)
+}
+
+func melToHertz(mel float64) float64 {
+	return 700 * (math.Pow(10, mel/2595) - 1)
+}
@@ -0,0 +1,49 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestMelWeightMatrixInit(t *testing.T) {
+	m := newMelWeightMatrix().(*MelWeightMatrix)
+	assert.Equal(t, int32(onnx.TensorProto_FLOAT), m.outputDatatype)
+
+	err := m.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "output_datatype", I: 11}}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(onnx.TensorProto_DOUBLE), m.outputDatatype)
+}
+
+func TestMelWeightMatrix(t *testing.T) {
+	melWeightMatrix := &MelWeightMatrix{outputDatatype: int32(onnx.TensorProto_FLOAT)}
+	inputs := []tensor.Tensor{
+		tensor.New(tensor.FromScalar(int64(2))),
+		tensor.New(tensor.FromScalar(int64(8))),
+		tensor.New(tensor.FromScalar(int64(8000))),
+		tensor.New(tensor.FromScalar(float32(0))),
+		tensor.New(tensor.FromScalar(float32(4000))),
+	}
+
+	res, err := melWeightMatrix.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{5, 2}, res[0].Shape())
+	assert.Equal(t, []float32{1, 0, 0, 1, 0, 0, 0, 0, 0, 0}, res[0].Data())
+}
+
+func TestInputValidationMelWeightMatrix(t *testing.T) {
+	melWeightMatrix := &MelWeightMatrix{}
+	inputs := []tensor.Tensor{
+		tensor.New(tensor.FromScalar(int64(2))),
+		tensor.New(tensor.FromScalar(int64(8))),
+		tensor.New(tensor.FromScalar(int64(8000))),
+		tensor.New(tensor.FromScalar(float32(0))),
+		tensor.New(tensor.FromScalar(int32(4000))),
+	}
+
+	_, err := melWeightMatrix.ValidateInputs(inputs)
+	assert.Equal(t, ops.ErrInvalidInputType(4, "int32", melWeightMatrix), err)
+}
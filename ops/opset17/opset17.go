@@ -0,0 +1,42 @@
+package opset17
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset16"
+)
+
+// operators17 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 17. All other operators are the same as in opset 16.
+var operators17 = []ops.OperatorVersion{
+	{OpType: "BlackmanWindow", SinceVersion: 17, New: newBlackmanWindow},
+	{OpType: "DFT", SinceVersion: 17, New: newDFT},
+	{OpType: "HammingWindow", SinceVersion: 17, New: newHammingWindow},
+	{OpType: "HannWindow", SinceVersion: 17, New: newHannWindow},
+	{OpType: "LayerNormalization", SinceVersion: 17, New: newLayerNormalization},
+	{OpType: "MelWeightMatrix", SinceVersion: 17, New: newMelWeightMatrix},
+	{OpType: "STFT", SinceVersion: 17, New: newSTFT},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 17. Operators
+// which did not change since opset 16 are taken from opset 16.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators17, operatorType); ok {
+		return op, nil
+	}
+
+	return opset16.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 17.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators17)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 17,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators17)
+}
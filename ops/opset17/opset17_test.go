@@ -0,0 +1,50 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset16"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"BlackmanWindow", newBlackmanWindow(), nil},
+		{"DFT", newDFT(), nil},
+		{"HammingWindow", newHammingWindow(), nil},
+		{"HannWindow", newHannWindow(), nil},
+		{"LayerNormalization", newLayerNormalization(), nil},
+		{"MelWeightMatrix", newMelWeightMatrix(), nil},
+		{"STFT", newSTFT(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("GridSample")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset16.GridSample{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(17), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
@@ -0,0 +1,151 @@
+package opset17
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSTFTInputs = 2
+	MaxSTFTInputs = 4
+
+	// stftRank is the rank of the signal, which is (batch, signal length, 1 or 2).
+	stftRank = 3
+)
+
+// STFT represents the ONNX stft operator, which computes the short time Fourier transform
+// of the signal. The signal is split in frames, which are multiplied with the window before
+// computing their discrete Fourier transform.
+type STFT struct {
+	onesided bool
+}
+
+// newSTFT creates a new stft operator.
+func newSTFT() ops.Operator {
+	return &STFT{
+		onesided: true,
+	}
+}
+
+// Init initializes the stft operator.
+func (s *STFT) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "onesided":
+			s.onesided = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the stft operator.
+func (s *STFT) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	shape := inputs[0].Shape()
+	if len(shape) != stftRank || (shape[2] != 1 && shape[2] != 2) {
+		return nil, ops.ErrInvalidInput("signal should have shape (batch, length, 1 or 2)", s)
+	}
+
+	frameStep, err := ops.ScalarInt(inputs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var window []float64
+
+	if inputs[2] != nil {
+		window, err = ops.Float64Data(inputs[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	frameLength := len(window)
+
+	if inputs[3] != nil {
+		frameLength, err = ops.ScalarInt(inputs[3])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if frameLength < 1 || frameLength > shape[1] || frameStep < 1 {
+		return nil, ops.ErrInvalidInput("frame length or frame step is invalid", s)
+	}
+
+	if window != nil && len(window) != frameLength {
+		return nil, ops.ErrInvalidInput("window should have the length of a frame", s)
+	}
+
+	signal, err := ops.Float64Data(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize, nParts := shape[0], shape[2]
+	nFrames := (shape[1]-frameLength)/frameStep + 1
+	frames := make([]float64, 0, batchSize*nFrames*frameLength*nParts)
+
+	for b := 0; b < batchSize; b++ {
+		for f := 0; f < nFrames; f++ {
+			start := (b*shape[1] + f*frameStep) * nParts
+
+			for n := 0; n < frameLength; n++ {
+				for p := 0; p < nParts; p++ {
+					value := signal[start+n*nParts+p]
+					if window != nil {
+						value *= window[n]
+					}
+
+					frames = append(frames, value)
+				}
+			}
+		}
+	}
+
+	framesShape := tensor.Shape{batchSize, nFrames, frameLength, nParts}
+	out, outShape := ops.DFT(frames, framesShape, 2, frameLength, false, s.onesided)
+
+	res := tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtypeLike(res, inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *STFT) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *STFT) GetMinInputs() int {
+	return MinSTFTInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *STFT) GetMaxInputs() int {
+	return MaxSTFTInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *STFT) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Int32, tensor.Int64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Int32, tensor.Int64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *STFT) String() string {
+	return "stft operator"
+}
@@ -0,0 +1,84 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSTFTInit(t *testing.T) {
+	s := newSTFT().(*STFT)
+	assert.True(t, s.onesided)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "onesided", I: 0}}})
+	assert.Nil(t, err)
+	assert.False(t, s.onesided)
+}
+
+func TestSTFT(t *testing.T) {
+	tests := []struct {
+		stft          *STFT
+		window        tensor.Tensor
+		frameLength   tensor.Tensor
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{
+			&STFT{onesided: true},
+			nil,
+			tensor.New(tensor.FromScalar(int64(2))),
+			[]float32{3, 0, -1, 0, 7, 0, -1, 0},
+			tensor.Shape{1, 2, 2, 2},
+		},
+		{
+			&STFT{onesided: false},
+			ops.TensorWithBackingFixture([]float32{1, 0}, 2),
+			nil,
+			[]float32{1, 0, 1, 0, 3, 0, 3, 0},
+			tensor.Shape{1, 2, 2, 2},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5}, 1, 5, 1),
+			tensor.New(tensor.FromScalar(int64(2))),
+			test.window,
+			test.frameLength,
+		}
+
+		res, err := test.stft.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestInputValidationSTFT(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2, 1),
+				tensor.New(tensor.FromScalar(int32(1))),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2, 1)},
+			ops.ErrInvalidOptionalInputCount(1, &STFT{}),
+		},
+	}
+
+	for _, test := range tests {
+		stft := &STFT{}
+		_, err := stft.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
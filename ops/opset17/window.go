@@ -0,0 +1,144 @@
+package opset17
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinWindowInputs = 1
+	MaxWindowInputs = 1
+
+	// hammingAlpha is the alpha coefficient of the Hamming window.
+	hammingAlpha = 25.0 / 46.0
+	// blackmanAlpha is the alpha coefficient of the Blackman window.
+	blackmanAlpha = 0.16
+)
+
+// window is the implementation shared by the window operators, which generate a window
+// of the given size. The value of element n is a0 - a1 * cos(x) + a2 * cos(2x), with the
+// coefficients of the window and x = 2 * pi * n / N. N is the size of the window for
+// periodic windows, and the size minus one otherwise.
+type window struct {
+	name           string
+	coefficients   [3]float64
+	outputDatatype int32
+	periodic       bool
+}
+
+// newWindow creates a new window operator.
+func newWindow(name string, coefficients [3]float64) *window {
+	return &window{
+		name:           name,
+		coefficients:   coefficients,
+		outputDatatype: int32(onnx.TensorProto_FLOAT),
+		periodic:       true,
+	}
+}
+
+// Init initializes the window operator.
+func (w *window) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "output_datatype":
+			w.outputDatatype = int32(attr.GetI())
+		case "periodic":
+			w.periodic = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), w)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the window operator.
+func (w *window) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	size, err := ops.ScalarInt(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if size < 1 {
+		return nil, ops.ErrDimension("empty tensors are not supported")
+	}
+
+	denominator := float64(size)
+	if !w.periodic {
+		denominator--
+	}
+
+	values := make([]float64, size)
+	for n := range values {
+		x := 2 * math.Pi * float64(n) / denominator
+		values[n] = w.coefficients[0] - w.coefficients[1]*math.Cos(x) + w.coefficients[2]*math.Cos(2*x)
+	}
+
+	out, err := ops.ConvertTensorDtype(tensor.New(tensor.WithShape(size), tensor.WithBacking(values)), w.outputDatatype)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (w *window) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(w, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (w *window) GetMinInputs() int {
+	return MinWindowInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (w *window) GetMaxInputs() int {
+	return MaxWindowInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (w *window) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Int32, tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (w *window) String() string {
+	return w.name + " operator"
+}
+
+// BlackmanWindow represents the ONNX blackmanWindow operator.
+type BlackmanWindow struct {
+	*window
+}
+
+// newBlackmanWindow creates a new blackmanWindow operator.
+func newBlackmanWindow() ops.Operator {
+	return &BlackmanWindow{newWindow("blackmanWindow", [3]float64{
+		(1 - blackmanAlpha) / 2, 0.5, blackmanAlpha / 2,
+	})}
+}
+
+// HammingWindow represents the ONNX hammingWindow operator.
+type HammingWindow struct {
+	*window
+}
+
+// newHammingWindow creates a new hammingWindow operator.
+func newHammingWindow() ops.Operator {
+	return &HammingWindow{newWindow("hammingWindow", [3]float64{hammingAlpha, 1 - hammingAlpha, 0})}
+}
+
+// HannWindow represents the ONNX hannWindow operator.
+type HannWindow struct {
+	*window
+}
+
+// newHannWindow creates a new hannWindow operator.
+func newHannWindow() ops.Operator {
+	return &HannWindow{newWindow("hannWindow", [3]float64{0.5, 0.5, 0})}
+}
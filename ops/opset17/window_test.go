@@ -0,0 +1,63 @@
+package opset17
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestWindowInit(t *testing.T) {
+	w := newHannWindow().(*HannWindow)
+
+	err := w.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "output_datatype", I: int64(onnx.TensorProto_DOUBLE)},
+		{Name: "periodic", I: 0},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(onnx.TensorProto_DOUBLE), w.outputDatatype)
+	assert.False(t, w.periodic)
+
+	err = w.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "size"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("size", w.window), err)
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		window   ops.Operator
+		periodic bool
+		expected []float32
+	}{
+		{newHannWindow(), true, []float32{0, 0.5, 1, 0.5}},
+		{newHannWindow(), false, []float32{0, 0.75, 0.75, 0}},
+		{newHammingWindow(), true, []float32{0.0869565, 0.5434783, 1, 0.5434783}},
+		{newBlackmanWindow(), true, []float32{0, 0.34, 1, 0.34}},
+		{newBlackmanWindow(), false, []float32{0, 0.63, 0.63, 0}},
+	}
+
+	for _, test := range tests {
+		attributes := []*onnx.AttributeProto{{Name: "periodic", I: 0}}
+		if test.periodic {
+			attributes = nil
+		}
+
+		err := test.window.Init(&onnx.NodeProto{Attribute: attributes})
+		assert.Nil(t, err)
+
+		res, err := test.window.Apply([]tensor.Tensor{tensor.New(tensor.FromScalar(int64(4)))})
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestInputValidationWindow(t *testing.T) {
+	window := newBlackmanWindow()
+
+	_, err := window.ValidateInputs([]tensor.Tensor{tensor.New(tensor.FromScalar(int32(4)))})
+	assert.Nil(t, err)
+
+	_, err = window.ValidateInputs([]tensor.Tensor{tensor.New(tensor.FromScalar(float32(4)))})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "float32", window.(*BlackmanWindow).window), err)
+}
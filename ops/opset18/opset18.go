@@ -0,0 +1,49 @@
+package opset18
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset17"
+)
+
+// operators18 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 18. All other operators are the same as in opset 17.
+var operators18 = []ops.OperatorVersion{
+	{OpType: "Pad", SinceVersion: 18, New: newPad},
+	{OpType: "ReduceL1", SinceVersion: 18, New: newReduceL1},
+	{OpType: "ReduceL2", SinceVersion: 18, New: newReduceL2},
+	{OpType: "ReduceLogSum", SinceVersion: 18, New: newReduceLogSum},
+	{OpType: "ReduceLogSumExp", SinceVersion: 18, New: newReduceLogSumExp},
+	{OpType: "ReduceMax", SinceVersion: 18, New: newReduceMax},
+	{OpType: "ReduceMean", SinceVersion: 18, New: newReduceMean},
+	{OpType: "ReduceMin", SinceVersion: 18, New: newReduceMin},
+	{OpType: "ReduceProd", SinceVersion: 18, New: newReduceProd},
+	{OpType: "ReduceSumSquare", SinceVersion: 18, New: newReduceSumSquare},
+	{OpType: "Resize", SinceVersion: 18, New: newResize},
+	{OpType: "ScatterElements", SinceVersion: 18, New: newScatterElements},
+	{OpType: "ScatterND", SinceVersion: 18, New: newScatterND},
+	{OpType: "Split", SinceVersion: 18, New: newSplit},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 18. Operators
+// which did not change since opset 17 are taken from opset 17.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators18, operatorType); ok {
+		return op, nil
+	}
+
+	return opset17.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 18.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators18)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 18,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators18)
+}
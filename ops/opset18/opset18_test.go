@@ -0,0 +1,57 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset17"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"Pad", newPad(), nil},
+		{"ReduceL1", newReduceL1(), nil},
+		{"ReduceL2", newReduceL2(), nil},
+		{"ReduceLogSum", newReduceLogSum(), nil},
+		{"ReduceLogSumExp", newReduceLogSumExp(), nil},
+		{"ReduceMax", newReduceMax(), nil},
+		{"ReduceMean", newReduceMean(), nil},
+		{"ReduceMin", newReduceMin(), nil},
+		{"ReduceProd", newReduceProd(), nil},
+		{"ReduceSumSquare", newReduceSumSquare(), nil},
+		{"Resize", newResize(), nil},
+		{"ScatterElements", newScatterElements(), nil},
+		{"ScatterND", newScatterND(), nil},
+		{"Split", newSplit(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("LayerNormalization")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset17.LayerNormalization{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(18), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
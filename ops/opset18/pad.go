@@ -0,0 +1,80 @@
+package opset18
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinPadInputs = 2
+	MaxPadInputs = 4
+)
+
+// Pad represents the ONNX pad operator. Since opset 18 the axes to pad can be given as an
+// optional input, in which case the pads only hold the number of elements for these axes.
+type Pad struct {
+	mode ops.PadMode
+}
+
+// newPad creates a new pad operator.
+func newPad() ops.Operator {
+	return &Pad{
+		mode: ops.PadConstant,
+	}
+}
+
+// Init initializes the pad operator.
+func (p *Pad) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "mode":
+			switch mode := ops.PadMode(attr.GetS()); mode {
+			case ops.PadConstant, ops.PadReflect, ops.PadEdge:
+				p.mode = mode
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), p)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), p)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the pad operator.
+func (p *Pad) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.PadWithAxes(inputs[0], inputs[1], inputs[2], inputs[3], p.mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (p *Pad) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(p, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (p *Pad) GetMinInputs() int {
+	return MinPadInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (p *Pad) GetMaxInputs() int {
+	return MaxPadInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (p *Pad) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}, ops.AllTypes, {tensor.Int32, tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (p *Pad) String() string {
+	return "pad operator"
+}
@@ -0,0 +1,115 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestPadInit(t *testing.T) {
+	p := newPad().(*Pad)
+	assert.Equal(t, ops.PadConstant, p.mode)
+
+	err := p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("edge")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.PadEdge, p.mode)
+
+	err = p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("wrap")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", p), err)
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		pads     tensor.Tensor
+		axes     tensor.Tensor
+		expected []float32
+		shape    tensor.Shape
+	}{
+		{
+			ops.TensorWithBackingFixture([]int64{0, 1, 0, 0}, 4),
+			nil,
+			[]float32{0, 1, 2, 0, 3, 4},
+			tensor.Shape{2, 3},
+		},
+		{
+			ops.TensorWithBackingFixture([]int64{0, 1}, 2),
+			ops.TensorWithBackingFixture([]int64{1}, 1),
+			[]float32{1, 2, 0, 3, 4, 0},
+			tensor.Shape{2, 3},
+		},
+		{
+			ops.TensorWithBackingFixture([]int64{1, 0}, 2),
+			ops.TensorWithBackingFixture([]int32{-2}, 1),
+			[]float32{0, 0, 1, 2, 3, 4},
+			tensor.Shape{3, 2},
+		},
+	}
+
+	for _, test := range tests {
+		pad := &Pad{mode: ops.PadConstant}
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			test.pads,
+			nil,
+			test.axes,
+		}
+
+		res, err := pad.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.shape, res[0].Shape())
+	}
+}
+
+func TestPadInvalidAxes(t *testing.T) {
+	pad := &Pad{mode: ops.PadConstant}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+		ops.TensorWithBackingFixture([]int64{1, 1}, 2),
+		nil,
+		ops.TensorWithBackingFixture([]int64{2}, 1),
+	}
+
+	_, err := pad.Apply(inputs)
+	assert.Equal(t, ops.ErrAxisOutOfRange(-2, 1, 2), err)
+}
+
+func TestInputValidationPad(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{1, 1}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]int32{0}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{1, 1}, 2),
+				nil,
+				ops.TensorWithBackingFixture([]float32{0}, 1),
+			},
+			ops.ErrInvalidInputType(3, "float32", &Pad{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2}, 2)},
+			ops.ErrInvalidOptionalInputCount(1, &Pad{}),
+		},
+	}
+
+	for _, test := range tests {
+		pad := &Pad{}
+		_, err := pad.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
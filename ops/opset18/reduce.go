@@ -0,0 +1,182 @@
+package opset18
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinReduceInputs = 1
+	MaxReduceInputs = 2
+)
+
+// reduceTypes are the types which can be reduced by the reduce operators.
+var reduceTypes = []tensor.Dtype{
+	tensor.Uint32, tensor.Uint64, tensor.Int32, tensor.Int64, tensor.Float32, tensor.Float64,
+}
+
+// reduceMinMaxTypes are the types which can be reduced by the reduceMax and reduceMin operators.
+var reduceMinMaxTypes = append([]tensor.Dtype{tensor.Uint8, tensor.Int8}, reduceTypes...)
+
+// reduce is the implementation shared by the reduce operators, which take the axes to
+// reduce as an optional input since opset 18.
+type reduce struct {
+	name              string
+	reduction         ops.Reduction
+	types             []tensor.Dtype
+	keepDims          bool
+	noopWithEmptyAxes bool
+}
+
+// newReduce creates a new reduce operator.
+func newReduce(name string, reduction ops.Reduction, types []tensor.Dtype) *reduce {
+	return &reduce{
+		name:      name,
+		reduction: reduction,
+		types:     types,
+		keepDims:  true,
+	}
+}
+
+// Init initializes the reduce operator.
+func (r *reduce) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "keepdims":
+			r.keepDims = ops.Int64ToBool(attr.GetI())
+		case "noop_with_empty_axes":
+			r.noopWithEmptyAxes = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the reduce operator.
+func (r *reduce) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ReduceWithAxes(inputs[0], inputs[1], r.keepDims, r.noopWithEmptyAxes, r.reduction)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *reduce) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *reduce) GetMinInputs() int {
+	return MinReduceInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *reduce) GetMaxInputs() int {
+	return MaxReduceInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *reduce) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{r.types, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *reduce) String() string {
+	return r.name + " operator"
+}
+
+// ReduceL1 represents the ONNX reduceL1 operator.
+type ReduceL1 struct {
+	*reduce
+}
+
+// newReduceL1 creates a new reduceL1 operator.
+func newReduceL1() ops.Operator {
+	return &ReduceL1{newReduce("reduceL1", ops.ReductionL1, reduceTypes)}
+}
+
+// ReduceL2 represents the ONNX reduceL2 operator.
+type ReduceL2 struct {
+	*reduce
+}
+
+// newReduceL2 creates a new reduceL2 operator.
+func newReduceL2() ops.Operator {
+	return &ReduceL2{newReduce("reduceL2", ops.ReductionL2, reduceTypes)}
+}
+
+// ReduceLogSum represents the ONNX reduceLogSum operator.
+type ReduceLogSum struct {
+	*reduce
+}
+
+// newReduceLogSum creates a new reduceLogSum operator.
+func newReduceLogSum() ops.Operator {
+	return &ReduceLogSum{newReduce("reduceLogSum", ops.ReductionLogSum, reduceTypes)}
+}
+
+// ReduceLogSumExp represents the ONNX reduceLogSumExp operator.
+type ReduceLogSumExp struct {
+	*reduce
+}
+
+// newReduceLogSumExp creates a new reduceLogSumExp operator.
+func newReduceLogSumExp() ops.Operator {
+	return &ReduceLogSumExp{newReduce("reduceLogSumExp", ops.ReductionLogSumExp, reduceTypes)}
+}
+
+// ReduceMax represents the ONNX reduceMax operator.
+type ReduceMax struct {
+	*reduce
+}
+
+// newReduceMax creates a new reduceMax operator.
+func newReduceMax() ops.Operator {
+	return &ReduceMax{newReduce("reduceMax", ops.ReductionMax, reduceMinMaxTypes)}
+}
+
+// ReduceMean represents the ONNX reduceMean operator.
+type ReduceMean struct {
+	*reduce
+}
+
+// newReduceMean creates a new reduceMean operator.
+func newReduceMean() ops.Operator {
+	return &ReduceMean{newReduce("reduceMean", ops.ReductionMean, reduceTypes)}
+}
+
+// ReduceMin represents the ONNX reduceMin operator.
+type ReduceMin struct {
+	*reduce
+}
+
+// newReduceMin creates a new reduceMin operator.
+func newReduceMin() ops.Operator {
+	return &ReduceMin{newReduce("reduceMin", ops.ReductionMin, reduceMinMaxTypes)}
+}
+
+// ReduceProd represents the ONNX reduceProd operator.
+type ReduceProd struct {
+	*reduce
+}
+
+// newReduceProd creates a new reduceProd operator.
+func newReduceProd() ops.Operator {
+	return &ReduceProd{newReduce("reduceProd", ops.ReductionProd, reduceTypes)}
+}
+
+// ReduceSumSquare represents the ONNX reduceSumSquare operator.
+type ReduceSumSquare struct {
+	*reduce
+}
+
+// newReduceSumSquare creates a new reduceSumSquare operator.
+func newReduceSumSquare() ops.Operator {
+	return &ReduceSumSquare{newReduce("reduceSumSquare", ops.ReductionSumSquare, reduceTypes)}
+}
@@ -0,0 +1,115 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReduceInit(t *testing.T) {
+	r := newReduceMax().(*ReduceMax)
+	assert.True(t, r.keepDims)
+	assert.False(t, r.noopWithEmptyAxes)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "keepdims", I: 0},
+		{Name: "noop_with_empty_axes", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, r.keepDims)
+	assert.True(t, r.noopWithEmptyAxes)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", r.reduce), err)
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		reduce        ops.Operator
+		axes          tensor.Tensor
+		keepDims      int64
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{newReduceMax(), ops.TensorWithBackingFixture([]int64{1}, 1), 1, []float32{2, 4}, tensor.Shape{2, 1}},
+		{newReduceMin(), ops.TensorWithBackingFixture([]int64{0}, 1), 0, []float32{1, 2}, tensor.Shape{2}},
+		{newReduceMean(), ops.TensorWithBackingFixture([]int64{-1}, 1), 0, []float32{1.5, 3.5}, tensor.Shape{2}},
+		{newReduceProd(), ops.TensorWithBackingFixture([]int64{0}, 1), 0, []float32{3, 8}, tensor.Shape{2}},
+		{newReduceL1(), ops.TensorWithBackingFixture([]int64{1}, 1), 0, []float32{3, 7}, tensor.Shape{2}},
+		{newReduceSumSquare(), ops.TensorWithBackingFixture([]int64{1}, 1), 0, []float32{5, 25}, tensor.Shape{2}},
+	}
+
+	for _, test := range tests {
+		r := test.reduce
+		err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "keepdims", I: test.keepDims}}})
+		assert.Nil(t, err)
+
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			test.axes,
+		}
+
+		res, err := r.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestReduceWithoutAxes(t *testing.T) {
+	r := newReduceMax()
+	inputs := []tensor.Tensor{ops.TensorWithBackingFixture([]int8{1, 4, 3, 2}, 2, 2), nil}
+
+	res, err := r.Apply(inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, []int8{4}, res[0].Data())
+	assert.Equal(t, tensor.Shape{1, 1}, res[0].Shape())
+}
+
+func TestInputValidationReduce(t *testing.T) {
+	tests := []struct {
+		reduce ops.Operator
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			newReduceMax(),
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1, 2}, 2)},
+			nil,
+		},
+		{
+			newReduceMean(),
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{0}, 1),
+			},
+			nil,
+		},
+		{
+			newReduceMean(),
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]uint8{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "uint8", newReduceMean().(*ReduceMean).reduce),
+		},
+		{
+			newReduceMin(),
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{0}, 1),
+			},
+			ops.ErrInvalidInputType(1, "int32", newReduceMin().(*ReduceMin).reduce),
+		},
+	}
+
+	for _, test := range tests {
+		validated, err := test.reduce.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 2, len(validated))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package opset18
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// Resize represents the ONNX resize operator of opset 18, which adds the antialias, axes
+// and keep_aspect_ratio_policy attributes to the resize operator of opset 13.
+type Resize struct {
+	opset13.Resize
+	attributes ops.ResizeAttributes
+}
+
+// newResize creates a new resize operator.
+func newResize() ops.Operator {
+	return &Resize{
+		attributes: ops.NewResizeAttributes(),
+	}
+}
+
+// Init initializes the resize operator.
+func (r *Resize) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		if attr.GetName() == "coordinate_transformation_mode" &&
+			ops.CoordinateTransformation(attr.GetS()) == ops.TransformHalfPixelSymmetric {
+			return ops.ErrUnsupportedAttribute(attr.GetName(), r)
+		}
+
+		if err := r.attributes.SetAttribute(attr, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the resize operator.
+func (r *Resize) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ResizeWithInputs(inputs[0], inputs[1], inputs[2], inputs[3], r.attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *Resize) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateResizeInputs(r, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *Resize) String() string {
+	return "resize operator"
+}
@@ -0,0 +1,58 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestResizeInit(t *testing.T) {
+	r := newResize().(*Resize)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "mode", S: []byte("linear")},
+		{Name: "antialias", I: 1},
+		{Name: "axes", Ints: []int64{2, 3}},
+		{Name: "keep_aspect_ratio_policy", S: []byte("not_smaller")},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.ResizeLinear, r.attributes.Mode)
+	assert.True(t, r.attributes.Antialias)
+	assert.Equal(t, []int{2, 3}, r.attributes.Axes)
+	assert.Equal(t, ops.AspectRatioNotSmaller, r.attributes.KeepAspectRatioPolicy)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "keep_aspect_ratio_policy", S: []byte("fill")},
+	}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("keep_aspect_ratio_policy", r), err)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "coordinate_transformation_mode", S: []byte("half_pixel_symmetric")},
+	}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("coordinate_transformation_mode", r), err)
+}
+
+func TestResize(t *testing.T) {
+	resize := newResize().(*Resize)
+	resize.attributes.Mode = ops.ResizeLinear
+	resize.attributes.Antialias = true
+	resize.attributes.Axes = []int{2, 3}
+
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture(ops.Arange(16, 1), 1, 1, 4, 4),
+		nil,
+		nil,
+		ops.TensorWithBackingFixture([]int64{2, 2}, 2),
+	}
+
+	validated, err := resize.ValidateInputs(inputs)
+	assert.Nil(t, err)
+
+	res, err := resize.Apply(validated)
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{1, 1, 2, 2}, res[0].Shape())
+	assert.InDeltaSlice(t, []float32{3.125, 4.875, 10.125, 11.875}, res[0].Data(), 0.00001)
+}
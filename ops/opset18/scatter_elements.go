@@ -0,0 +1,62 @@
+package opset18
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// ScatterElements represents the ONNX scatterElements operator. Since opset 18 the max and
+// min reductions can be used to combine the updates with the data.
+type ScatterElements struct {
+	opset13.ScatterElements
+	axis      int
+	reduction ops.ScatterReduction
+}
+
+// newScatterElements creates a new scatterElements operator.
+func newScatterElements() ops.Operator {
+	return &ScatterElements{reduction: ops.ScatterNone}
+}
+
+// Init initializes the scatterElements operator.
+func (s *ScatterElements) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		case "reduction":
+			reduction, err := parseReduction(attr, s)
+			if err != nil {
+				return err
+			}
+
+			s.reduction = reduction
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the scatterElements operator.
+func (s *ScatterElements) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ScatterElements(inputs[0], inputs[1], inputs[2], s.axis, s.reduction)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *ScatterElements) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateScatterInputs(s, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *ScatterElements) String() string {
+	return "scatterElements operator"
+}
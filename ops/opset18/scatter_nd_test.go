@@ -0,0 +1,90 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestScatterNDInit(t *testing.T) {
+	s := newScatterND().(*ScatterND)
+	assert.Equal(t, ops.ScatterNone, s.reduction)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("mul")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.ScatterMul, s.reduction)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("max")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.ScatterMax, s.reduction)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("div")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("reduction", s), err)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", s), err)
+}
+
+func TestScatterND(t *testing.T) {
+	tests := []struct {
+		reduction ops.ScatterReduction
+		expected  []int64
+	}{
+		{ops.ScatterNone, []int64{1, 10, 3, 20}},
+		{ops.ScatterAdd, []int64{1, 12, 3, 24}},
+		{ops.ScatterMul, []int64{1, 20, 3, 80}},
+		{ops.ScatterMax, []int64{1, 10, 3, 20}},
+		{ops.ScatterMin, []int64{1, 2, 3, 4}},
+	}
+
+	for _, test := range tests {
+		scatterND := &ScatterND{reduction: test.reduction}
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]int64{1, 2, 3, 4}, 4),
+			ops.TensorWithBackingFixture([]int64{1, 3}, 2, 1),
+			ops.TensorWithBackingFixture([]int64{10, 20}, 2),
+		}
+
+		res, err := scatterND.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationScatterND(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{1}, 1, 1),
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+			},
+			ops.ErrInvalidTensor("DType of 'updates' does not match DType of 'data'", &ScatterND{}),
+		},
+	}
+
+	for _, test := range tests {
+		scatterND := &ScatterND{}
+		validated, err := scatterND.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
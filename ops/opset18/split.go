@@ -0,0 +1,105 @@
+package opset18
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinSplitInputs = 1
+	MaxSplitInputs = 2
+)
+
+// Split represents the ONNX split operator. Since opset 18 the number of parts can be given
+// by the num_outputs attribute, in which case the last part is smaller if the axis can not
+// be split into equal parts.
+type Split struct {
+	axis       int
+	numOutputs int
+	nOutputs   int
+}
+
+// newSplit creates a new split operator.
+func newSplit() ops.Operator {
+	return &Split{}
+}
+
+// Init initializes the split operator.
+func (s *Split) Init(n *onnx.NodeProto) error {
+	s.nOutputs = len(n.GetOutput())
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			s.axis = int(attr.GetI())
+		case "num_outputs":
+			s.numOutputs = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the split operator.
+func (s *Split) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if inputs[1] != nil {
+		if s.numOutputs != 0 {
+			return nil, ops.ErrInvalidInput("split and num_outputs can not both be given", s)
+		}
+
+		sizes, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+		if err != nil {
+			return nil, err
+		}
+
+		return ops.Split(inputs[0], s.axis, sizes)
+	}
+
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	if s.axis < -rank || s.axis >= rank {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-1, s.axis)
+	}
+
+	parts := s.numOutputs
+	if parts == 0 {
+		parts = s.nOutputs
+	}
+
+	size := shape[ops.ConvertNegativeAxis(s.axis, rank)]
+	if parts < 1 || parts > size {
+		return nil, ops.ErrInvalidInput("the axis can not be split into the number of outputs", s)
+	}
+
+	return ops.Split(inputs[0], s.axis, ops.EqualSplitSizes(size, parts))
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *Split) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *Split) GetMinInputs() int {
+	return MinSplitInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *Split) GetMaxInputs() int {
+	return MaxSplitInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *Split) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{ops.AllTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *Split) String() string {
+	return "split operator"
+}
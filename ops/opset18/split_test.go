@@ -0,0 +1,91 @@
+package opset18
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSplitInit(t *testing.T) {
+	s := newSplit().(*Split)
+
+	err := s.Init(&onnx.NodeProto{
+		Attribute: []*onnx.AttributeProto{{Name: "axis", I: -1}, {Name: "num_outputs", I: 3}},
+		Output:    []string{"a", "b", "c"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, s.axis)
+	assert.Equal(t, 3, s.numOutputs)
+	assert.Equal(t, 3, s.nOutputs)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "split"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("split", s), err)
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		split    *Split
+		sizes    tensor.Tensor
+		expected [][]float32
+	}{
+		{&Split{numOutputs: 3, nOutputs: 3}, nil, [][]float32{{1, 2}, {3, 4}, {5}}},
+		{&Split{nOutputs: 5}, nil, [][]float32{{1}, {2}, {3}, {4}, {5}}},
+		{&Split{nOutputs: 2}, ops.TensorWithBackingFixture([]int64{1, 4}, 2), [][]float32{{1}, {2, 3, 4, 5}}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5}, 5), test.sizes}
+
+		res, err := test.split.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, len(test.expected), len(res))
+
+		for i, expected := range test.expected {
+			assert.Equal(t, expected, ops.IfScalarToSlice(res[i].Data()))
+		}
+	}
+}
+
+func TestSplitFail(t *testing.T) {
+	split := &Split{numOutputs: 2, nOutputs: 2}
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2, 3}, 3),
+		ops.TensorWithBackingFixture([]int64{1, 2}, 2),
+	}
+
+	_, err := split.Apply(inputs)
+	assert.Equal(t, ops.ErrInvalidInput("split and num_outputs can not both be given", split), err)
+
+	split = &Split{numOutputs: 4, nOutputs: 4}
+	_, err = split.Apply([]tensor.Tensor{inputs[0], nil})
+	assert.Equal(t, ops.ErrInvalidInput("the axis can not be split into the number of outputs", split), err)
+}
+
+func TestInputValidationSplit(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int32{1, 1}, 2),
+			},
+			ops.ErrInvalidInputType(1, "int32", &Split{}),
+		},
+	}
+
+	for _, test := range tests {
+		split := &Split{}
+		_, err := split.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+	}
+}
@@ -0,0 +1,61 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCastLikeInit(t *testing.T) {
+	c := newCastLike().(*CastLike)
+	assert.True(t, c.saturate)
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "saturate", I: 0}}})
+	assert.Nil(t, err)
+	assert.False(t, c.saturate)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "to", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("to", c), err)
+}
+
+func TestCastLike(t *testing.T) {
+	castLike := newCastLike()
+
+	res, err := castLike.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]int32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float64{0}, 1),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1, 2}, res[0].Data())
+
+	castLike = &CastLike{saturate: false}
+
+	res, err = castLike.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 1000}, 2),
+		ops.TensorWithBackingFixture([]onnx.Float8E4M3FN{0}, 1),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []onnx.Float8E4M3FN{0x38, 0x7f}, res[0].Data())
+}
+
+func TestInputValidationCastLike(t *testing.T) {
+	castLike := &CastLike{}
+
+	_, err := castLike.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]int32{1}, 1),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = castLike.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]onnx.Float8E4M3FN{0x38}, 1),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = castLike.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputCount(1, castLike), err)
+}
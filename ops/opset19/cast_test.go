@@ -0,0 +1,79 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCastInit(t *testing.T) {
+	c := newCast().(*Cast)
+	assert.True(t, c.saturate)
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "to", I: 11}, {Name: "saturate", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(11), c.to)
+	assert.False(t, c.saturate)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "saturate", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, c), err)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", c), err)
+}
+
+func TestCast(t *testing.T) {
+	tests := []struct {
+		cast     *Cast
+		backing  []float32
+		expected any
+	}{
+		{
+			&Cast{to: int32(onnx.TensorProto_INT64)},
+			[]float32{1.5, -2},
+			[]int64{1, -2},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_FLOAT8E4M3FN), saturate: true},
+			[]float32{1.5, 500, -1000},
+			[]onnx.Float8E4M3FN{0x3c, 0x7e, 0xfe},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_FLOAT8E4M3FN), saturate: false},
+			[]float32{1.5, 500, -1000},
+			[]onnx.Float8E4M3FN{0x3c, 0x7f, 0xff},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_FLOAT8E5M2), saturate: true},
+			[]float32{1.5, 1e5, -1e5},
+			[]onnx.Float8E5M2{0x3e, 0x7b, 0xfb},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_FLOAT8E5M2), saturate: false},
+			[]float32{1.5, 1e5, -1e5},
+			[]onnx.Float8E5M2{0x3e, 0x7c, 0xfc},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.cast.Apply([]tensor.Tensor{ops.TensorWithBackingFixture(test.backing, len(test.backing))})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationCast(t *testing.T) {
+	cast := &Cast{}
+
+	_, err := cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1}, 1)})
+	assert.Nil(t, err)
+
+	_, err = cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.Float8E5M2{0x3c}, 1)})
+	assert.Nil(t, err)
+
+	_, err = cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "bool", cast), err)
+}
@@ -0,0 +1,266 @@
+package opset19
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinDeformConvInputs = 3
+	MaxDeformConvInputs = 5
+)
+
+// The number of dims of the inputs of 2D deformable convolutions.
+const nDims2DDeformConv = 4
+
+// DeformConv represents the ONNX deformConv operator. It computes a convolution in which
+// every position of the kernel is sampled at an offset learned per output position, and
+// optionally scaled by a mask. Only 2D deformable convolutions are supported.
+type DeformConv struct {
+	dilations   []int
+	group       int
+	kernelShape []int
+	offsetGroup int
+	pads        []int
+	strides     []int
+}
+
+// newDeformConv creates a new deformConv operator.
+func newDeformConv() ops.Operator {
+	return &DeformConv{
+		group:       1,
+		offsetGroup: 1,
+	}
+}
+
+// Init initializes the deformConv operator.
+func (d *DeformConv) Init(n *onnx.NodeProto) error {
+	var err error
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "dilations":
+			d.dilations, err = ops.AnyToIntSlice(attr.GetInts())
+		case "group":
+			d.group = int(attr.GetI())
+		case "kernel_shape":
+			d.kernelShape, err = ops.AnyToIntSlice(attr.GetInts())
+		case "offset_group":
+			d.offsetGroup = int(attr.GetI())
+		case "pads":
+			d.pads, err = ops.AnyToIntSlice(attr.GetInts())
+		case "strides":
+			d.strides, err = ops.AnyToIntSlice(attr.GetInts())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), d)
+		}
+
+		if err != nil {
+			return ops.ErrInvalidAttribute(attr.GetName(), d)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the deformConv operator.
+func (d *DeformConv) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	x, w, offset, mask := inputs[0], inputs[1], inputs[2], inputs[4]
+
+	xShape, wShape := x.Shape(), w.Shape()
+	if len(xShape) != nDims2DDeformConv || len(wShape) != nDims2DDeformConv {
+		return nil, ops.ErrInvalidInput("only 2D deformable convolutions are supported", d)
+	}
+
+	batchSize, nChannels, height, width := xShape[0], xShape[1], xShape[2], xShape[3]
+	nKernels, kernelHeight, kernelWidth := wShape[0], wShape[2], wShape[3]
+
+	if len(d.kernelShape) == 2 && (d.kernelShape[0] != kernelHeight || d.kernelShape[1] != kernelWidth) {
+		return nil, ops.ErrInvalidInput("kernel shape does not match the shape of the weights", d)
+	}
+
+	if d.group < 1 || nChannels%d.group != 0 || nKernels%d.group != 0 || wShape[1] != nChannels/d.group {
+		return nil, ops.ErrInvalidInput("the channels can not be divided into the groups", d)
+	}
+
+	if d.offsetGroup < 1 || nChannels%d.offsetGroup != 0 {
+		return nil, ops.ErrInvalidInput("the channels can not be divided into the offset groups", d)
+	}
+
+	dilations, pads, strides := d.dilations, d.pads, d.strides
+	if len(dilations) == 0 {
+		dilations = []int{1, 1}
+	}
+
+	if len(pads) == 0 {
+		pads = []int{0, 0, 0, 0}
+	}
+
+	if len(strides) == 0 {
+		strides = []int{1, 1}
+	}
+
+	outHeight := (height+pads[0]+pads[2]-dilations[0]*(kernelHeight-1)-1)/strides[0] + 1
+	outWidth := (width+pads[1]+pads[3]-dilations[1]*(kernelWidth-1)-1)/strides[1] + 1
+
+	if outHeight < 1 || outWidth < 1 {
+		return nil, ops.ErrDimension("empty tensors are not supported")
+	}
+
+	kernelSize := kernelHeight * kernelWidth
+	expectedOffsetShape := tensor.Shape{batchSize, d.offsetGroup * 2 * kernelSize, outHeight, outWidth}
+
+	if !offset.Shape().Eq(expectedOffsetShape) {
+		return nil, ops.ErrInvalidInput("offset has an invalid shape", d)
+	}
+
+	expectedMaskShape := tensor.Shape{batchSize, d.offsetGroup * kernelSize, outHeight, outWidth}
+	if mask != nil && !mask.Shape().Eq(expectedMaskShape) {
+		return nil, ops.ErrInvalidInput("mask has an invalid shape", d)
+	}
+
+	data, err := deformConvData(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	xData, wData, offsetData, biasData, maskData := data[0], data[1], data[2], data[3], data[4]
+
+	channelsPerGroup := nChannels / d.group
+	kernelsPerGroup := nKernels / d.group
+	channelsPerOffsetGroup := nChannels / d.offsetGroup
+	outSize := outHeight * outWidth
+
+	out := make([]float64, batchSize*nKernels*outSize)
+
+	for n := 0; n < batchSize; n++ {
+		for k := 0; k < nKernels; k++ {
+			group := k / kernelsPerGroup
+
+			for oh := 0; oh < outHeight; oh++ {
+				for ow := 0; ow < outWidth; ow++ {
+					var sum float64
+
+					for ic := 0; ic < channelsPerGroup; ic++ {
+						c := group*channelsPerGroup + ic
+						offsetGroup := c / channelsPerOffsetGroup
+						channel := xData[(n*nChannels+c)*height*width : (n*nChannels+c+1)*height*width]
+
+						for kh := 0; kh < kernelHeight; kh++ {
+							for kw := 0; kw < kernelWidth; kw++ {
+								position := kh*kernelWidth + kw
+								offsetIdx := ((n*expectedOffsetShape[1]+offsetGroup*2*kernelSize+2*position)*outHeight+oh)*outWidth + ow
+
+								y := float64(oh*strides[0]-pads[0]+kh*dilations[0]) + offsetData[offsetIdx]
+								x := float64(ow*strides[1]-pads[1]+kw*dilations[1]) + offsetData[offsetIdx+outSize]
+
+								value := bilinearSample(channel, height, width, y, x)
+
+								if maskData != nil {
+									maskIdx := ((n*expectedMaskShape[1]+offsetGroup*kernelSize+position)*outHeight+oh)*outWidth + ow
+									value *= maskData[maskIdx]
+								}
+
+								sum += wData[((k*channelsPerGroup+ic)*kernelHeight+kh)*kernelWidth+kw] * value
+							}
+						}
+					}
+
+					if biasData != nil {
+						sum += biasData[k]
+					}
+
+					out[((n*nKernels+k)*outHeight+oh)*outWidth+ow] = sum
+				}
+			}
+		}
+	}
+
+	res := tensor.New(tensor.WithShape(batchSize, nKernels, outHeight, outWidth), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtypeLike(res, x)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (d *DeformConv) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(d, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (d *DeformConv) GetMinInputs() int {
+	return MinDeformConvInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (d *DeformConv) GetMaxInputs() int {
+	return MaxDeformConvInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (d *DeformConv) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (d *DeformConv) String() string {
+	return "deformConv operator"
+}
+
+// deformConvData returns the data of the inputs of the deformConv operator as float64. The
+// data of optional inputs which are not given is nil.
+func deformConvData(inputs []tensor.Tensor) ([][]float64, error) {
+	data := make([][]float64, len(inputs))
+
+	for i, input := range inputs {
+		if input == nil {
+			continue
+		}
+
+		values, err := ops.Float64Data(input)
+		if err != nil {
+			return nil, err
+		}
+
+		data[i] = values
+	}
+
+	return data, nil
+}
+
+// bilinearSample samples a channel with the given height and width at a position using
+// bilinear interpolation. Positions outside of the channel have the value 0.
+func bilinearSample(channel []float64, height, width int, y, x float64) float64 {
+	if y <= -1 || y >= float64(height) || x <= -1 || x >= float64(width) {
+		return 0
+	}
+
+	y0, x0 := math.Floor(y), math.Floor(x)
+	dy, dx := y-y0, x-x0
+
+	at := func(h, w int) float64 {
+		if h < 0 || h >= height || w < 0 || w >= width {
+			return 0
+		}
+
+		return channel[h*width+w]
+	}
+
+	h, w := int(y0), int(x0)
+
+	return (1-dy)*(1-dx)*at(h, w) + (1-dy)*dx*at(h, w+1) + dy*(1-dx)*at(h+1, w) + dy*dx*at(h+1, w+1)
+}
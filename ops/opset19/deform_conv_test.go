@@ -0,0 +1,143 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDeformConvInit(t *testing.T) {
+	d := newDeformConv().(*DeformConv)
+	assert.Equal(t, 1, d.group)
+	assert.Equal(t, 1, d.offsetGroup)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "dilations", Ints: []int64{1, 1}},
+		{Name: "group", I: 2},
+		{Name: "kernel_shape", Ints: []int64{2, 2}},
+		{Name: "offset_group", I: 2},
+		{Name: "pads", Ints: []int64{1, 1, 1, 1}},
+		{Name: "strides", Ints: []int64{2, 2}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &DeformConv{
+		dilations:   []int{1, 1},
+		group:       2,
+		kernelShape: []int{2, 2},
+		offsetGroup: 2,
+		pads:        []int{1, 1, 1, 1},
+		strides:     []int{2, 2},
+	}, d)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "auto_pad"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("auto_pad", d), err)
+}
+
+func TestDeformConv(t *testing.T) {
+	tests := []struct {
+		offset   []float32
+		bias     tensor.Tensor
+		mask     tensor.Tensor
+		expected []float32
+	}{
+		{
+			make([]float32, 32),
+			nil,
+			nil,
+			[]float32{8, 12, 20, 24},
+		},
+		{
+			[]float32{
+				0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0,
+				0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0,
+				0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0,
+				0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0,
+			},
+			ops.TensorWithBackingFixture([]float32{1}, 1),
+			nil,
+			[]float32{15, 19, 17.5, 20.5},
+		},
+		{
+			make([]float32, 32),
+			nil,
+			ops.TensorWithBackingFixture(ops.Full(16, 0.5), 1, 4, 2, 2),
+			[]float32{4, 6, 10, 12},
+		},
+	}
+
+	for _, test := range tests {
+		deformConv := newDeformConv()
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{0, 1, 2, 3, 4, 5, 6, 7, 8}, 1, 1, 3, 3),
+			ops.TensorWithBackingFixture([]float32{1, 1, 1, 1}, 1, 1, 2, 2),
+			ops.TensorWithBackingFixture(test.offset, 1, 8, 2, 2),
+			test.bias,
+			test.mask,
+		}
+
+		res, err := deformConv.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, tensor.Shape{1, 1, 2, 2}, res[0].Shape())
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestDeformConvFail(t *testing.T) {
+	deformConv := newDeformConv()
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{0, 1, 2, 3, 4, 5, 6, 7, 8}, 1, 1, 3, 3),
+		ops.TensorWithBackingFixture([]float32{1, 1, 1, 1}, 1, 1, 2, 2),
+		ops.TensorWithBackingFixture(make([]float32, 8), 1, 8, 1, 1),
+		nil,
+		nil,
+	}
+
+	_, err := deformConv.Apply(inputs)
+	assert.Equal(t, ops.ErrInvalidInput("offset has an invalid shape", deformConv), err)
+
+	inputs[0] = ops.TensorWithBackingFixture([]float32{0, 1, 2}, 1, 1, 3)
+	_, err = deformConv.Apply(inputs)
+	assert.Equal(t, ops.ErrInvalidInput("only 2D deformable convolutions are supported", deformConv), err)
+}
+
+func TestInputValidationDeformConv(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1}, 1, 1, 1, 1),
+				ops.TensorWithBackingFixture([]float32{1}, 1, 1, 1, 1),
+				ops.TensorWithBackingFixture([]float32{0, 0}, 1, 2, 1, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]int32{1}, 1, 1, 1, 1),
+				ops.TensorWithBackingFixture([]float32{1}, 1, 1, 1, 1),
+				ops.TensorWithBackingFixture([]float32{0, 0}, 1, 2, 1, 1),
+			},
+			ops.ErrInvalidInputType(0, "int32", &DeformConv{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1}, 1, 1, 1, 1)},
+			ops.ErrInvalidOptionalInputCount(1, &DeformConv{}),
+		},
+	}
+
+	for _, test := range tests {
+		deformConv := &DeformConv{}
+		validated, err := deformConv.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 5, len(validated))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package opset19
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// DequantizeLinear represents the ONNX dequantizeLinear operator of opset 19, which adds the
+// float8 types to the dequantizeLinear operator of opset 13.
+type DequantizeLinear struct {
+	opset13.DequantizeLinear
+	axis int
+}
+
+// newDequantizeLinear creates a new dequantizeLinear operator.
+func newDequantizeLinear() ops.Operator {
+	return &DequantizeLinear{
+		axis: 1,
+	}
+}
+
+// Init initializes the dequantizeLinear operator.
+func (d *DequantizeLinear) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			d.axis = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), d)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the dequantizeLinear operator.
+func (d *DequantizeLinear) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if inputs[2] != nil && inputs[2].Dtype() != inputs[0].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'x_zero_point' does not match DType of 'x'", d)
+	}
+
+	out, err := ops.DequantizeLinear(inputs[0], inputs[1], inputs[2], d.axis, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (d *DequantizeLinear) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(d, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (d *DequantizeLinear) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Int8, tensor.Uint8, tensor.Int32, onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype},
+		{tensor.Float32},
+		{tensor.Int8, tensor.Uint8, tensor.Int32, onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (d *DequantizeLinear) String() string {
+	return "dequantizeLinear operator"
+}
@@ -0,0 +1,78 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDequantizeLinearInit(t *testing.T) {
+	d := newDequantizeLinear().(*DequantizeLinear)
+	assert.Equal(t, 1, d.axis)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, d.axis)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "block_size", I: 2}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("block_size", d), err)
+}
+
+func TestDequantizeLinear(t *testing.T) {
+	tests := []struct {
+		x         tensor.Tensor
+		zeroPoint tensor.Tensor
+		expected  []float32
+		err       error
+	}{
+		{
+			ops.TensorWithBackingFixture([]onnx.Float8E4M3FN{0x38, 0x40, 0xc4}, 3),
+			nil,
+			[]float32{2, 4, -6},
+			nil,
+		},
+		{
+			ops.TensorWithBackingFixture([]onnx.Float8E5M2{0x3c, 0x40, 0xc2}, 3),
+			tensor.New(tensor.FromScalar(onnx.Float8E5M2(0x3c))),
+			[]float32{0, 2, -8},
+			nil,
+		},
+		{
+			ops.TensorWithBackingFixture([]onnx.Float8E5M2{0x3c, 0x40, 0xc2}, 3),
+			tensor.New(tensor.FromScalar(onnx.Float8E4M3FN(0x38))),
+			nil,
+			ops.ErrInvalidTensor("DType of 'x_zero_point' does not match DType of 'x'", &DequantizeLinear{}),
+		},
+	}
+
+	for _, test := range tests {
+		dequantizeLinear := &DequantizeLinear{}
+		inputs := []tensor.Tensor{test.x, tensor.New(tensor.FromScalar(float32(2))), test.zeroPoint}
+
+		res, err := dequantizeLinear.Apply(inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Data())
+		}
+	}
+}
+
+func TestInputValidationDequantizeLinear(t *testing.T) {
+	dequantizeLinear := &DequantizeLinear{}
+
+	_, err := dequantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]onnx.Float8E4M3FN{0x38}, 1),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = dequantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "float32", dequantizeLinear), err)
+}
@@ -0,0 +1,44 @@
+package opset19
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+)
+
+// operators19 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 19. All other operators are the same as in opset 18. Equal adds support
+// for strings in opset 19, which the equal operator of opset 13 already supports. Of the
+// operators which add the float8 types, only the cast and quantization operators support them.
+var operators19 = []ops.OperatorVersion{
+	{OpType: "Cast", SinceVersion: 19, New: newCast},
+	{OpType: "CastLike", SinceVersion: 19, New: newCastLike},
+	{OpType: "DeformConv", SinceVersion: 19, New: newDeformConv},
+	{OpType: "DequantizeLinear", SinceVersion: 19, New: newDequantizeLinear},
+	{OpType: "Pad", SinceVersion: 19, New: newPad},
+	{OpType: "QuantizeLinear", SinceVersion: 19, New: newQuantizeLinear},
+	{OpType: "Resize", SinceVersion: 19, New: newResize},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 19. Operators
+// which did not change since opset 18 are taken from opset 18.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators19, operatorType); ok {
+		return op, nil
+	}
+
+	return opset18.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 19.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators19)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 19,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators19)
+}
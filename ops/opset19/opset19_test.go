@@ -0,0 +1,50 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"Cast", newCast(), nil},
+		{"CastLike", newCastLike(), nil},
+		{"DeformConv", newDeformConv(), nil},
+		{"DequantizeLinear", newDequantizeLinear(), nil},
+		{"Pad", newPad(), nil},
+		{"QuantizeLinear", newQuantizeLinear(), nil},
+		{"Resize", newResize(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("ReduceMax")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset18.ReduceMax{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(19), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
@@ -0,0 +1,61 @@
+package opset19
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"gorgonia.org/tensor"
+)
+
+// Pad represents the ONNX pad operator of opset 19, which adds the wrap mode to the pad
+// operator of opset 18.
+type Pad struct {
+	opset18.Pad
+	mode ops.PadMode
+}
+
+// newPad creates a new pad operator.
+func newPad() ops.Operator {
+	return &Pad{
+		mode: ops.PadConstant,
+	}
+}
+
+// Init initializes the pad operator.
+func (p *Pad) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "mode":
+			switch mode := ops.PadMode(attr.GetS()); mode {
+			case ops.PadConstant, ops.PadReflect, ops.PadEdge, ops.PadWrap:
+				p.mode = mode
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), p)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), p)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the pad operator.
+func (p *Pad) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.PadWithAxes(inputs[0], inputs[1], inputs[2], inputs[3], p.mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (p *Pad) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(p, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (p *Pad) String() string {
+	return "pad operator"
+}
@@ -0,0 +1,64 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestPadInit(t *testing.T) {
+	p := newPad().(*Pad)
+	assert.Equal(t, ops.PadConstant, p.mode)
+
+	err := p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("wrap")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.PadWrap, p.mode)
+
+	err = p.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode", S: []byte("mirror")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("mode", p), err)
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		pad      *Pad
+		axes     tensor.Tensor
+		expected []float32
+	}{
+		{&Pad{mode: ops.PadWrap}, nil, []float32{4, 3, 4, 2, 1, 2, 4, 3, 4}},
+		{&Pad{mode: ops.PadConstant}, nil, []float32{0, 0, 0, 0, 1, 2, 0, 3, 4}},
+		{&Pad{mode: ops.PadEdge}, ops.TensorWithBackingFixture([]int64{0, 1}, 2), []float32{1, 1, 2, 1, 1, 2, 3, 3, 4}},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			ops.TensorWithBackingFixture([]int64{1, 1, 0, 0}, 4),
+			nil,
+			test.axes,
+		}
+
+		res, err := test.pad.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, tensor.Shape{3, 3}, res[0].Shape())
+	}
+}
+
+func TestInputValidationPad(t *testing.T) {
+	pad := &Pad{}
+
+	_, err := pad.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]int64{1, 1}, 2),
+	})
+	assert.Nil(t, err)
+
+	_, err = pad.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]int32{1, 1}, 2),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(1, "int32", pad), err)
+}
@@ -0,0 +1,76 @@
+package opset19
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"gorgonia.org/tensor"
+)
+
+// QuantizeLinear represents the ONNX quantizeLinear operator of opset 19, which adds the float8
+// types, the saturate attribute and int32 scales to the quantizeLinear operator of opset 13.
+// The attribute determines whether values which are too large for a float8 type saturate to
+// its largest finite value.
+type QuantizeLinear struct {
+	opset13.QuantizeLinear
+	axis     int
+	saturate bool
+}
+
+// newQuantizeLinear creates a new quantizeLinear operator.
+func newQuantizeLinear() ops.Operator {
+	return &QuantizeLinear{
+		axis:     1,
+		saturate: true,
+	}
+}
+
+// Init initializes the quantizeLinear operator.
+func (q *QuantizeLinear) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			q.axis = int(attr.GetI())
+		case "saturate":
+			q.saturate = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), q)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the quantizeLinear operator.
+func (q *QuantizeLinear) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if inputs[1].Dtype() != inputs[0].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'y_scale' does not match DType of 'x'", q)
+	}
+
+	out, err := ops.QuantizeLinear(inputs[0], inputs[1], inputs[2], q.axis, 0, q.saturate)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (q *QuantizeLinear) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(q, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (q *QuantizeLinear) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Int32},
+		{tensor.Float32, tensor.Int32},
+		{tensor.Int8, tensor.Uint8, onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (q *QuantizeLinear) String() string {
+	return "quantizeLinear operator"
+}
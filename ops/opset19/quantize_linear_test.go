@@ -0,0 +1,103 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestQuantizeLinearInit(t *testing.T) {
+	q := newQuantizeLinear().(*QuantizeLinear)
+	assert.Equal(t, 1, q.axis)
+	assert.True(t, q.saturate)
+
+	err := q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}, {Name: "saturate", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, q.axis)
+	assert.False(t, q.saturate)
+
+	err = q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "block_size", I: 2}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("block_size", q), err)
+}
+
+func TestQuantizeLinear(t *testing.T) {
+	tests := []struct {
+		quantizeLinear *QuantizeLinear
+		x              tensor.Tensor
+		scale          tensor.Tensor
+		zeroPoint      tensor.Tensor
+		expected       any
+		err            error
+	}{
+		{
+			&QuantizeLinear{},
+			ops.TensorWithBackingFixture([]int32{10, 25, -7}, 3),
+			tensor.New(tensor.FromScalar(int32(5))),
+			tensor.New(tensor.FromScalar(int8(0))),
+			[]int8{2, 5, -1},
+			nil,
+		},
+		{
+			&QuantizeLinear{},
+			ops.TensorWithBackingFixture([]float32{1, 2.5, 300}, 3),
+			tensor.New(tensor.FromScalar(float32(1))),
+			tensor.New(tensor.FromScalar(int8(0))),
+			[]int8{1, 2, 127},
+			nil,
+		},
+		{
+			&QuantizeLinear{saturate: true},
+			ops.TensorWithBackingFixture([]float32{3, 2000, -2000}, 3),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(onnx.Float8E4M3FN(0))),
+			[]onnx.Float8E4M3FN{0x3c, 0x7e, 0xfe},
+			nil,
+		},
+		{
+			&QuantizeLinear{saturate: false},
+			ops.TensorWithBackingFixture([]float32{3, 2000, -2000}, 3),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(onnx.Float8E4M3FN(0))),
+			[]onnx.Float8E4M3FN{0x3c, 0x7f, 0xff},
+			nil,
+		},
+		{
+			&QuantizeLinear{},
+			ops.TensorWithBackingFixture([]float32{1, 2.5, 300}, 3),
+			tensor.New(tensor.FromScalar(int32(1))),
+			tensor.New(tensor.FromScalar(int8(0))),
+			nil,
+			ops.ErrInvalidTensor("DType of 'y_scale' does not match DType of 'x'", &QuantizeLinear{}),
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{test.x, test.scale, test.zeroPoint}
+
+		res, err := test.quantizeLinear.Apply(inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Data())
+		}
+	}
+}
+
+func TestInputValidationQuantizeLinear(t *testing.T) {
+	quantizeLinear := &QuantizeLinear{}
+
+	_, err := quantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]int32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]int32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = quantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float64{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float64{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "float64", quantizeLinear), err)
+}
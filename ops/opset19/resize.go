@@ -0,0 +1,53 @@
+package opset19
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"gorgonia.org/tensor"
+)
+
+// Resize represents the ONNX resize operator of opset 19, which adds the half_pixel_symmetric
+// coordinate transformation to the resize operator of opset 18.
+type Resize struct {
+	opset18.Resize
+	attributes ops.ResizeAttributes
+}
+
+// newResize creates a new resize operator.
+func newResize() ops.Operator {
+	return &Resize{
+		attributes: ops.NewResizeAttributes(),
+	}
+}
+
+// Init initializes the resize operator.
+func (r *Resize) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		if err := r.attributes.SetAttribute(attr, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the resize operator.
+func (r *Resize) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ResizeWithInputs(inputs[0], inputs[1], inputs[2], inputs[3], r.attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *Resize) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateResizeInputs(r, inputs)
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *Resize) String() string {
+	return "resize operator"
+}
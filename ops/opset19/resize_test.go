@@ -0,0 +1,43 @@
+package opset19
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestResizeInit(t *testing.T) {
+	r := newResize().(*Resize)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "coordinate_transformation_mode", S: []byte("half_pixel_symmetric")},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, ops.TransformHalfPixelSymmetric, r.attributes.CoordinateTransformation)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", r), err)
+}
+
+func TestResize(t *testing.T) {
+	resize := newResize().(*Resize)
+	resize.attributes.Mode = ops.ResizeLinear
+	resize.attributes.CoordinateTransformation = ops.TransformHalfPixelSymmetric
+
+	inputs := []tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 1, 1, 4),
+		nil,
+		ops.TensorWithBackingFixture([]float32{1, 1, 1, 0.6}, 4),
+	}
+
+	validated, err := resize.ValidateInputs(inputs)
+	assert.Nil(t, err)
+
+	res, err := resize.Apply(validated)
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{1, 1, 1, 2}, res[0].Shape())
+	assert.InDeltaSlice(t, []float32{1.6666666, 3.3333333}, res[0].Data(), 0.00001)
+}
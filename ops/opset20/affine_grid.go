@@ -0,0 +1,162 @@
+package opset20
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinAffineGridInputs = 2
+	MaxAffineGridInputs = 2
+)
+
+// AffineGrid represents the ONNX affineGrid operator. It generates a 2D or 3D grid of
+// sampling coordinates by applying a batch of affine matrices to a grid of normalized
+// coordinates in the range [-1, 1]. The grid can be used by the gridSample operator.
+type AffineGrid struct {
+	alignCorners bool
+}
+
+// newAffineGrid creates a new affineGrid operator.
+func newAffineGrid() ops.Operator {
+	return &AffineGrid{}
+}
+
+// Init initializes the affineGrid operator.
+func (a *AffineGrid) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "align_corners":
+			a.alignCorners = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), a)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the affineGrid operator.
+func (a *AffineGrid) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	size, err := ops.AnyToIntSlice(ops.IfScalarToSlice(inputs[1].Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	// The size holds the batch size, the number of channels and 2 or 3 spatial dims.
+	nSpatialDims := len(size) - 2
+	if nSpatialDims != 2 && nSpatialDims != 3 {
+		return nil, ops.ErrInvalidInput("size should have 4 or 5 elements", a)
+	}
+
+	batchSize := size[0]
+	spatialDims := size[2:]
+
+	if !inputs[0].Shape().Eq(tensor.Shape{batchSize, nSpatialDims, nSpatialDims + 1}) {
+		return nil, ops.ErrInvalidInput("theta should have a matrix for every batch which matches the size", a)
+	}
+
+	for _, dim := range spatialDims {
+		if dim < 1 {
+			return nil, ops.ErrDimension("empty tensors are not supported")
+		}
+	}
+
+	theta, err := ops.Float64Data(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// The coordinates of every spatial dim, in the reversed order of the dims.
+	coordinates := make([][]float64, nSpatialDims)
+	for i, dim := range spatialDims {
+		coordinates[nSpatialDims-1-i] = a.normalizedCoordinates(dim)
+	}
+
+	nPoints := ops.NElements(spatialDims...)
+	matrixSize := nSpatialDims * (nSpatialDims + 1)
+	out := make([]float64, 0, batchSize*nPoints*nSpatialDims)
+	point := make([]float64, nSpatialDims+1)
+
+	for n := 0; n < batchSize; n++ {
+		matrix := theta[n*matrixSize : (n+1)*matrixSize]
+
+		for p := 0; p < nPoints; p++ {
+			// The first coordinate of a point belongs to the last spatial dim.
+			remaining := p
+			for i := 0; i < nSpatialDims; i++ {
+				dim := spatialDims[nSpatialDims-1-i]
+				point[i] = coordinates[i][remaining%dim]
+				remaining /= dim
+			}
+
+			point[nSpatialDims] = 1
+
+			for row := 0; row < nSpatialDims; row++ {
+				var value float64
+				for col, coordinate := range point {
+					value += matrix[row*(nSpatialDims+1)+col] * coordinate
+				}
+
+				out = append(out, value)
+			}
+		}
+	}
+
+	outShape := append(append([]int{batchSize}, spatialDims...), nSpatialDims)
+	res := tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtypeLike(res, inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (a *AffineGrid) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(a, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (a *AffineGrid) GetMinInputs() int {
+	return MinAffineGridInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (a *AffineGrid) GetMaxInputs() int {
+	return MaxAffineGridInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (a *AffineGrid) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (a *AffineGrid) String() string {
+	return "affineGrid operator"
+}
+
+// normalizedCoordinates returns the normalized coordinates of the elements along a dim with
+// the given size. If the corners are aligned, the first and last coordinates are -1 and 1,
+// otherwise these are the coordinates of the corners of the first and last element.
+func (a *AffineGrid) normalizedCoordinates(size int) []float64 {
+	coordinates := make([]float64, size)
+
+	for i := range coordinates {
+		switch {
+		case !a.alignCorners:
+			coordinates[i] = -1 + float64(2*i+1)/float64(size)
+		case size > 1:
+			coordinates[i] = -1 + float64(2*i)/float64(size-1)
+		default:
+			coordinates[i] = -1
+		}
+	}
+
+	return coordinates
+}
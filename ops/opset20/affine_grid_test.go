@@ -0,0 +1,126 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestAffineGridInit(t *testing.T) {
+	a := newAffineGrid().(*AffineGrid)
+	assert.False(t, a.alignCorners)
+
+	err := a.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "align_corners", I: 1}}})
+	assert.Nil(t, err)
+	assert.True(t, a.alignCorners)
+
+	err = a.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "mode"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("mode", a), err)
+}
+
+func TestAffineGrid(t *testing.T) {
+	tests := []struct {
+		affineGrid    *AffineGrid
+		theta         tensor.Tensor
+		size          []int64
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{
+			&AffineGrid{},
+			ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+			[]int64{1, 1, 2, 2},
+			[]float32{-0.5, -0.5, 0.5, -0.5, -0.5, 0.5, 0.5, 0.5},
+			tensor.Shape{1, 2, 2, 2},
+		},
+		{
+			&AffineGrid{alignCorners: true},
+			ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+			[]int64{1, 1, 2, 2},
+			[]float32{-1, -1, 1, -1, -1, 1, 1, 1},
+			tensor.Shape{1, 2, 2, 2},
+		},
+		{
+			&AffineGrid{alignCorners: true},
+			ops.TensorWithBackingFixture([]float32{2, 0, 1, 0, 1, -1}, 1, 2, 3),
+			[]int64{1, 3, 1, 2},
+			[]float32{-1, -2, 3, -2},
+			tensor.Shape{1, 1, 2, 2},
+		},
+		{
+			&AffineGrid{alignCorners: true},
+			ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0}, 1, 3, 4),
+			[]int64{1, 1, 2, 1, 1},
+			[]float32{-1, -1, -1, -1, -1, 1},
+			tensor.Shape{1, 2, 1, 1, 3},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			test.theta,
+			ops.TensorWithBackingFixture(test.size, len(test.size)),
+		}
+
+		res, err := test.affineGrid.Apply(inputs)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestAffineGridFail(t *testing.T) {
+	a := &AffineGrid{}
+
+	_, err := a.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+		ops.TensorWithBackingFixture([]int64{1, 1, 2}, 3),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("size should have 4 or 5 elements", a), err)
+
+	_, err = a.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+		ops.TensorWithBackingFixture([]int64{2, 1, 2, 2}, 4),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("theta should have a matrix for every batch which matches the size", a), err)
+}
+
+func TestInputValidationAffineGrid(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+				ops.TensorWithBackingFixture([]int64{1, 1, 2, 2}, 4),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float32{1}, 1)},
+			ops.ErrInvalidInputCount(1, &AffineGrid{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 0, 0, 0, 1, 0}, 1, 2, 3),
+				ops.TensorWithBackingFixture([]int32{1, 1, 2, 2}, 4),
+			},
+			ops.ErrInvalidInputType(1, "int32", &AffineGrid{}),
+		},
+	}
+
+	for _, test := range tests {
+		affineGrid := &AffineGrid{}
+		validated, err := affineGrid.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestConstantOfShape(t *testing.T) {
+	tests := []struct {
+		value    *onnx.TensorProto
+		expected any
+		err      error
+	}{
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_FLOAT16), Dims: []int64{1}, Int32Data: []int32{0x3c00}},
+			[]onnx.Float16{0x3c00, 0x3c00, 0x3c00},
+			nil,
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_BFLOAT16), Dims: []int64{1}, Int32Data: []int32{0x4000}},
+			[]onnx.BFloat16{0x4000, 0x4000, 0x4000},
+			nil,
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_FLOAT8E4M3FN), Dims: []int64{1}, Int32Data: []int32{0x38}},
+			[]onnx.Float8E4M3FN{0x38, 0x38, 0x38},
+			nil,
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_FLOAT8E5M2), Dims: []int64{1}, Int32Data: []int32{0x3c}},
+			[]onnx.Float8E5M2{0x3c, 0x3c, 0x3c},
+			nil,
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_BOOL), Dims: []int64{1}, Int32Data: []int32{1}},
+			[]bool{true, true, true},
+			nil,
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_INT4), Dims: []int64{1}, Int32Data: []int32{0x0f}},
+			nil,
+			ops.ErrInvalidTensor("the dtype of the value is not supported", &ConstantOfShape{}),
+		},
+	}
+
+	for _, test := range tests {
+		constantOfShape := &ConstantOfShape{}
+
+		err := constantOfShape.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "value", T: test.value}}})
+		assert.Equal(t, test.err, err)
+
+		if test.err != nil {
+			continue
+		}
+
+		res, err := constantOfShape.Apply([]tensor.Tensor{ops.TensorWithBackingFixture([]int64{3}, 1)})
+		assert.Nil(t, err)
+		assert.Equal(t, tensor.Shape{3}, res[0].Shape())
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
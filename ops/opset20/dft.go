@@ -0,0 +1,132 @@
+package opset20
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinDFTInputs = 1
+	MaxDFTInputs = 3
+)
+
+// The default axis of the dft operator, which is the last dim before the complex values.
+const defaultDFTAxis = -2
+
+// DFT represents the ONNX dft operator of opset 20, which takes the axis as an optional
+// input instead of an attribute. The axis may be any dim except for the last dim, which
+// holds the complex values.
+type DFT struct {
+	inverse  bool
+	onesided bool
+}
+
+// newDFT creates a new dft operator.
+func newDFT() ops.Operator {
+	return &DFT{}
+}
+
+// Init initializes the dft operator.
+func (d *DFT) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "inverse":
+			d.inverse = ops.Int64ToBool(attr.GetI())
+		case "onesided":
+			d.onesided = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), d)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the dft operator.
+func (d *DFT) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	shape := inputs[0].Shape()
+	rank := len(shape)
+
+	axis := defaultDFTAxis
+
+	if inputs[2] != nil {
+		var err error
+
+		axis, err = ops.ScalarInt(inputs[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if axis < -rank || axis >= rank-1 || axis == -1 {
+		return nil, ops.ErrAxisOutOfRange(-rank, rank-2, axis)
+	}
+
+	axis = ops.ConvertNegativeAxis(axis, rank)
+
+	if shape[rank-1] != 1 && shape[rank-1] != 2 {
+		return nil, ops.ErrInvalidInput("the last dim of the input should be 1 or 2", d)
+	}
+
+	length := shape[axis]
+
+	if inputs[1] != nil {
+		var err error
+
+		length, err = ops.ScalarInt(inputs[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if length < 1 {
+		return nil, ops.ErrInvalidInput("dft length should be positive", d)
+	}
+
+	data, err := ops.Float64Data(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	out, outShape := ops.DFT(data, shape, axis, length, d.inverse, d.onesided)
+
+	res := tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out))
+
+	converted, err := ops.ConvertTensorDtypeLike(res, inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{converted}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (d *DFT) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(d, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (d *DFT) GetMinInputs() int {
+	return MinDFTInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (d *DFT) GetMaxInputs() int {
+	return MaxDFTInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (d *DFT) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Int32, tensor.Int64},
+		{tensor.Int64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (d *DFT) String() string {
+	return "dft operator"
+}
@@ -0,0 +1,117 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDFTInit(t *testing.T) {
+	d := newDFT().(*DFT)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "inverse", I: 1},
+		{Name: "onesided", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &DFT{inverse: true, onesided: true}, d)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axis", d), err)
+}
+
+func TestDFT(t *testing.T) {
+	tests := []struct {
+		dft           *DFT
+		input         tensor.Tensor
+		dftLength     tensor.Tensor
+		axis          tensor.Tensor
+		expected      []float32
+		expectedShape tensor.Shape
+	}{
+		{
+			&DFT{},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 4, 1),
+			nil,
+			nil,
+			[]float32{10, 0, -2, 2, -2, 0, -2, -2},
+			tensor.Shape{1, 4, 2},
+		},
+		{
+			&DFT{onesided: true},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 4, 1),
+			nil,
+			tensor.New(tensor.FromScalar(int64(0))),
+			[]float32{10, 0, -2, 2, -2, 0},
+			tensor.Shape{3, 2},
+		},
+		{
+			&DFT{},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 4, 1),
+			tensor.New(tensor.FromScalar(int64(2))),
+			tensor.New(tensor.FromScalar(int64(-2))),
+			[]float32{3, 0, -1, 0},
+			tensor.Shape{1, 2, 2},
+		},
+		{
+			&DFT{inverse: true},
+			ops.TensorWithBackingFixture([]float32{10, 0, -2, 2, -2, 0, -2, -2}, 1, 4, 2),
+			nil,
+			nil,
+			[]float32{1, 0, 2, 0, 3, 0, 4, 0},
+			tensor.Shape{1, 4, 2},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.dft.Apply([]tensor.Tensor{test.input, test.dftLength, test.axis})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestDFTInvalidAxis(t *testing.T) {
+	dft := &DFT{}
+
+	_, err := dft.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2, 1),
+		nil,
+		tensor.New(tensor.FromScalar(int64(-1))),
+	})
+	assert.Equal(t, ops.ErrAxisOutOfRange(-3, 1, -1), err)
+}
+
+func TestInputValidationDFT(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{1, 2}, 1, 2, 1)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2, 1),
+				nil,
+				ops.TensorWithBackingFixture([]int32{1}, 1),
+			},
+			ops.ErrInvalidInputType(2, "int32", &DFT{}),
+		},
+	}
+
+	for _, test := range tests {
+		dft := &DFT{}
+		validated, err := dft.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 3, len(validated))
+		}
+	}
+}
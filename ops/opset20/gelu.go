@@ -0,0 +1,111 @@
+package opset20
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinGeluInputs = 1
+	MaxGeluInputs = 1
+)
+
+// The coefficient of the cubic term in the tanh approximation of gelu.
+const geluTanhCoefficient = 0.044715
+
+// Gelu represents the ONNX gelu operator. It computes the Gaussian error linear unit, or its
+// approximation using tanh if the approximate attribute is set to 'tanh'.
+type Gelu struct {
+	approximate bool
+}
+
+// newGelu creates a new gelu operator.
+func newGelu() ops.Operator {
+	return &Gelu{}
+}
+
+// Init initializes the gelu operator.
+func (g *Gelu) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "approximate":
+			switch string(attr.GetS()) {
+			case "none":
+				g.approximate = false
+			case "tanh":
+				g.approximate = true
+			default:
+				return ops.ErrUnsupportedAttribute(attr.GetName(), g)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), g)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the gelu operator.
+func (g *Gelu) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	var (
+		out tensor.Tensor
+		err error
+	)
+
+	switch inputs[0].Dtype() {
+	case tensor.Float32:
+		out, err = inputs[0].Apply(gelu[float32](g.approximate))
+	case tensor.Float64:
+		out, err = inputs[0].Apply(gelu[float64](g.approximate))
+	default:
+		return nil, ops.ErrInvalidInputType(0, inputs[0].Dtype().String(), g)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (g *Gelu) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(g, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (g *Gelu) GetMinInputs() int {
+	return MinGeluInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (g *Gelu) GetMaxInputs() int {
+	return MaxGeluInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (g *Gelu) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.Float32, tensor.Float64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (g *Gelu) String() string {
+	return "gelu operator"
+}
+
+func gelu[T ops.FloatType](approximate bool) func(T) T {
+	return func(x T) T {
+		value := float64(x)
+
+		if approximate {
+			inner := math.Sqrt(2/math.Pi) * (value + geluTanhCoefficient*value*value*value)
+			return T(0.5 * value * (1 + math.Tanh(inner)))
+		}
+
+		return T(0.5 * value * (1 + math.Erf(value/math.Sqrt2)))
+	}
+}
@@ -0,0 +1,83 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestGeluInit(t *testing.T) {
+	g := newGelu().(*Gelu)
+	assert.False(t, g.approximate)
+
+	err := g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "approximate", S: []byte("tanh")}}})
+	assert.Nil(t, err)
+	assert.True(t, g.approximate)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "approximate", S: []byte("sigmoid")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("approximate", g), err)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "alpha"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("alpha", g), err)
+}
+
+func TestGelu(t *testing.T) {
+	tests := []struct {
+		gelu     *Gelu
+		backing  []float32
+		expected []float32
+	}{
+		{
+			&Gelu{},
+			[]float32{-1, 0, 1},
+			[]float32{-0.15865526, 0, 0.8413447},
+		},
+		{
+			&Gelu{approximate: true},
+			[]float32{-1, 0, 1},
+			[]float32{-0.15880801, 0, 0.841192},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{ops.TensorWithBackingFixture(test.backing, 3)}
+
+		res, err := test.gelu.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+	}
+}
+
+func TestInputValidationGelu(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]float64{1, 2}, 2)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &Gelu{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "int32", &Gelu{}),
+		},
+	}
+
+	for _, test := range tests {
+		gelu := &Gelu{}
+		validated, err := gelu.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package opset20
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+)
+
+// operators20 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 20. All other operators are the same as in opset 19.
+var operators20 = []ops.OperatorVersion{
+	{OpType: "AffineGrid", SinceVersion: 20, New: newAffineGrid},
+	{OpType: "ConstantOfShape", SinceVersion: 20, New: newConstantOfShape},
+	{OpType: "DFT", SinceVersion: 20, New: newDFT},
+	{OpType: "Gelu", SinceVersion: 20, New: newGelu},
+	{OpType: "ReduceMax", SinceVersion: 20, New: newReduceMax},
+	{OpType: "ReduceMin", SinceVersion: 20, New: newReduceMin},
+	{OpType: "RegexFullMatch", SinceVersion: 20, New: newRegexFullMatch},
+	{OpType: "StringConcat", SinceVersion: 20, New: newStringConcat},
+	{OpType: "StringSplit", SinceVersion: 20, New: newStringSplit},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 20. Operators
+// which did not change since opset 19 are taken from opset 19.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators20, operatorType); ok {
+		return op, nil
+	}
+
+	return opset19.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 20.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators20)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 20,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators20)
+}
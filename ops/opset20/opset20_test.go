@@ -0,0 +1,52 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{"AffineGrid", newAffineGrid(), nil},
+		{"ConstantOfShape", newConstantOfShape(), nil},
+		{"DFT", newDFT(), nil},
+		{"Gelu", newGelu(), nil},
+		{"ReduceMax", newReduceMax(), nil},
+		{"ReduceMin", newReduceMin(), nil},
+		{"RegexFullMatch", newRegexFullMatch(), nil},
+		{"StringConcat", newStringConcat(), nil},
+		{"StringSplit", newStringSplit(), nil},
+		{"NotYetImplemented", nil, ops.ErrUnknownOperatorType("NotYetImplemented")},
+	}
+
+	for _, test := range tests {
+		op, err := GetOperator(test.opType)
+
+		assert.Equal(t, test.expected, op)
+		assert.Equal(t, test.err, err)
+	}
+
+	op, err := GetOperator("Pad")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset19.Pad{}, op)
+}
+
+func TestGetOperatorVersions(t *testing.T) {
+	versions := GetOperatorVersions()
+	assert.Equal(t, len(GetOpNames()), len(versions))
+
+	for _, version := range versions {
+		assert.Equal(t, int64(20), version.SinceVersion)
+
+		op, err := GetOperator(version.OpType)
+		assert.Nil(t, err)
+		assert.Equal(t, op, version.New())
+	}
+}
@@ -0,0 +1,110 @@
+package opset20
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinReduceInputs = 1
+	MaxReduceInputs = 2
+)
+
+// reduceMinMaxTypes are the types which can be reduced by the reduceMax and reduceMin operators.
+var reduceMinMaxTypes = []tensor.Dtype{
+	tensor.Uint8, tensor.Uint32, tensor.Uint64, tensor.Int8, tensor.Int32, tensor.Int64,
+	tensor.Float32, tensor.Float64, tensor.Bool,
+}
+
+// reduce is the implementation shared by the reduceMax and reduceMin operators, which
+// support booleans since opset 20.
+type reduce struct {
+	name              string
+	reduction         ops.Reduction
+	keepDims          bool
+	noopWithEmptyAxes bool
+}
+
+// newReduce creates a new reduce operator.
+func newReduce(name string, reduction ops.Reduction) *reduce {
+	return &reduce{
+		name:      name,
+		reduction: reduction,
+		keepDims:  true,
+	}
+}
+
+// Init initializes the reduce operator.
+func (r *reduce) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "keepdims":
+			r.keepDims = ops.Int64ToBool(attr.GetI())
+		case "noop_with_empty_axes":
+			r.noopWithEmptyAxes = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the reduce operator.
+func (r *reduce) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ReduceWithAxes(inputs[0], inputs[1], r.keepDims, r.noopWithEmptyAxes, r.reduction)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *reduce) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *reduce) GetMinInputs() int {
+	return MinReduceInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *reduce) GetMaxInputs() int {
+	return MaxReduceInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *reduce) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{reduceMinMaxTypes, {tensor.Int64}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *reduce) String() string {
+	return r.name + " operator"
+}
+
+// ReduceMax represents the ONNX reduceMax operator of opset 20, which adds support for
+// booleans to the reduceMax operator of opset 18.
+type ReduceMax struct {
+	*reduce
+}
+
+// newReduceMax creates a new reduceMax operator.
+func newReduceMax() ops.Operator {
+	return &ReduceMax{newReduce("reduceMax", ops.ReductionMax)}
+}
+
+// ReduceMin represents the ONNX reduceMin operator of opset 20, which adds support for
+// booleans to the reduceMin operator of opset 18.
+type ReduceMin struct {
+	*reduce
+}
+
+// newReduceMin creates a new reduceMin operator.
+func newReduceMin() ops.Operator {
+	return &ReduceMin{newReduce("reduceMin", ops.ReductionMin)}
+}
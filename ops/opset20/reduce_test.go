@@ -0,0 +1,111 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReduceInit(t *testing.T) {
+	r := newReduceMin().(*ReduceMin)
+	assert.True(t, r.keepDims)
+	assert.False(t, r.noopWithEmptyAxes)
+
+	err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "keepdims", I: 0},
+		{Name: "noop_with_empty_axes", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, r.keepDims)
+	assert.True(t, r.noopWithEmptyAxes)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axes"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("axes", r.reduce), err)
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		reduce        ops.Operator
+		input         tensor.Tensor
+		axes          tensor.Tensor
+		keepDims      int64
+		expected      interface{}
+		expectedShape tensor.Shape
+	}{
+		{
+			newReduceMax(),
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2),
+			ops.TensorWithBackingFixture([]int64{1}, 1),
+			1,
+			[]float32{2, 4},
+			tensor.Shape{2, 1},
+		},
+		{
+			newReduceMax(),
+			ops.TensorWithBackingFixture([]bool{true, false, false, false}, 2, 2),
+			ops.TensorWithBackingFixture([]int64{1}, 1),
+			0,
+			[]bool{true, false},
+			tensor.Shape{2},
+		},
+		{
+			newReduceMin(),
+			ops.TensorWithBackingFixture([]bool{true, true, false, true}, 2, 2),
+			ops.TensorWithBackingFixture([]int64{0}, 1),
+			0,
+			[]bool{false, true},
+			tensor.Shape{2},
+		},
+	}
+
+	for _, test := range tests {
+		r := test.reduce
+		err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "keepdims", I: test.keepDims}}})
+		assert.Nil(t, err)
+
+		res, err := r.Apply([]tensor.Tensor{test.input, test.axes})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationReduce(t *testing.T) {
+	tests := []struct {
+		reduce ops.Operator
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			newReduceMax(),
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true, false}, 2)},
+			nil,
+		},
+		{
+			newReduceMin(),
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+				ops.TensorWithBackingFixture([]int64{0}, 1),
+			},
+			nil,
+		},
+		{
+			newReduceMin(),
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int16{1, 2}, 2)},
+			ops.ErrInvalidInputType(0, "int16", newReduceMin().(*ReduceMin).reduce),
+		},
+	}
+
+	for _, test := range tests {
+		validated, err := test.reduce.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 2, len(validated))
+		}
+	}
+}
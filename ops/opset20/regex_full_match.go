@@ -0,0 +1,90 @@
+package opset20
+
+import (
+	"regexp"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinRegexFullMatchInputs = 1
+	MaxRegexFullMatchInputs = 1
+)
+
+// RegexFullMatch represents the ONNX regexFullMatch operator, which checks for every string
+// of the input whether the whole string matches a regular expression. The pattern uses the
+// RE2 syntax, which is the syntax of the regexp package.
+type RegexFullMatch struct {
+	pattern *regexp.Regexp
+}
+
+// newRegexFullMatch creates a new regexFullMatch operator.
+func newRegexFullMatch() ops.Operator {
+	return &RegexFullMatch{}
+}
+
+// Init initializes the regexFullMatch operator.
+func (r *RegexFullMatch) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "pattern":
+			pattern, err := regexp.Compile("^(?:" + string(attr.GetS()) + ")$")
+			if err != nil {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), r)
+			}
+
+			r.pattern = pattern
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), r)
+		}
+	}
+
+	if r.pattern == nil {
+		return ops.ErrInvalidAttributeCount(1, 0, r)
+	}
+
+	return nil
+}
+
+// Apply applies the regexFullMatch operator.
+func (r *RegexFullMatch) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	data, err := ops.StringData(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]bool, len(data))
+	for i, value := range data {
+		out[i] = r.pattern.MatchString(value)
+	}
+
+	return []tensor.Tensor{tensor.New(tensor.WithShape(inputs[0].Shape()...), tensor.WithBacking(out))}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (r *RegexFullMatch) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(r, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (r *RegexFullMatch) GetMinInputs() int {
+	return MinRegexFullMatchInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (r *RegexFullMatch) GetMaxInputs() int {
+	return MaxRegexFullMatchInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (r *RegexFullMatch) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.String}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (r *RegexFullMatch) String() string {
+	return "regexFullMatch operator"
+}
@@ -0,0 +1,88 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestRegexFullMatchInit(t *testing.T) {
+	r := newRegexFullMatch().(*RegexFullMatch)
+
+	err := r.Init(&onnx.NodeProto{})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, r), err)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "pattern", S: []byte("(a")}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("pattern", r), err)
+
+	err = r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "pattern", S: []byte("a|b")}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "^(?:a|b)$", r.pattern.String())
+}
+
+func TestRegexFullMatch(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		backing  []string
+		shape    []int
+		expected []bool
+	}{
+		{
+			`www\.[\w.-]+\.\bcom\b`,
+			[]string{"www.google.com", "www.facebook.com", "www.bbc.co.uk"},
+			[]int{3},
+			[]bool{true, true, false},
+		},
+		{
+			"a|b",
+			[]string{"a", "b", "ab", ""},
+			[]int{2, 2},
+			[]bool{true, true, false, false},
+		},
+	}
+
+	for _, test := range tests {
+		r := newRegexFullMatch()
+		err := r.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "pattern", S: []byte(test.pattern)}}})
+		assert.Nil(t, err)
+
+		res, err := r.Apply([]tensor.Tensor{ops.TensorWithBackingFixture(test.backing, test.shape...)})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, tensor.Shape(test.shape), res[0].Shape())
+	}
+}
+
+func TestInputValidationRegexFullMatch(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]string{"a"}, 1)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{},
+			ops.ErrInvalidInputCount(0, &RegexFullMatch{}),
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]int64{1}, 1)},
+			ops.ErrInvalidInputType(0, "int64", &RegexFullMatch{}),
+		},
+	}
+
+	for _, test := range tests {
+		regexFullMatch := &RegexFullMatch{}
+		validated, err := regexFullMatch.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package opset20
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinStringConcatInputs = 2
+	MaxStringConcatInputs = 2
+)
+
+// StringConcat represents the ONNX stringConcat operator, which concatenates the strings
+// of two tensors element wise, using multidirectional broadcasting.
+type StringConcat struct{}
+
+// newStringConcat creates a new stringConcat operator.
+func newStringConcat() ops.Operator {
+	return &StringConcat{}
+}
+
+// Init initializes the stringConcat operator.
+func (s *StringConcat) Init(*onnx.NodeProto) error {
+	return nil
+}
+
+// Apply applies the stringConcat operator.
+func (s *StringConcat) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	x, y, err := ops.MultidirectionalBroadcast(inputs[0], inputs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	xData, err := ops.StringData(x)
+	if err != nil {
+		return nil, err
+	}
+
+	yData, err := ops.StringData(y)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(xData))
+	for i := range out {
+		out[i] = xData[i] + yData[i]
+	}
+
+	return []tensor.Tensor{tensor.New(tensor.WithShape(x.Shape()...), tensor.WithBacking(out))}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *StringConcat) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *StringConcat) GetMinInputs() int {
+	return MinStringConcatInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *StringConcat) GetMaxInputs() int {
+	return MaxStringConcatInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *StringConcat) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.String}, {tensor.String}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *StringConcat) String() string {
+	return "stringConcat operator"
+}
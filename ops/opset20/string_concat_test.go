@@ -0,0 +1,83 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestStringConcat(t *testing.T) {
+	tests := []struct {
+		x             tensor.Tensor
+		y             tensor.Tensor
+		expected      []string
+		expectedShape tensor.Shape
+	}{
+		{
+			ops.TensorWithBackingFixture([]string{"abc", "d"}, 2),
+			ops.TensorWithBackingFixture([]string{"e", "fg"}, 2),
+			[]string{"abce", "dfg"},
+			tensor.Shape{2},
+		},
+		{
+			ops.TensorWithBackingFixture([]string{"a", "b", "c", "d"}, 2, 2),
+			ops.TensorWithBackingFixture([]string{"_x", "_y"}, 2),
+			[]string{"a_x", "b_y", "c_x", "d_y"},
+			tensor.Shape{2, 2},
+		},
+		{
+			ops.TensorWithBackingFixture([]string{"a", ""}, 2),
+			tensor.New(tensor.WithShape(), tensor.WithBacking([]string{"!"})),
+			[]string{"a!", "!"},
+			tensor.Shape{2},
+		},
+	}
+
+	for _, test := range tests {
+		stringConcat := newStringConcat()
+
+		res, err := stringConcat.Apply([]tensor.Tensor{test.x, test.y})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+	}
+}
+
+func TestInputValidationStringConcat(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]string{"a"}, 1),
+				ops.TensorWithBackingFixture([]string{"b"}, 1),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]string{"a"}, 1)},
+			ops.ErrInvalidInputCount(1, &StringConcat{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]string{"a"}, 1),
+				ops.TensorWithBackingFixture([]float32{1}, 1),
+			},
+			ops.ErrInvalidInputType(1, "float32", &StringConcat{}),
+		},
+	}
+
+	for _, test := range tests {
+		stringConcat := &StringConcat{}
+		validated, err := stringConcat.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package opset20
+
+import (
+	"strings"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinStringSplitInputs = 1
+	MaxStringSplitInputs = 1
+)
+
+// StringSplit represents the ONNX stringSplit operator. It splits every string of the input
+// at the delimiter, or at runs of whitespace if no delimiter is given. The first output holds
+// the substrings of every string, padded with empty strings to the largest number of
+// substrings. The second output holds the number of substrings of every string.
+type StringSplit struct {
+	delimiter string
+	maxSplit  int
+}
+
+// newStringSplit creates a new stringSplit operator.
+func newStringSplit() ops.Operator {
+	return &StringSplit{
+		maxSplit: -1,
+	}
+}
+
+// Init initializes the stringSplit operator.
+func (s *StringSplit) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "delimiter":
+			s.delimiter = string(attr.GetS())
+		case "maxsplit":
+			s.maxSplit = int(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), s)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the stringSplit operator.
+func (s *StringSplit) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	data, err := ops.StringData(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	substrings := make([][]string, len(data))
+	counts := make([]int64, len(data))
+	maxCount := 0
+
+	for i, value := range data {
+		substrings[i] = s.split(value)
+		counts[i] = int64(len(substrings[i]))
+		maxCount = max(maxCount, len(substrings[i]))
+	}
+
+	if maxCount == 0 {
+		return nil, ops.ErrDimension("empty tensors are not supported")
+	}
+
+	out := make([]string, 0, len(data)*maxCount)
+	for _, values := range substrings {
+		out = append(out, values...)
+		out = append(out, make([]string, maxCount-len(values))...)
+	}
+
+	shape := inputs[0].Shape()
+	outShape := append(shape.Clone(), maxCount)
+
+	return []tensor.Tensor{
+		tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out)),
+		tensor.New(tensor.WithShape(shape...), tensor.WithBacking(counts)),
+	}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (s *StringSplit) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(s, inputs)
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (s *StringSplit) GetMinInputs() int {
+	return MinStringSplitInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (s *StringSplit) GetMaxInputs() int {
+	return MaxStringSplitInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (s *StringSplit) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{{tensor.String}}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (s *StringSplit) String() string {
+	return "stringSplit operator"
+}
+
+// split splits a string into at most maxSplit+1 substrings, or into any number of substrings
+// if maxSplit is negative. Without delimiter, runs of whitespace separate the substrings,
+// and leading and trailing whitespace is ignored.
+func (s *StringSplit) split(value string) []string {
+	if s.delimiter != "" {
+		if s.maxSplit < 0 {
+			return strings.Split(value, s.delimiter)
+		}
+
+		return strings.SplitN(value, s.delimiter, s.maxSplit+1)
+	}
+
+	var substrings []string
+
+	value = strings.TrimLeft(value, whitespace)
+	for value != "" {
+		if s.maxSplit >= 0 && len(substrings) == s.maxSplit {
+			substrings = append(substrings, strings.TrimRight(value, whitespace))
+			break
+		}
+
+		end := strings.IndexAny(value, whitespace)
+		if end == -1 {
+			end = len(value)
+		}
+
+		substrings = append(substrings, value[:end])
+		value = strings.TrimLeft(value[end:], whitespace)
+	}
+
+	return substrings
+}
+
+// whitespace holds the characters which separate substrings if no delimiter is given.
+const whitespace = " \t\n\v\f\r"
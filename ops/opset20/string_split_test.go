@@ -0,0 +1,120 @@
+package opset20
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestStringSplitInit(t *testing.T) {
+	s := newStringSplit().(*StringSplit)
+	assert.Equal(t, -1, s.maxSplit)
+
+	err := s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "delimiter", S: []byte("-")},
+		{Name: "maxsplit", I: 2},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &StringSplit{delimiter: "-", maxSplit: 2}, s)
+
+	err = s.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "pattern"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("pattern", s), err)
+}
+
+func TestStringSplit(t *testing.T) {
+	tests := []struct {
+		stringSplit    *StringSplit
+		backing        []string
+		shape          []int
+		expected       []string
+		expectedShape  tensor.Shape
+		expectedCounts []int64
+	}{
+		{
+			&StringSplit{delimiter: ".", maxSplit: -1},
+			[]string{"abc.com", "def.net"},
+			[]int{2},
+			[]string{"abc", "com", "def", "net"},
+			tensor.Shape{2, 2},
+			[]int64{2, 2},
+		},
+		{
+			&StringSplit{delimiter: "-", maxSplit: 1},
+			[]string{"a-b-c", "d", "e-f"},
+			[]int{3},
+			[]string{"a", "b-c", "d", "", "e", "f"},
+			tensor.Shape{3, 2},
+			[]int64{2, 1, 2},
+		},
+		{
+			&StringSplit{maxSplit: -1},
+			[]string{"  hello  world ", "a\tb c", "", " "},
+			[]int{2, 2},
+			[]string{"hello", "world", "", "a", "b", "c", "", "", "", "", "", ""},
+			tensor.Shape{2, 2, 3},
+			[]int64{2, 3, 0, 0},
+		},
+		{
+			&StringSplit{maxSplit: 1},
+			[]string{" a b  c "},
+			[]int{1},
+			[]string{"a", "b  c"},
+			tensor.Shape{1, 2},
+			[]int64{2},
+		},
+		{
+			&StringSplit{delimiter: ",", maxSplit: -1},
+			[]string{"a,,b", ""},
+			[]int{2},
+			[]string{"a", "", "b", "", "", ""},
+			tensor.Shape{2, 3},
+			[]int64{3, 1},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.stringSplit.Apply([]tensor.Tensor{ops.TensorWithBackingFixture(test.backing, test.shape...)})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+		assert.Equal(t, test.expectedShape, res[0].Shape())
+		assert.Equal(t, test.expectedCounts, res[1].Data())
+		assert.Equal(t, tensor.Shape(test.shape), res[1].Shape())
+	}
+}
+
+func TestStringSplitEmptyOutput(t *testing.T) {
+	stringSplit := &StringSplit{maxSplit: -1}
+
+	_, err := stringSplit.Apply([]tensor.Tensor{ops.TensorWithBackingFixture([]string{" ", ""}, 2)})
+	assert.Equal(t, ops.ErrDimension("empty tensors are not supported"), err)
+}
+
+func TestInputValidationStringSplit(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]string{"a"}, 1)},
+			nil,
+		},
+		{
+			[]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)},
+			ops.ErrInvalidInputType(0, "bool", &StringSplit{}),
+		},
+	}
+
+	for _, test := range tests {
+		stringSplit := &StringSplit{}
+		validated, err := stringSplit.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package opset21
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"gorgonia.org/tensor"
+)
+
+// Cast represents the ONNX cast operator of opset 21, which adds the int4 and uint4 types to
+// the cast operator of opset 19. Casts to these types round half to even and saturate.
+type Cast struct {
+	opset19.Cast
+	to       int32
+	saturate bool
+}
+
+// newCast creates a new cast operator.
+func newCast() ops.Operator {
+	return &Cast{
+		saturate: true,
+	}
+}
+
+// Init initializes the cast operator.
+func (c *Cast) Init(n *onnx.NodeProto) error {
+	hasTo := false
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "to":
+			c.to = int32(attr.GetI())
+			hasTo = true
+		case "saturate":
+			c.saturate = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), c)
+		}
+	}
+
+	if !hasTo {
+		return ops.ErrInvalidAttributeCount(1, 0, c)
+	}
+
+	return nil
+}
+
+// Apply applies the cast operator.
+func (c *Cast) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ConvertTensorDtypeSaturate(inputs[0], c.to, c.saturate)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *Cast) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *Cast) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		append(c.Cast.GetInputTypeConstraints()[0], onnx.Int4Dtype, onnx.Uint4Dtype),
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *Cast) String() string {
+	return "cast operator"
+}
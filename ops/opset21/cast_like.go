@@ -0,0 +1,64 @@
+package opset21
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"gorgonia.org/tensor"
+)
+
+// CastLike represents the ONNX castLike operator of opset 21, which adds the int4 and uint4
+// types to the castLike operator of opset 19.
+type CastLike struct {
+	opset19.CastLike
+	saturate bool
+}
+
+// newCastLike creates a new castLike operator.
+func newCastLike() ops.Operator {
+	return &CastLike{
+		saturate: true,
+	}
+}
+
+// Init initializes the castLike operator.
+func (c *CastLike) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "saturate":
+			c.saturate = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), c)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the castLike operator.
+func (c *CastLike) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ConvertTensorDtypeLikeSaturate(inputs[0], inputs[1], c.saturate)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *CastLike) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (c *CastLike) GetInputTypeConstraints() [][]tensor.Dtype {
+	types := append(c.CastLike.GetInputTypeConstraints()[0], onnx.Int4Dtype, onnx.Uint4Dtype)
+
+	return [][]tensor.Dtype{types, types}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (c *CastLike) String() string {
+	return "castLike operator"
+}
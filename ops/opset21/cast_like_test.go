@@ -0,0 +1,46 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCastLikeInit(t *testing.T) {
+	c := newCastLike().(*CastLike)
+	assert.True(t, c.saturate)
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "saturate", I: 0}}})
+	assert.Nil(t, err)
+	assert.False(t, c.saturate)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "to", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("to", c), err)
+}
+
+func TestCastLike(t *testing.T) {
+	castLike := newCastLike()
+
+	res, err := castLike.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{-7.5, 3.2}, 2),
+		ops.TensorWithBackingFixture([]onnx.Int4{0}, 1),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []onnx.Int4{-8, 3}, res[0].Data())
+}
+
+func TestInputValidationCastLike(t *testing.T) {
+	castLike := &CastLike{}
+
+	_, err := castLike.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]onnx.Uint4{1}, 1),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = castLike.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]int32{1}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputCount(1, castLike), err)
+}
@@ -0,0 +1,74 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestCastInit(t *testing.T) {
+	c := newCast().(*Cast)
+	assert.True(t, c.saturate)
+
+	err := c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "to", I: 22}, {Name: "saturate", I: 0}}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(onnx.TensorProto_INT4), c.to)
+	assert.False(t, c.saturate)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "saturate", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, c), err)
+
+	err = c.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", c), err)
+}
+
+func TestCast(t *testing.T) {
+	tests := []struct {
+		cast     *Cast
+		input    tensor.Tensor
+		expected any
+	}{
+		{
+			&Cast{to: int32(onnx.TensorProto_INT4)},
+			ops.TensorWithBackingFixture([]float32{1.5, 2.5, -9, 20, -0.4}, 5),
+			[]onnx.Int4{2, 2, -8, 7, 0},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_UINT4)},
+			ops.TensorWithBackingFixture([]int64{1, -3, 16}, 3),
+			[]onnx.Uint4{1, 0, 15},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_FLOAT)},
+			ops.TensorWithBackingFixture([]onnx.Int4{-8, 7}, 2),
+			[]float32{-8, 7},
+		},
+		{
+			&Cast{to: int32(onnx.TensorProto_UINT8)},
+			ops.TensorWithBackingFixture([]onnx.Uint4{15, 3}, 2),
+			[]uint8{15, 3},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.cast.Apply([]tensor.Tensor{test.input})
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
+
+func TestInputValidationCast(t *testing.T) {
+	cast := &Cast{}
+
+	_, err := cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.Int4{1}, 1)})
+	assert.Nil(t, err)
+
+	_, err = cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]onnx.Float8E4M3FN{0x38}, 1)})
+	assert.Nil(t, err)
+
+	_, err = cast.ValidateInputs([]tensor.Tensor{ops.TensorWithBackingFixture([]bool{true}, 1)})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "bool", cast), err)
+}
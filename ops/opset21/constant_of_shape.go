@@ -0,0 +1,56 @@
+package opset21
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset20"
+	"gorgonia.org/tensor"
+)
+
+// constantOfShapeTypes are the dtypes of the value of the constant of shape operator.
+var constantOfShapeTypes = []tensor.Dtype{
+	onnx.Float16Dtype, onnx.BFloat16Dtype, tensor.Float32, tensor.Float64,
+	onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype,
+	onnx.Int4Dtype, tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	onnx.Uint4Dtype, tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Bool,
+}
+
+// ConstantOfShape represents the ONNX constant of shape operator of opset 21, which adds
+// the int4 and uint4 types to the constant of shape operator of opset 20.
+type ConstantOfShape struct {
+	opset20.ConstantOfShape
+	value *tensor.Dense
+}
+
+// newConstantOfShape creates a new constant of shape operator.
+func newConstantOfShape() ops.Operator {
+	return &ConstantOfShape{}
+}
+
+// Init initializes the constant of shape operator.
+func (c *ConstantOfShape) Init(n *onnx.NodeProto) error {
+	value, err := ops.ConstantOfShapeValue(n, constantOfShapeTypes, c)
+	if err != nil {
+		return err
+	}
+
+	c.value = value
+
+	return nil
+}
+
+// Apply applies the constant of shape operator.
+func (c *ConstantOfShape) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	out, err := ops.ConstantOfShape(inputs[0], c.value, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (c *ConstantOfShape) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(c, inputs)
+}
@@ -0,0 +1,42 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestConstantOfShape(t *testing.T) {
+	tests := []struct {
+		value    *onnx.TensorProto
+		expected any
+	}{
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_INT4), Dims: []int64{1}, RawData: []byte{0x0f}},
+			[]onnx.Int4{-1, -1, -1, -1},
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_UINT4), Dims: []int64{1}, Int32Data: []int32{0x0f}},
+			[]onnx.Uint4{15, 15, 15, 15},
+		},
+		{
+			&onnx.TensorProto{DataType: int32(onnx.TensorProto_FLOAT16), Dims: []int64{1}, Int32Data: []int32{0xbc00}},
+			[]onnx.Float16{0xbc00, 0xbc00, 0xbc00, 0xbc00},
+		},
+	}
+
+	for _, test := range tests {
+		constantOfShape := &ConstantOfShape{}
+
+		err := constantOfShape.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "value", T: test.value}}})
+		assert.Nil(t, err)
+
+		res, err := constantOfShape.Apply([]tensor.Tensor{ops.TensorWithBackingFixture([]int64{2, 2}, 2)})
+		assert.Nil(t, err)
+		assert.Equal(t, tensor.Shape{2, 2}, res[0].Shape())
+		assert.Equal(t, test.expected, res[0].Data())
+	}
+}
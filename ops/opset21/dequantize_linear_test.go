@@ -0,0 +1,87 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestDequantizeLinearInit(t *testing.T) {
+	d := newDequantizeLinear().(*DequantizeLinear)
+	assert.Equal(t, 1, d.axis)
+
+	err := d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "axis", I: 0}, {Name: "block_size", I: 4}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, d.axis)
+	assert.Equal(t, 4, d.blockSize)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "block_size", I: -1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("block_size", d), err)
+
+	err = d.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "output_dtype", I: 1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("output_dtype", d), err)
+}
+
+func TestDequantizeLinear(t *testing.T) {
+	tests := []struct {
+		dequantizeLinear *DequantizeLinear
+		x                tensor.Tensor
+		scale            tensor.Tensor
+		zeroPoint        tensor.Tensor
+		expected         []float32
+		err              error
+	}{
+		{
+			&DequantizeLinear{axis: 1},
+			ops.TensorWithBackingFixture([]onnx.Uint4{0, 3, 8, 15}, 4),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(onnx.Uint4(8))),
+			[]float32{-16, -10, 0, 14},
+			nil,
+		},
+		{
+			&DequantizeLinear{axis: 1, blockSize: 2},
+			ops.TensorWithBackingFixture([]onnx.Int4{-8, 7, 1, 2}, 2, 2),
+			ops.TensorWithBackingFixture([]float32{2, 0.5}, 2, 1),
+			ops.TensorWithBackingFixture([]onnx.Int4{0, 1}, 2, 1),
+			[]float32{-16, 14, 0, 0.5},
+			nil,
+		},
+		{
+			&DequantizeLinear{},
+			ops.TensorWithBackingFixture([]onnx.Int4{-8, 7}, 2),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(int8(0))),
+			nil,
+			ops.ErrInvalidTensor("DType of 'x_zero_point' does not match DType of 'x'", &DequantizeLinear{}),
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.dequantizeLinear.Apply([]tensor.Tensor{test.x, test.scale, test.zeroPoint})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Data())
+		}
+	}
+}
+
+func TestInputValidationDequantizeLinear(t *testing.T) {
+	dequantizeLinear := &DequantizeLinear{}
+
+	_, err := dequantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]onnx.Int4{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = dequantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(0, "float32", dequantizeLinear), err)
+}
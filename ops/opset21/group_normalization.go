@@ -0,0 +1,188 @@
+package opset21
+
+import (
+	"math"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"gorgonia.org/tensor"
+)
+
+const (
+	MinGroupNormalizationInputs = 3
+	MaxGroupNormalizationInputs = 3
+
+	// GroupNormalizationDefaultEpsilon is the default value of the epsilon attribute.
+	GroupNormalizationDefaultEpsilon = 1e-5
+)
+
+// GroupNormalization represents the ONNX groupNormalization operator. The channels of the
+// input are divided into groups, and every group is normalized over its channels and spatial
+// dims. The result is scaled and shifted per channel.
+type GroupNormalization struct {
+	epsilon   float32
+	numGroups int
+}
+
+// newGroupNormalization creates a new groupNormalization operator.
+func newGroupNormalization() ops.Operator {
+	return &GroupNormalization{
+		epsilon: GroupNormalizationDefaultEpsilon,
+	}
+}
+
+// Init initializes the groupNormalization operator.
+func (g *GroupNormalization) Init(n *onnx.NodeProto) error {
+	hasNumGroups := false
+
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "epsilon":
+			g.epsilon = attr.GetF()
+		case "num_groups":
+			g.numGroups = int(attr.GetI())
+			hasNumGroups = true
+		case "stash_type":
+			// The computation is always done in the type of the input.
+			if attr.GetI() != int64(onnx.TensorProto_FLOAT) {
+				return ops.ErrUnsupportedAttribute(attr.GetName(), g)
+			}
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), g)
+		}
+	}
+
+	if !hasNumGroups {
+		return ops.ErrInvalidAttributeCount(1, 0, g)
+	}
+
+	return nil
+}
+
+// Apply applies the groupNormalization operator.
+func (g *GroupNormalization) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	X := inputs[0]
+	shape := X.Shape()
+
+	if len(shape) < 2 {
+		return nil, ops.ErrInvalidInput("the input should have a batch and a channel dim", g)
+	}
+
+	channels := shape[1]
+	if g.numGroups < 1 || channels%g.numGroups != 0 {
+		return nil, ops.ErrInvalidInput("the number of channels should be divisible by the number of groups", g)
+	}
+
+	for _, input := range inputs[1:] {
+		if !input.Shape().Eq(tensor.Shape{channels}) {
+			return nil, ops.ErrInvalidInput("the scale and bias should have a value for every channel", g)
+		}
+	}
+
+	var (
+		Y   any
+		err error
+	)
+
+	switch x := X.Data().(type) {
+	case []float32:
+		Y, err = groupNormalization(x, inputs[1].Data(), inputs[2].Data(), shape[0], channels, g.numGroups, float64(g.epsilon))
+	case []float64:
+		Y, err = groupNormalization(x, inputs[1].Data(), inputs[2].Data(), shape[0], channels, g.numGroups, float64(g.epsilon))
+	default:
+		return nil, ops.ErrInvalidInputType(0, X.Dtype().String(), g)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{tensor.New(tensor.WithShape(shape...), tensor.WithBacking(Y))}, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (g *GroupNormalization) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ops.ValidateInputs(g, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs[1:] {
+		if input.Dtype() != inputs[0].Dtype() {
+			return nil, ops.ErrInvalidInputType(i+1, input.Dtype().String(), g)
+		}
+	}
+
+	return inputs, nil
+}
+
+// GetMinInputs returns the minimum number of input tensors this operator expects.
+func (g *GroupNormalization) GetMinInputs() int {
+	return MinGroupNormalizationInputs
+}
+
+// GetMaxInputs returns the maximum number of input tensors this operator expects.
+func (g *GroupNormalization) GetMaxInputs() int {
+	return MaxGroupNormalizationInputs
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (g *GroupNormalization) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+		{tensor.Float32, tensor.Float64},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (g *GroupNormalization) String() string {
+	return "groupNormalization operator"
+}
+
+// groupNormalization normalizes every group of consecutive channels of every batch of x, and
+// returns the result after scaling and shifting every channel. The scale and bias have a value for
+// every channel.
+func groupNormalization[T ops.FloatType](x []T, scale, bias any, batchSize, channels, numGroups int, epsilon float64) ([]T, error) {
+	scaleData, ok := ops.IfScalarToSlice(scale).([]T)
+	if !ok {
+		return nil, ops.ErrTypeAssert("list", scale)
+	}
+
+	biasData, ok := ops.IfScalarToSlice(bias).([]T)
+	if !ok {
+		return nil, ops.ErrTypeAssert("list", bias)
+	}
+
+	// The size of a single channel of a single batch, and the size of a group of channels.
+	channelSize := len(x) / batchSize / channels
+	groupSize := channelSize * channels / numGroups
+	y := make([]T, len(x))
+
+	for start := 0; start < len(x); start += groupSize {
+		values := x[start : start+groupSize]
+
+		sum := 0.0
+		for _, value := range values {
+			sum += float64(value)
+		}
+
+		mean := sum / float64(groupSize)
+
+		variance := 0.0
+		for _, value := range values {
+			variance += (float64(value) - mean) * (float64(value) - mean)
+		}
+
+		invStdDev := 1 / math.Sqrt(variance/float64(groupSize)+epsilon)
+
+		for i, value := range values {
+			j := start + i
+			channel := (j / channelSize) % channels
+			y[j] = T((float64(value)-mean)*invStdDev)*scaleData[channel] + biasData[channel]
+		}
+	}
+
+	return y, nil
+}
@@ -0,0 +1,125 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestGroupNormalizationInit(t *testing.T) {
+	g := newGroupNormalization().(*GroupNormalization)
+
+	err := g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "epsilon", F: 0.1},
+		{Name: "num_groups", I: 2},
+		{Name: "stash_type", I: 1},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &GroupNormalization{epsilon: 0.1, numGroups: 2}, g)
+
+	err = g.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "stash_type", I: 11}}})
+	assert.Equal(t, ops.ErrUnsupportedAttribute("stash_type", g), err)
+
+	err = g.Init(&onnx.NodeProto{})
+	assert.Equal(t, ops.ErrInvalidAttributeCount(1, 0, g), err)
+}
+
+func TestGroupNormalization(t *testing.T) {
+	tests := []struct {
+		groupNormalization *GroupNormalization
+		input              tensor.Tensor
+		expected           []float32
+	}{
+		{
+			&GroupNormalization{numGroups: 1},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 2, 2),
+			[]float32{-1.3416408, -0.4472136, 1.8944272, 3.6832817},
+		},
+		{
+			&GroupNormalization{numGroups: 2},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4}, 1, 2, 2),
+			[]float32{-1, 1, -1, 3},
+		},
+		{
+			&GroupNormalization{epsilon: GroupNormalizationDefaultEpsilon, numGroups: 2},
+			ops.TensorWithBackingFixture([]float32{1, 3, 5, 6}, 2, 2),
+			[]float32{0, 1, 0, 1},
+		},
+	}
+
+	for _, test := range tests {
+		inputs := []tensor.Tensor{
+			test.input,
+			ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+			ops.TensorWithBackingFixture([]float32{0, 1}, 2),
+		}
+
+		res, err := test.groupNormalization.Apply(inputs)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, test.expected, res[0].Data(), 1e-5)
+		assert.Equal(t, test.input.Shape(), res[0].Shape())
+	}
+}
+
+func TestGroupNormalizationFail(t *testing.T) {
+	g := &GroupNormalization{numGroups: 2}
+
+	_, err := g.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2, 3}, 1, 3),
+		ops.TensorWithBackingFixture([]float32{1, 1, 1}, 3),
+		ops.TensorWithBackingFixture([]float32{0, 0, 0}, 3),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("the number of channels should be divisible by the number of groups", g), err)
+
+	_, err = g.Apply([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+		ops.TensorWithBackingFixture([]float32{0, 0}, 2),
+	})
+	assert.Equal(t, ops.ErrInvalidInput("the scale and bias should have a value for every channel", g), err)
+}
+
+func TestInputValidationGroupNormalization(t *testing.T) {
+	tests := []struct {
+		inputs []tensor.Tensor
+		err    error
+	}{
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float64{1, 2}, 1, 2),
+				ops.TensorWithBackingFixture([]float64{1, 1}, 2),
+				ops.TensorWithBackingFixture([]float64{0, 0}, 2),
+			},
+			nil,
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2),
+				ops.TensorWithBackingFixture([]float32{1, 1}, 2),
+			},
+			ops.ErrInvalidInputCount(2, &GroupNormalization{}),
+		},
+		{
+			[]tensor.Tensor{
+				ops.TensorWithBackingFixture([]float32{1, 2}, 1, 2),
+				ops.TensorWithBackingFixture([]float32{1, 1}, 2),
+				ops.TensorWithBackingFixture([]float64{0, 0}, 2),
+			},
+			ops.ErrInvalidInputType(2, "float64", &GroupNormalization{}),
+		},
+	}
+
+	for _, test := range tests {
+		groupNormalization := &GroupNormalization{}
+		validated, err := groupNormalization.ValidateInputs(test.inputs)
+
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.inputs, validated)
+		}
+	}
+}
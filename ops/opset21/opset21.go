@@ -0,0 +1,43 @@
+package opset21
+
+import (
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset20"
+)
+
+// operators21 holds the operators of the default ONNX domain which are introduced or
+// changed in opset 21. All other operators are the same as in opset 20. Most operators
+// of opset 21 only add the int4, uint4 and float8 types, which are only supported by the
+// cast, constant of shape and quantization operators.
+var operators21 = []ops.OperatorVersion{
+	{OpType: "Cast", SinceVersion: 21, New: newCast},
+	{OpType: "CastLike", SinceVersion: 21, New: newCastLike},
+	{OpType: "ConstantOfShape", SinceVersion: 21, New: newConstantOfShape},
+	{OpType: "DequantizeLinear", SinceVersion: 21, New: newDequantizeLinear},
+	{OpType: "GroupNormalization", SinceVersion: 21, New: newGroupNormalization},
+	{OpType: "QuantizeLinear", SinceVersion: 21, New: newQuantizeLinear},
+}
+
+// GetOperator maps strings as found in the ModelProto to Operators from opset 21. Operators
+// which did not change since opset 20 are taken from opset 20.
+func GetOperator(operatorType string) (ops.Operator, error) {
+	if op, ok := ops.NewOperator(operators21, operatorType); ok {
+		return op, nil
+	}
+
+	return opset20.GetOperator(operatorType)
+}
+
+// GetOpNames returns a list with the names of the operators which are introduced or
+// changed in opset 21.
+func GetOpNames() []string {
+	return ops.OperatorTypes(operators21)
+}
+
+// GetOperatorVersions returns the operators which are introduced or changed in opset 21,
+// together with the version of the operator set since which they are valid.
+func GetOperatorVersions() []ops.OperatorVersion {
+	return slices.Clone(operators21)
+}
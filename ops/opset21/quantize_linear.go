@@ -0,0 +1,120 @@
+package opset21
+
+import (
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"gorgonia.org/tensor"
+)
+
+// QuantizeLinear represents the ONNX quantizeLinear operator of opset 21, which adds the int4,
+// uint4, int16 and uint16 types, blocked quantization and the output_dtype attribute to the
+// quantizeLinear operator of opset 19. The output_dtype attribute determines the output type
+// when no zero point is given.
+type QuantizeLinear struct {
+	opset19.QuantizeLinear
+	axis        int
+	blockSize   int
+	outputDtype int32
+	saturate    bool
+}
+
+// newQuantizeLinear creates a new quantizeLinear operator.
+func newQuantizeLinear() ops.Operator {
+	return &QuantizeLinear{
+		axis:     1,
+		saturate: true,
+	}
+}
+
+// Init initializes the quantizeLinear operator.
+func (q *QuantizeLinear) Init(n *onnx.NodeProto) error {
+	for _, attr := range n.GetAttribute() {
+		switch attr.GetName() {
+		case "axis":
+			q.axis = int(attr.GetI())
+		case "block_size":
+			q.blockSize = int(attr.GetI())
+			if q.blockSize < 0 {
+				return ops.ErrInvalidAttribute(attr.GetName(), q)
+			}
+		case "output_dtype":
+			q.outputDtype = int32(attr.GetI())
+		case "saturate":
+			q.saturate = ops.Int64ToBool(attr.GetI())
+		default:
+			return ops.ErrInvalidAttribute(attr.GetName(), q)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the quantizeLinear operator.
+func (q *QuantizeLinear) Apply(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	if inputs[1].Dtype() != inputs[0].Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'y_scale' does not match DType of 'x'", q)
+	}
+
+	zeroPoint, err := q.zeroPoint(inputs[1], inputs[2])
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := ops.QuantizeLinear(inputs[0], inputs[1], zeroPoint, q.axis, q.blockSize, q.saturate)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tensor.Tensor{out}, nil
+}
+
+// zeroPoint returns the zero point to quantize with. When the output type is given, the zero
+// point must have the output type. If there is no zero point, it is a tensor of zeros of the
+// output type with the shape of the scale.
+func (q *QuantizeLinear) zeroPoint(scale, zeroPoint tensor.Tensor) (tensor.Tensor, error) {
+	if q.outputDtype == 0 {
+		return zeroPoint, nil
+	}
+
+	zeros, err := ops.ConvertTensorDtype(
+		tensor.New(tensor.WithShape(scale.Shape()...), tensor.WithBacking(make([]float32, scale.Shape().TotalSize()))),
+		q.outputDtype,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if zeroPoint == nil {
+		return zeros, nil
+	}
+
+	if zeroPoint.Dtype() != zeros.Dtype() {
+		return nil, ops.ErrInvalidTensor("DType of 'y_zero_point' does not match 'output_dtype'", q)
+	}
+
+	return zeroPoint, nil
+}
+
+// ValidateInputs validates the inputs that will be given to Apply for this operator.
+func (q *QuantizeLinear) ValidateInputs(inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	return ops.ValidateInputs(q, inputs)
+}
+
+// GetInputTypeConstraints returns a list. Every element represents a set of allowed tensor dtypes
+// for the corresponding input tensor.
+func (q *QuantizeLinear) GetInputTypeConstraints() [][]tensor.Dtype {
+	return [][]tensor.Dtype{
+		{tensor.Float32, tensor.Int32},
+		{tensor.Float32, tensor.Int32},
+		{
+			tensor.Int8, tensor.Uint8, tensor.Int16, tensor.Uint16, onnx.Int4Dtype, onnx.Uint4Dtype,
+			onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype,
+		},
+	}
+}
+
+// String implements the stringer interface, and can be used to format errors or messages.
+func (q *QuantizeLinear) String() string {
+	return "quantizeLinear operator"
+}
@@ -0,0 +1,105 @@
+package opset21
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestQuantizeLinearInit(t *testing.T) {
+	q := newQuantizeLinear().(*QuantizeLinear)
+	assert.Equal(t, 1, q.axis)
+	assert.True(t, q.saturate)
+
+	err := q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{
+		{Name: "axis", I: 0},
+		{Name: "block_size", I: 2},
+		{Name: "output_dtype", I: 21},
+		{Name: "saturate", I: 0},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, q.axis)
+	assert.Equal(t, 2, q.blockSize)
+	assert.Equal(t, int32(onnx.TensorProto_UINT4), q.outputDtype)
+	assert.False(t, q.saturate)
+
+	err = q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "block_size", I: -1}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("block_size", q), err)
+
+	err = q.Init(&onnx.NodeProto{Attribute: []*onnx.AttributeProto{{Name: "unknown"}}})
+	assert.Equal(t, ops.ErrInvalidAttribute("unknown", q), err)
+}
+
+func TestQuantizeLinear(t *testing.T) {
+	tests := []struct {
+		quantizeLinear *QuantizeLinear
+		x              tensor.Tensor
+		scale          tensor.Tensor
+		zeroPoint      tensor.Tensor
+		expected       any
+		err            error
+	}{
+		{
+			&QuantizeLinear{axis: 1},
+			ops.TensorWithBackingFixture([]float32{0, 2, 3, 1000, -254, -1000}, 6),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(onnx.Int4(1))),
+			[]onnx.Int4{1, 2, 3, 7, -8, -8},
+			nil,
+		},
+		{
+			&QuantizeLinear{axis: 1, blockSize: 2},
+			ops.TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6, 7, 8}, 2, 4),
+			ops.TensorWithBackingFixture([]float32{1, 2, 4, 8}, 2, 2),
+			nil,
+			[]uint8{1, 2, 2, 2, 1, 2, 1, 1},
+			nil,
+		},
+		{
+			&QuantizeLinear{axis: 1, outputDtype: int32(onnx.TensorProto_UINT4)},
+			ops.TensorWithBackingFixture([]float32{1, 20, -3}, 3),
+			tensor.New(tensor.FromScalar(float32(1))),
+			nil,
+			[]onnx.Uint4{1, 15, 0},
+			nil,
+		},
+		{
+			&QuantizeLinear{outputDtype: int32(onnx.TensorProto_INT4)},
+			ops.TensorWithBackingFixture([]float32{1, 20, -3}, 3),
+			tensor.New(tensor.FromScalar(float32(1))),
+			tensor.New(tensor.FromScalar(uint8(0))),
+			nil,
+			ops.ErrInvalidTensor("DType of 'y_zero_point' does not match 'output_dtype'", &QuantizeLinear{outputDtype: int32(onnx.TensorProto_INT4)}),
+		},
+	}
+
+	for _, test := range tests {
+		res, err := test.quantizeLinear.Apply([]tensor.Tensor{test.x, test.scale, test.zeroPoint})
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, res[0].Data())
+		}
+	}
+}
+
+func TestInputValidationQuantizeLinear(t *testing.T) {
+	quantizeLinear := &QuantizeLinear{}
+
+	_, err := quantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+		ops.TensorWithBackingFixture([]onnx.Uint4{1}, 1),
+	})
+	assert.Nil(t, err)
+
+	_, err = quantizeLinear.ValidateInputs([]tensor.Tensor{
+		ops.TensorWithBackingFixture([]float32{1, 2}, 2),
+		ops.TensorWithBackingFixture([]float32{1}, 1),
+		ops.TensorWithBackingFixture([]int32{1}, 1),
+	})
+	assert.Equal(t, ops.ErrInvalidInputType(2, "int32", quantizeLinear), err)
+}
@@ -0,0 +1,213 @@
+package ops
+
+import (
+	"fmt"
+
+	"gorgonia.org/tensor"
+)
+
+// PadMode is the mode the pad operator uses to determine the values of the padded elements.
+type PadMode string
+
+const (
+	PadConstant PadMode = "constant"
+	PadReflect  PadMode = "reflect"
+	PadEdge     PadMode = "edge"
+	PadWrap     PadMode = "wrap"
+)
+
+// Pad pads the input with the given number of elements at the beginning and at the end of
+// every axis. The pads hold the number of elements at the beginning of every axis, followed
+// by the number of elements at the end of every axis. Negative pads remove elements. For the
+// constant mode the padded elements get the constant value, or the zero value if it is nil.
+func Pad(input tensor.Tensor, pads []int, mode PadMode, constantValue any) (tensor.Tensor, error) {
+	shape := input.Shape()
+	rank := len(shape)
+
+	if len(pads) != 2*rank {
+		return nil, ErrDimension(fmt.Sprintf("pads should have length %d", 2*rank))
+	}
+
+	outShape := make(tensor.Shape, rank)
+
+	for i, dim := range shape {
+		outShape[i] = dim + pads[i] + pads[i+rank]
+		if outShape[i] <= 0 {
+			return nil, ErrDimension("empty tensors are not supported")
+		}
+	}
+
+	if rank == 0 {
+		return input, nil
+	}
+
+	sources, err := padSources(shape, outShape, pads[:rank], mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+
+	switch data := dataAsSlice(input).(type) {
+	case []float32:
+		out = padValues(data, sources, constantValue)
+	case []float64:
+		out = padValues(data, sources, constantValue)
+	case []int8:
+		out = padValues(data, sources, constantValue)
+	case []int16:
+		out = padValues(data, sources, constantValue)
+	case []int32:
+		out = padValues(data, sources, constantValue)
+	case []int64:
+		out = padValues(data, sources, constantValue)
+	case []uint8:
+		out = padValues(data, sources, constantValue)
+	case []uint16:
+		out = padValues(data, sources, constantValue)
+	case []uint32:
+		out = padValues(data, sources, constantValue)
+	case []uint64:
+		out = padValues(data, sources, constantValue)
+	case []bool:
+		out = padValues(data, sources, constantValue)
+	case []string:
+		out = padValues(data, sources, constantValue)
+	default:
+		return nil, ErrTypeAssert("list", input.Data())
+	}
+
+	return tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out)), nil
+}
+
+// PadWithAxes pads the input as done by the pad operators which take the pads, the constant
+// value and the axes to pad as tensors. The constant value and the axes are optional. If the
+// axes are given, the pads only hold the number of elements for these axes.
+func PadWithAxes(input, pads, constantValue, axes tensor.Tensor, mode PadMode) (tensor.Tensor, error) {
+	padsList, err := AnyToIntSlice(IfScalarToSlice(pads.Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	if axes != nil {
+		axesList, err := AnyToIntSlice(IfScalarToSlice(axes.Data()))
+		if err != nil {
+			return nil, err
+		}
+
+		padsList, err = padsForAxes(padsList, axesList, len(input.Shape()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var value any
+	if constantValue != nil {
+		value = constantValue.Data()
+	}
+
+	return Pad(input, padsList, mode, value)
+}
+
+// padSources returns, for every element of the padded output, the offset of the element
+// in the input it gets its value from, or -1 if it gets the constant value.
+func padSources(shape, outShape tensor.Shape, begins []int, mode PadMode) ([]int, error) {
+	strides := shape.CalcStrides()
+	outStrides := outShape.CalcStrides()
+
+	sources := make([]int, outShape.TotalSize())
+
+	for i := range sources {
+		remaining := i
+
+		for dim, stride := range outStrides {
+			position := remaining/stride - begins[dim]
+			remaining %= stride
+
+			if position < 0 || position >= shape[dim] {
+				switch mode {
+				case PadConstant:
+					sources[i] = -1
+				case PadEdge:
+					position = min(max(position, 0), shape[dim]-1)
+				case PadReflect:
+					position = reflectIndex(position, shape[dim])
+				case PadWrap:
+					position = ((position % shape[dim]) + shape[dim]) % shape[dim]
+				default:
+					return nil, ErrUnsupportedPadMode(mode)
+				}
+			}
+
+			if sources[i] == -1 {
+				break
+			}
+
+			sources[i] += position * strides[dim]
+		}
+	}
+
+	return sources, nil
+}
+
+// reflectIndex reflects an index at the first and the last element of a dim of the given
+// size, without repeating these elements, until it lies within the dim.
+func reflectIndex(index, size int) int {
+	if size == 1 {
+		return 0
+	}
+
+	period := 2 * (size - 1)
+
+	index %= period
+	if index < 0 {
+		index += period
+	}
+
+	if index >= size {
+		index = period - index
+	}
+
+	return index
+}
+
+func padValues[T any](data []T, sources []int, constantValue any) []T {
+	var constant T
+	if value, ok := constantValue.(T); ok {
+		constant = value
+	}
+
+	out := make([]T, len(sources))
+
+	for i, source := range sources {
+		if source == -1 {
+			out[i] = constant
+		} else {
+			out[i] = data[source]
+		}
+	}
+
+	return out
+}
+
+// padsForAxes expands the pads for the given axes to pads for all axes of a tensor with
+// the given rank. Axes which are not given are not padded.
+func padsForAxes(axesPads, axesList []int, rank int) ([]int, error) {
+	if len(axesPads) != 2*len(axesList) {
+		return nil, ErrDimension("pads should have twice the length of the axes")
+	}
+
+	pads := make([]int, 2*rank)
+
+	for i, axis := range axesList {
+		if axis < -rank || axis >= rank {
+			return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+		}
+
+		axis = ConvertNegativeAxis(axis, rank)
+		pads[axis] = axesPads[i]
+		pads[axis+rank] = axesPads[i+len(axesList)]
+	}
+
+	return pads, nil
+}
@@ -0,0 +1,89 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		backing       any
+		shape         []int
+		pads          []int
+		mode          PadMode
+		constantValue any
+		expected      any
+		expectedShape tensor.Shape
+	}{
+		{
+			[]float32{1, 2, 3, 4}, []int{2, 2}, []int{0, 1, 1, 0}, PadConstant, float32(9),
+			[]float32{9, 1, 2, 9, 3, 4, 9, 9, 9}, tensor.Shape{3, 3},
+		},
+		{
+			[]int64{1, 2, 3, 4}, []int{2, 2}, []int{0, 1, 0, 0}, PadConstant, nil,
+			[]int64{0, 1, 2, 0, 3, 4}, tensor.Shape{2, 3},
+		},
+		{
+			[]int32{1, 2, 3}, []int{3}, []int{2, 2}, PadReflect, nil,
+			[]int32{3, 2, 1, 2, 3, 2, 1}, tensor.Shape{7},
+		},
+		{
+			[]int32{1, 2, 3}, []int{3}, []int{2, 1}, PadEdge, nil,
+			[]int32{1, 1, 1, 2, 3, 3}, tensor.Shape{6},
+		},
+		{
+			[]int32{1, 2, 3}, []int{3}, []int{2, 1}, PadWrap, nil,
+			[]int32{2, 3, 1, 2, 3, 1}, tensor.Shape{6},
+		},
+		{
+			[]string{"a", "b", "c", "d"}, []int{4}, []int{-1, -1}, PadConstant, nil,
+			[]string{"b", "c"}, tensor.Shape{2},
+		},
+	}
+
+	for _, test := range tests {
+		input := TensorWithBackingFixture(test.backing, test.shape...)
+
+		out, err := Pad(input, test.pads, test.mode, test.constantValue)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, out.Shape())
+		assert.Equal(t, test.expected, out.Data())
+	}
+}
+
+func TestPadErrors(t *testing.T) {
+	input := TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)
+
+	_, err := Pad(input, []int{1, 1}, PadConstant, nil)
+	assert.Equal(t, ErrDimension("pads should have length 4"), err)
+
+	_, err = Pad(input, []int{0, 0, -2, 0}, PadConstant, nil)
+	assert.Equal(t, ErrDimension("empty tensors are not supported"), err)
+
+	_, err = Pad(input, []int{1, 0, 0, 0}, PadMode("mirror"), nil)
+	assert.ErrorIs(t, err, ErrPadMode)
+}
+
+func TestPadWithAxes(t *testing.T) {
+	input := TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)
+
+	out, err := PadWithAxes(
+		input,
+		TensorWithBackingFixture([]int64{1, 0}, 2),
+		tensor.New(tensor.FromScalar(float32(9))),
+		TensorWithBackingFixture([]int64{-1}, 1),
+		PadConstant,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{2, 3}, out.Shape())
+	assert.Equal(t, []float32{9, 1, 2, 9, 3, 4}, out.Data())
+
+	out, err = PadWithAxes(input, TensorWithBackingFixture([]int64{0, 1, 0, 1}, 4), nil, nil, PadWrap)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{2, 1, 2, 1, 4, 3, 4, 3}, out.Data())
+
+	_, err = PadWithAxes(input, TensorWithBackingFixture([]int64{1, 1}, 2), nil, TensorWithBackingFixture([]int64{2}, 1), PadConstant)
+	assert.Equal(t, ErrAxisOutOfRange(-2, 1, 2), err)
+}
@@ -0,0 +1,169 @@
+package ops
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"gorgonia.org/tensor"
+)
+
+// quantizeRanges holds the range of values of the types a tensor can be quantized to.
+var quantizeRanges = map[tensor.Dtype][2]float64{
+	tensor.Uint8:    {0, math.MaxUint8},
+	tensor.Int8:     {math.MinInt8, math.MaxInt8},
+	tensor.Uint16:   {0, math.MaxUint16},
+	tensor.Int16:    {math.MinInt16, math.MaxInt16},
+	onnx.Uint4Dtype: {0, onnx.MaxUint4},
+	onnx.Int4Dtype:  {onnx.MinInt4, onnx.MaxInt4},
+}
+
+// quantizeFloat8Types holds the float8 types a tensor can be quantized to.
+var quantizeFloat8Types = map[tensor.Dtype]bool{
+	onnx.Float8E4M3FNDtype: true,
+	onnx.Float8E5M2Dtype:   true,
+}
+
+// QuantizeLinear quantizes the input using the scale and the zero point, which are either
+// scalars, 1D tensors with a value for every element along the axis or, if the block size is
+// positive, tensors with a value for every block of elements along the axis. The output has
+// the type of the zero point, or uint8 if no zero point is given. For integer types, values
+// are rounded half to even and saturated to the range of the type. For float8 types, values
+// are converted to the nearest float8 value, where saturate determines whether values which
+// are too large saturate.
+func QuantizeLinear(x, scale, zeroPoint tensor.Tensor, axis, blockSize int, saturate bool) (tensor.Tensor, error) {
+	outType := tensor.Uint8
+	if zeroPoint != nil {
+		outType = zeroPoint.Dtype()
+	}
+
+	valueRange, isInteger := quantizeRanges[outType]
+	if !isInteger && !quantizeFloat8Types[outType] {
+		return nil, ErrConversionNotSupportedDtype(outType)
+	}
+
+	data, scales, zeroPoints, err := quantizeParams(x, scale, zeroPoint, axis, blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]float64, len(data))
+
+	for i, value := range data {
+		if !isInteger {
+			out[i] = value/scales[i] + zeroPoints[i]
+
+			continue
+		}
+
+		quantized := math.RoundToEven(value/scales[i]) + zeroPoints[i]
+		out[i] = math.Min(math.Max(quantized, valueRange[0]), valueRange[1])
+	}
+
+	res := tensor.New(tensor.WithShape(x.Shape()...), tensor.WithBacking(out))
+
+	return ConvertTensorDtypeSaturate(res, int32(tensorProtoTypes[outType]), saturate)
+}
+
+// DequantizeLinear dequantizes the input using the scale and the zero point, which are given
+// like for QuantizeLinear. The output has the type of the scale.
+func DequantizeLinear(x, scale, zeroPoint tensor.Tensor, axis, blockSize int) (tensor.Tensor, error) {
+	data, scales, zeroPoints, err := quantizeParams(x, scale, zeroPoint, axis, blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]float64, len(data))
+	for i, value := range data {
+		out[i] = (value - zeroPoints[i]) * scales[i]
+	}
+
+	res := tensor.New(tensor.WithShape(x.Shape()...), tensor.WithBacking(out))
+
+	return ConvertTensorDtypeLike(res, scale)
+}
+
+// quantizeParams returns the data of x, together with the scale and the zero point of every
+// element of x. If no zero point is given, the zero point of every element is 0.
+func quantizeParams(x, scale, zeroPoint tensor.Tensor, axis, blockSize int) ([]float64, []float64, []float64, error) {
+	data, err := Float64Data(x)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	scales, err := broadcastQuantizeParam(scale, x.Shape(), axis, blockSize)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	zeroPoints := make([]float64, len(data))
+
+	if zeroPoint != nil {
+		zeroPoints, err = broadcastQuantizeParam(zeroPoint, x.Shape(), axis, blockSize)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return data, scales, zeroPoints, nil
+}
+
+// broadcastQuantizeParam returns the value of a scale or zero point for every element of a
+// tensor with the given shape. The parameter is either a scalar, holds a value for every
+// element along the axis or, if the block size is positive, has the shape of the tensor
+// except along the axis, where it holds a value for every block of elements.
+func broadcastQuantizeParam(param tensor.Tensor, shape tensor.Shape, axis, blockSize int) ([]float64, error) {
+	values, err := Float64Data(param)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]float64, shape.TotalSize())
+
+	if len(values) == 1 && blockSize <= 0 {
+		for i := range out {
+			out[i] = values[0]
+		}
+
+		return out, nil
+	}
+
+	rank := len(shape)
+	if axis < -rank || axis >= rank {
+		return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+	}
+
+	axis = ConvertNegativeAxis(axis, rank)
+	stride := shape[axis+1:].TotalSize()
+
+	if blockSize <= 0 {
+		if len(param.Shape()) != 1 || len(values) != shape[axis] {
+			return nil, ErrDimension(fmt.Sprintf("expected a scalar or %d values along axis %d", shape[axis], axis))
+		}
+
+		for i := range out {
+			out[i] = values[(i/stride)%shape[axis]]
+		}
+
+		return out, nil
+	}
+
+	blocks := (shape[axis] + blockSize - 1) / blockSize
+
+	expectedShape := shape.Clone()
+	expectedShape[axis] = blocks
+
+	// The shapes are compared strictly, as Eq considers all vectors of the same size equal.
+	if !slices.Equal(param.Shape(), expectedShape) {
+		return nil, ErrDimension(fmt.Sprintf("expected shape %v for blocks of size %d along axis %d", expectedShape, blockSize, axis))
+	}
+
+	for i := range out {
+		outer := i / (stride * shape[axis])
+		block := (i / stride) % shape[axis] / blockSize
+		out[i] = values[(outer*blocks+block)*stride+i%stride]
+	}
+
+	return out, nil
+}
@@ -0,0 +1,151 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestQuantizeLinear(t *testing.T) {
+	tests := []struct {
+		x         tensor.Tensor
+		scale     tensor.Tensor
+		zeroPoint tensor.Tensor
+		axis      int
+		blockSize int
+		expected  any
+	}{
+		{
+			TensorWithBackingFixture([]float32{0, 2, 3, 1000, -254, -1000}, 6),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(uint8(128))),
+			1,
+			0,
+			[]uint8{128, 129, 130, 255, 1, 0},
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 5, -3, -300}, 4),
+			tensor.New(tensor.FromScalar(float32(2))),
+			nil,
+			1,
+			0,
+			[]uint8{0, 2, 0, 0},
+		},
+		{
+			TensorWithBackingFixture([]float32{2, 4, 6, 8, 10, 12}, 2, 3),
+			TensorWithBackingFixture([]float32{1, 2}, 2),
+			TensorWithBackingFixture([]int8{0, -10}, 2),
+			0,
+			0,
+			[]int8{2, 4, 6, -6, -5, -4},
+		},
+		{
+			TensorWithBackingFixture([]float32{2, 4, 6, 8, 10, 12}, 2, 3),
+			TensorWithBackingFixture([]float32{1, 2, 4}, 3),
+			nil,
+			-1,
+			0,
+			[]uint8{2, 2, 2, 8, 5, 3},
+		},
+		{
+			TensorWithBackingFixture([]float32{2, 4, 6, 8, 10, 12}, 2, 3),
+			TensorWithBackingFixture([]float32{1, 2, 4, 8}, 2, 2),
+			nil,
+			1,
+			2,
+			[]uint8{2, 4, 3, 2, 2, 2},
+		},
+		{
+			TensorWithBackingFixture([]float32{-20, 3, 17}, 3),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(onnx.Int4(1))),
+			1,
+			0,
+			[]onnx.Int4{-8, 3, 7},
+		},
+		{
+			TensorWithBackingFixture([]float32{0.5, -4, 1e6}, 3),
+			tensor.New(tensor.FromScalar(float32(0.5))),
+			tensor.New(tensor.FromScalar(onnx.Float8E5M2(0))),
+			1,
+			0,
+			[]onnx.Float8E5M2{0x3c, 0xc8, 0x7b},
+		},
+	}
+
+	for _, test := range tests {
+		out, err := QuantizeLinear(test.x, test.scale, test.zeroPoint, test.axis, test.blockSize, true)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, out.Data())
+		assert.Equal(t, test.x.Shape(), out.Shape())
+	}
+}
+
+func TestQuantizeLinearFail(t *testing.T) {
+	x := TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)
+
+	_, err := QuantizeLinear(x, TensorWithBackingFixture([]float32{1, 2, 3}, 3), nil, 1, 0, true)
+	assert.Equal(t, ErrDimension("expected a scalar or 2 values along axis 1"), err)
+
+	_, err = QuantizeLinear(x, TensorWithBackingFixture([]float32{1, 2}, 2), nil, 2, 0, true)
+	assert.Equal(t, ErrAxisOutOfRange(-2, 1, 2), err)
+
+	_, err = QuantizeLinear(x, tensor.New(tensor.FromScalar(float32(1))), tensor.New(tensor.FromScalar(int32(0))), 1, 0, true)
+	assert.Equal(t, ErrConversionNotSupportedDtype(tensor.Int32), err)
+
+	_, err = QuantizeLinear(x, TensorWithBackingFixture([]float32{1, 2}, 2), nil, 1, 2, true)
+	assert.Equal(t, ErrDimension("expected shape (2, 1) for blocks of size 2 along axis 1"), err)
+}
+
+func TestDequantizeLinear(t *testing.T) {
+	tests := []struct {
+		x         tensor.Tensor
+		scale     tensor.Tensor
+		zeroPoint tensor.Tensor
+		axis      int
+		blockSize int
+		expected  any
+	}{
+		{
+			TensorWithBackingFixture([]uint8{0, 3, 128, 255}, 4),
+			tensor.New(tensor.FromScalar(float32(2))),
+			tensor.New(tensor.FromScalar(uint8(128))),
+			1,
+			0,
+			[]float32{-256, -250, 0, 254},
+		},
+		{
+			TensorWithBackingFixture([]int32{-3, 5}, 2),
+			tensor.New(tensor.FromScalar(float64(0.5))),
+			nil,
+			1,
+			0,
+			[]float64{-1.5, 2.5},
+		},
+		{
+			TensorWithBackingFixture([]int8{1, 2, 3, 4}, 2, 2),
+			TensorWithBackingFixture([]float32{1, 2}, 2),
+			TensorWithBackingFixture([]int8{1, 0}, 2),
+			1,
+			0,
+			[]float32{0, 4, 2, 8},
+		},
+		{
+			TensorWithBackingFixture([]onnx.Int4{-8, 7, 1, 2, 3, 4}, 3, 2),
+			TensorWithBackingFixture([]float32{1, 1, 2, 2}, 2, 2),
+			TensorWithBackingFixture([]onnx.Int4{0, 0, 1, 1}, 2, 2),
+			0,
+			2,
+			[]float32{-8, 7, 1, 2, 4, 6},
+		},
+	}
+
+	for _, test := range tests {
+		out, err := DequantizeLinear(test.x, test.scale, test.zeroPoint, test.axis, test.blockSize)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, out.Data())
+		assert.Equal(t, test.x.Shape(), out.Shape())
+	}
+}
@@ -0,0 +1,293 @@
+package ops
+
+import (
+	"math"
+	"reflect"
+	"slices"
+
+	"gorgonia.org/tensor"
+)
+
+// Reduction is the function the reduce operators use to combine all values along the
+// reduced axes into a single value.
+type Reduction string
+
+const (
+	ReductionL1        Reduction = "l1"
+	ReductionL2        Reduction = "l2"
+	ReductionLogSum    Reduction = "logSum"
+	ReductionLogSumExp Reduction = "logSumExp"
+	ReductionMax       Reduction = "max"
+	ReductionMean      Reduction = "mean"
+	ReductionMin       Reduction = "min"
+	ReductionProd      Reduction = "prod"
+	ReductionSum       Reduction = "sum"
+	ReductionSumSquare Reduction = "sumSquare"
+)
+
+// Reduce reduces the input along the given axes using the reduction. Negative axes count
+// from the last dim. If no axes are given, all axes are reduced. The reduced dims are kept
+// with size 1 if keepDims is set, otherwise they are removed from the output.
+func Reduce(input tensor.Tensor, axes []int, keepDims bool, reduction Reduction) (tensor.Tensor, error) {
+	shape := input.Shape()
+	rank := len(shape)
+
+	reduced := make([]bool, rank)
+
+	for _, axis := range axes {
+		if axis < -rank || axis >= rank {
+			return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+		}
+
+		reduced[ConvertNegativeAxis(axis, rank)] = true
+	}
+
+	outShape := make([]int, 0, rank)
+	keptShape := make([]int, rank)
+
+	for i, dim := range shape {
+		if len(axes) == 0 {
+			reduced[i] = true
+		}
+
+		keptShape[i] = dim
+		if reduced[i] {
+			keptShape[i] = 1
+		}
+
+		if keepDims || !reduced[i] {
+			outShape = append(outShape, keptShape[i])
+		}
+	}
+
+	offsets := reduceOffsets(shape, keptShape)
+
+	var (
+		out any
+		err error
+	)
+
+	switch data := dataAsSlice(input).(type) {
+	case []float32:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []float64:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []int8:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []int16:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []int32:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []int64:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []uint8:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []uint16:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []uint32:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []uint64:
+		out, err = reduceNumbers(data, offsets, NElements(keptShape...), reduction)
+	case []bool:
+		out, err = reduceBools(data, offsets, NElements(keptShape...), reduction)
+	default:
+		return nil, ErrUnsupportedReduction(reduction, input.Dtype())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outShape) == 0 {
+		return tensor.New(tensor.FromScalar(reflect.ValueOf(out).Index(0).Interface())), nil
+	}
+
+	return tensor.New(tensor.WithShape(outShape...), tensor.WithBacking(out)), nil
+}
+
+// ReduceWithAxes reduces the input along the axes given by a tensor, as done by the reduce
+// operators which take the axes as an optional input. If no axes are given, all axes are
+// reduced, unless noopWithEmptyAxes is set, in which case the input is returned unchanged.
+func ReduceWithAxes(
+	input, axes tensor.Tensor, keepDims, noopWithEmptyAxes bool, reduction Reduction,
+) (tensor.Tensor, error) {
+	axesList := []int{}
+
+	if axes != nil {
+		var err error
+
+		axesList, err = AnyToIntSlice(IfScalarToSlice(axes.Data()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(axesList) == 0 && noopWithEmptyAxes {
+		return input, nil
+	}
+
+	return Reduce(input, axesList, keepDims, reduction)
+}
+
+// dataAsSlice wraps the data of a tensor in a slice if the tensor holds its data as a
+// scalar, which is the case for tensors with a single element. Scalars of named types, like
+// onnx.Float16, are returned by gorgonia as their underlying type, hence the scalar is
+// converted to the dtype of the tensor.
+func dataAsSlice(t tensor.Tensor) any {
+	data := t.Data()
+
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Slice {
+		return data
+	}
+
+	slice := reflect.MakeSlice(reflect.SliceOf(t.Dtype().Type), 1, 1)
+	slice.Index(0).Set(value.Convert(t.Dtype().Type))
+
+	return slice.Interface()
+}
+
+// reduceOffsets returns, for every element of a tensor with the given shape, the offset of
+// the element in the reduced tensor with the kept shape, in which every reduced dim has size 1.
+func reduceOffsets(shape, keptShape tensor.Shape) []int {
+	strides := shape.CalcStrides()
+	keptStrides := keptShape.CalcStrides()
+
+	offsets := make([]int, shape.TotalSize())
+
+	for i := range offsets {
+		remaining := i
+
+		for dim, stride := range strides {
+			position := remaining / stride
+			remaining %= stride
+
+			if keptShape[dim] != 1 {
+				offsets[i] += position * keptStrides[dim]
+			}
+		}
+	}
+
+	return offsets
+}
+
+// reduceNumbers reduces the values of data into size values, where offsets holds for every
+// value the offset of the value it is reduced into. The values are accumulated in place.
+func reduceNumbers[T Number](data []T, offsets []int, size int, reduction Reduction) ([]T, error) {
+	counts := make([]int, size)
+	for _, offset := range offsets {
+		counts[offset]++
+	}
+
+	if slices.Contains(counts, 0) {
+		return nil, ErrEmptyReduction
+	}
+
+	out := make([]T, size)
+
+	switch reduction {
+	case ReductionMax, ReductionMin, ReductionLogSumExp:
+		reduceExtremes(data, offsets, out, reduction == ReductionMin)
+
+		if reduction == ReductionLogSumExp {
+			reduceLogSumExp(data, offsets, out)
+		}
+	case ReductionSum, ReductionMean, ReductionLogSum:
+		for i, value := range data {
+			out[offsets[i]] += value
+		}
+
+		for i := range out {
+			if reduction == ReductionMean {
+				out[i] = T(float64(out[i]) / float64(counts[i]))
+			} else if reduction == ReductionLogSum {
+				out[i] = T(math.Log(float64(out[i])))
+			}
+		}
+	case ReductionProd:
+		for i := range out {
+			out[i] = 1
+		}
+
+		for i, value := range data {
+			out[offsets[i]] *= value
+		}
+	case ReductionL1:
+		for i, value := range data {
+			if value < 0 {
+				value = -value
+			}
+
+			out[offsets[i]] += value
+		}
+	case ReductionL2, ReductionSumSquare:
+		for i, value := range data {
+			out[offsets[i]] += value * value
+		}
+
+		if reduction == ReductionL2 {
+			for i := range out {
+				out[i] = T(math.Sqrt(float64(out[i])))
+			}
+		}
+	default:
+		return nil, ErrUnsupportedReduction(reduction, tensor.Dtype{Type: reflect.TypeOf(out).Elem()})
+	}
+
+	return out, nil
+}
+
+// reduceExtremes reduces the values of data into the max of every group in out, or into
+// the min if minimum is set.
+func reduceExtremes[T Number](data []T, offsets []int, out []T, minimum bool) {
+	seen := make([]bool, len(out))
+
+	for i, value := range data {
+		offset := offsets[i]
+
+		switch {
+		case !seen[offset]:
+			out[offset] = value
+			seen[offset] = true
+		case minimum && value < out[offset], !minimum && value > out[offset]:
+			out[offset] = value
+		}
+	}
+}
+
+// reduceLogSumExp reduces the values of data into the log of the sum of the exponents of
+// every group, given the max of every group in out. The max is subtracted before taking
+// the exponent, which keeps the sum from overflowing.
+func reduceLogSumExp[T Number](data []T, offsets []int, out []T) {
+	sums := make([]float64, len(out))
+	for i, value := range data {
+		sums[offsets[i]] += math.Exp(float64(value) - float64(out[offsets[i]]))
+	}
+
+	for i, sum := range sums {
+		out[i] = T(float64(out[i]) + math.Log(sum))
+	}
+}
+
+// reduceBools reduces booleans, for which the max is true if any value is true, and the min
+// is true if all values are true. Other reductions are not supported for booleans.
+func reduceBools(data []bool, offsets []int, size int, reduction Reduction) ([]bool, error) {
+	if reduction != ReductionMax && reduction != ReductionMin {
+		return nil, ErrUnsupportedReduction(reduction, tensor.Bool)
+	}
+
+	out := make([]bool, size)
+	for i := range out {
+		out[i] = reduction == ReductionMin
+	}
+
+	for i, value := range data {
+		if reduction == ReductionMax {
+			out[offsets[i]] = out[offsets[i]] || value
+		} else {
+			out[offsets[i]] = out[offsets[i]] && value
+		}
+	}
+
+	return out, nil
+}
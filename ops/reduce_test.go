@@ -0,0 +1,83 @@
+package ops
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		backing       any
+		shape         []int
+		axes          []int
+		keepDims      bool
+		reduction     Reduction
+		expected      any
+		expectedShape tensor.Shape
+	}{
+		{[]float32{1, 2, 3, 4, 5, 6}, []int{2, 3}, []int{1}, true, ReductionMax, []float32{3, 6}, tensor.Shape{2, 1}},
+		{[]float32{1, 2, 3, 4, 5, 6}, []int{2, 3}, []int{0}, false, ReductionMin, []float32{1, 2, 3}, tensor.Shape{3}},
+		{[]int64{1, 2, 3, 4, 5, 6}, []int{2, 3}, []int{-1}, false, ReductionSum, []int64{6, 15}, tensor.Shape{2}},
+		{[]int64{1, 2, 3, 4, 5, 6}, []int{2, 3}, []int{}, true, ReductionSum, []int64{21}, tensor.Shape{1, 1}},
+		{[]int64{1, 2, 3, 4, 5, 6}, []int{2, 3}, []int{}, false, ReductionProd, int64(720), tensor.ScalarShape()},
+		{[]float64{1, 2, 3, 4}, []int{2, 2}, []int{0, 1}, false, ReductionMean, 2.5, tensor.ScalarShape()},
+		{[]int32{-1, 2, -3, 4}, []int{2, 2}, []int{1}, false, ReductionL1, []int32{3, 7}, tensor.Shape{2}},
+		{[]float32{3, 4, 6, 8}, []int{2, 2}, []int{1}, false, ReductionL2, []float32{5, 10}, tensor.Shape{2}},
+		{[]uint8{1, 2, 3, 4}, []int{2, 2}, []int{1}, false, ReductionSumSquare, []uint8{5, 25}, tensor.Shape{2}},
+		{[]float64{1, math.E - 1}, []int{2}, []int{0}, true, ReductionLogSum, []float64{1}, tensor.Shape{1}},
+		{[]float64{0, 0}, []int{2}, []int{0}, true, ReductionLogSumExp, []float64{math.Log(2)}, tensor.Shape{1}},
+		{[]float32{1, 2, 3, 4, 5, 6, 7, 8}, []int{2, 2, 2}, []int{0, 2}, true, ReductionSum, []float32{14, 22}, tensor.Shape{1, 2, 1}},
+		{[]uint16{7}, []int{1}, []int{0}, true, ReductionMax, []uint16{7}, tensor.Shape{1}},
+	}
+
+	for _, test := range tests {
+		input := TensorWithBackingFixture(test.backing, test.shape...)
+
+		out, err := Reduce(input, test.axes, test.keepDims, test.reduction)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, out.Shape())
+
+		if expected, ok := test.expected.([]float64); ok {
+			assert.InDeltaSlice(t, expected, out.Data(), 1e-9)
+		} else {
+			assert.Equal(t, test.expected, out.Data())
+		}
+	}
+}
+
+func TestReduceErrors(t *testing.T) {
+	input := TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)
+
+	_, err := Reduce(input, []int{2}, true, ReductionMax)
+	assert.Equal(t, ErrAxisOutOfRange(-2, 1, 2), err)
+
+	_, err = Reduce(input, []int{0}, true, Reduction("median"))
+	assert.ErrorIs(t, err, ErrReduction)
+
+	_, err = Reduce(TensorWithBackingFixture([]bool{true, false}, 2), []int{0}, true, ReductionSum)
+	assert.ErrorIs(t, err, ErrReduction)
+
+	// Every output value must have at least one value to reduce.
+	_, err = reduceNumbers([]float32{1, 2}, []int{0, 0}, 2, ReductionMax)
+	assert.Equal(t, ErrEmptyReduction, err)
+}
+
+func TestReduceBools(t *testing.T) {
+	input := TensorWithBackingFixture([]bool{true, false, true, true}, 2, 2)
+
+	out, err := Reduce(input, []int{1}, false, ReductionMax)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, true}, out.Data())
+
+	out, err = Reduce(input, []int{1}, false, ReductionMin)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{false, true}, out.Data())
+
+	out, err = Reduce(input, nil, true, ReductionMin)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{false}, out.Data())
+	assert.Equal(t, tensor.Shape{1, 1}, out.Shape())
+}
@@ -0,0 +1,589 @@
+package ops
+
+import (
+	"math"
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"gorgonia.org/tensor"
+)
+
+// ResizeMode is the interpolation mode the resize operator uses.
+type ResizeMode string
+
+const (
+	ResizeNearest ResizeMode = "nearest"
+	ResizeLinear  ResizeMode = "linear"
+	ResizeCubic   ResizeMode = "cubic"
+)
+
+// CoordinateTransformation is the way the resize operator maps a coordinate in the output
+// to a coordinate in the input.
+type CoordinateTransformation string
+
+const (
+	TransformHalfPixel          CoordinateTransformation = "half_pixel"
+	TransformHalfPixelSymmetric CoordinateTransformation = "half_pixel_symmetric"
+	TransformPytorchHalfPixel   CoordinateTransformation = "pytorch_half_pixel"
+	TransformAlignCorners       CoordinateTransformation = "align_corners"
+	TransformAsymmetric         CoordinateTransformation = "asymmetric"
+	TransformTFCropAndResize    CoordinateTransformation = "tf_crop_and_resize"
+)
+
+// NearestMode is the way the nearest mode of the resize operator rounds a coordinate in the
+// input to the coordinate of an element.
+type NearestMode string
+
+const (
+	NearestRoundPreferFloor NearestMode = "round_prefer_floor"
+	NearestRoundPreferCeil  NearestMode = "round_prefer_ceil"
+	NearestFloor            NearestMode = "floor"
+	NearestCeil             NearestMode = "ceil"
+)
+
+// AspectRatioPolicy is the way the resize operator treats the aspect ratio of the input
+// when the sizes of the output are given.
+type AspectRatioPolicy string
+
+const (
+	AspectRatioStretch    AspectRatioPolicy = "stretch"
+	AspectRatioNotLarger  AspectRatioPolicy = "not_larger"
+	AspectRatioNotSmaller AspectRatioPolicy = "not_smaller"
+)
+
+// ResizeTypes are the dtypes which can be resized by the resize operators.
+var ResizeTypes = []tensor.Dtype{
+	tensor.Uint8, tensor.Uint16, tensor.Uint32, tensor.Uint64,
+	tensor.Int8, tensor.Int16, tensor.Int32, tensor.Int64,
+	tensor.Float32, tensor.Float64,
+}
+
+// ResizeAttributes holds the attributes of the resize operators. Attributes which do not
+// exist in an opset keep their default value.
+type ResizeAttributes struct {
+	Mode                     ResizeMode
+	CoordinateTransformation CoordinateTransformation
+	NearestMode              NearestMode
+	CubicCoeffA              float64
+	ExcludeOutside           bool
+	ExtrapolationValue       float64
+	Antialias                bool
+	KeepAspectRatioPolicy    AspectRatioPolicy
+	Axes                     []int
+}
+
+// NewResizeAttributes returns the attributes of a resize operator with their default values.
+func NewResizeAttributes() ResizeAttributes {
+	return ResizeAttributes{
+		Mode:                     ResizeNearest,
+		CoordinateTransformation: TransformHalfPixel,
+		NearestMode:              NearestRoundPreferFloor,
+		CubicCoeffA:              -0.75,
+		KeepAspectRatioPolicy:    AspectRatioStretch,
+	}
+}
+
+// SetAttribute sets the value of a resize attribute. Every attribute and value of the
+// resize operators of all opsets is accepted, the operators themselves reject the ones
+// which do not exist in their opset.
+func (a *ResizeAttributes) SetAttribute(attr *onnx.AttributeProto, op Operator) error {
+	switch attr.GetName() {
+	case "antialias":
+		a.Antialias = Int64ToBool(attr.GetI())
+	case "axes":
+		a.Axes = make([]int, len(attr.GetInts()))
+		for i, axis := range attr.GetInts() {
+			a.Axes[i] = int(axis)
+		}
+	case "coordinate_transformation_mode":
+		transformation := CoordinateTransformation(attr.GetS())
+		if !slices.Contains([]CoordinateTransformation{
+			TransformHalfPixel, TransformHalfPixelSymmetric, TransformPytorchHalfPixel,
+			TransformAlignCorners, TransformAsymmetric, TransformTFCropAndResize,
+		}, transformation) {
+			return ErrUnsupportedAttribute(attr.GetName(), op)
+		}
+
+		a.CoordinateTransformation = transformation
+	case "cubic_coeff_a":
+		a.CubicCoeffA = float64(attr.GetF())
+	case "exclude_outside":
+		a.ExcludeOutside = Int64ToBool(attr.GetI())
+	case "extrapolation_value":
+		a.ExtrapolationValue = float64(attr.GetF())
+	case "keep_aspect_ratio_policy":
+		policy := AspectRatioPolicy(attr.GetS())
+		if !slices.Contains([]AspectRatioPolicy{AspectRatioStretch, AspectRatioNotLarger, AspectRatioNotSmaller}, policy) {
+			return ErrUnsupportedAttribute(attr.GetName(), op)
+		}
+
+		a.KeepAspectRatioPolicy = policy
+	case "mode":
+		mode := ResizeMode(attr.GetS())
+		if !slices.Contains([]ResizeMode{ResizeNearest, ResizeLinear, ResizeCubic}, mode) {
+			return ErrUnsupportedAttribute(attr.GetName(), op)
+		}
+
+		a.Mode = mode
+	case "nearest_mode":
+		nearestMode := NearestMode(attr.GetS())
+		if !slices.Contains([]NearestMode{
+			NearestRoundPreferFloor, NearestRoundPreferCeil, NearestFloor, NearestCeil,
+		}, nearestMode) {
+			return ErrUnsupportedAttribute(attr.GetName(), op)
+		}
+
+		a.NearestMode = nearestMode
+	default:
+		return ErrInvalidAttribute(attr.GetName(), op)
+	}
+
+	return nil
+}
+
+// ValidateResizeInputs validates the inputs of a resize operator, which are the input and
+// the optional roi, scales and sizes. Exactly one of the scales and the sizes should be given.
+func ValidateResizeInputs(op Operator, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ValidateInputs(op, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if (inputs[2] == nil) == (inputs[3] == nil) {
+		return nil, ErrInvalidInput("exactly one of 'scales' and 'sizes' should be given", op)
+	}
+
+	return inputs, nil
+}
+
+// ResizeWithInputs resizes the input as done by the resize operators, which take the roi,
+// the scales and the sizes as optional tensors. Exactly one of the scales and the sizes
+// should be given. The roi is only used by the tf_crop_and_resize transformation.
+func ResizeWithInputs(input, roi, scales, sizes tensor.Tensor, attributes ResizeAttributes) (tensor.Tensor, error) {
+	var (
+		roiList, scalesList []float64
+		sizesList           []int
+		err                 error
+	)
+
+	if roi != nil && attributes.CoordinateTransformation == TransformTFCropAndResize {
+		roiList, err = Float64Data(roi)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if scales != nil {
+		scalesList, err = Float64Data(scales)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if sizes != nil {
+		sizesList, err = AnyToIntSlice(IfScalarToSlice(sizes.Data()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return Resize(input, roiList, scalesList, sizesList, attributes)
+}
+
+// Resize resizes the input by the given scales, or to the given sizes if the scales are nil.
+// The scales and sizes hold a value for every axis of the attributes, or for every axis of
+// the input if no axes are given. The roi holds the start of the region of interest for
+// every axis, followed by the end of it for every axis. Every axis is interpolated on its
+// own, which gives the same result as interpolating all axes at once.
+func Resize(input tensor.Tensor, roi, scales []float64, sizes []int, attributes ResizeAttributes) (tensor.Tensor, error) {
+	shape := input.Shape().Clone()
+	rank := len(shape)
+
+	axes, err := resizeAxes(attributes.Axes, rank)
+	if err != nil {
+		return nil, err
+	}
+
+	axesScales, outShape, err := resizeScales(shape, axes, scales, sizes, attributes.KeepAspectRatioPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	roiStarts, roiEnds, err := resizeRoi(roi, axes, rank, attributes.CoordinateTransformation)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := Float64Data(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for axis := range shape {
+		if outShape[axis] <= 0 {
+			return nil, ErrDimension("empty tensors are not supported")
+		}
+
+		// Without cropping, an axis which keeps its size is mapped onto itself.
+		if axesScales[axis] == 1 && outShape[axis] == shape[axis] &&
+			attributes.CoordinateTransformation != TransformTFCropAndResize {
+			continue
+		}
+
+		weights := attributes.axisWeights(shape[axis], outShape[axis], axesScales[axis], roiStarts[axis], roiEnds[axis])
+		data = resizeAxis(data, shape, axis, weights, attributes.ExtrapolationValue)
+		shape[axis] = outShape[axis]
+	}
+
+	out := tensor.New(tensor.WithShape(shape...), tensor.WithBacking(data))
+
+	return ConvertTensorDtypeLike(out, input)
+}
+
+// resizeAxes returns the axes which are resized, with negative axes converted to positive
+// ones. If no axes are given, all axes are resized.
+func resizeAxes(axes []int, rank int) ([]int, error) {
+	if axes == nil {
+		axes = make([]int, rank)
+		for i := range axes {
+			axes[i] = i
+		}
+
+		return axes, nil
+	}
+
+	converted := make([]int, len(axes))
+
+	for i, axis := range axes {
+		if axis < -rank || axis >= rank {
+			return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+		}
+
+		converted[i] = ConvertNegativeAxis(axis, rank)
+	}
+
+	if HasDuplicates(converted) {
+		return nil, ErrDimension("the axes to resize should be unique")
+	}
+
+	return converted, nil
+}
+
+// resizeScales returns the scale and the size of every axis of the output. The scale of an
+// axis which is not resized is 1. When the sizes are given, the scales follow from them and
+// the policy, which can change the sizes to keep the aspect ratio of the input.
+func resizeScales(
+	shape tensor.Shape, axes []int, scales []float64, sizes []int, policy AspectRatioPolicy,
+) ([]float64, []int, error) {
+	axesScales := make([]float64, len(shape))
+	outShape := slices.Clone([]int(shape))
+
+	for axis := range axesScales {
+		axesScales[axis] = 1
+	}
+
+	switch {
+	case scales != nil && sizes != nil:
+		return nil, nil, ErrDimension("only one of the scales and the sizes should be given")
+	case scales != nil:
+		if len(scales) != len(axes) {
+			return nil, nil, ErrDimension("there should be a scale for every resized axis")
+		}
+
+		for i, axis := range axes {
+			if scales[i] <= 0 {
+				return nil, nil, ErrDimension("scales should be positive")
+			}
+
+			axesScales[axis] = scales[i]
+			outShape[axis] = int(math.Floor(scales[i] * float64(shape[axis])))
+		}
+	case sizes != nil:
+		if len(sizes) != len(axes) {
+			return nil, nil, ErrDimension("there should be a size for every resized axis")
+		}
+
+		for i, axis := range axes {
+			axesScales[axis] = float64(sizes[i]) / float64(shape[axis])
+			outShape[axis] = sizes[i]
+		}
+
+		if policy == AspectRatioNotLarger || policy == AspectRatioNotSmaller {
+			scale := axesScales[axes[0]]
+
+			for _, axis := range axes[1:] {
+				if policy == AspectRatioNotLarger {
+					scale = min(scale, axesScales[axis])
+				} else {
+					scale = max(scale, axesScales[axis])
+				}
+			}
+
+			for _, axis := range axes {
+				axesScales[axis] = scale
+				outShape[axis] = int(scale*float64(shape[axis]) + 0.5)
+			}
+		}
+	default:
+		return nil, nil, ErrDimension("either the scales or the sizes should be given")
+	}
+
+	return axesScales, outShape, nil
+}
+
+// resizeRoi returns the start and the end of the region of interest of every axis. The roi
+// is only used by the tf_crop_and_resize transformation. An axis which is not resized keeps
+// its full range.
+func resizeRoi(roi []float64, axes []int, rank int, transformation CoordinateTransformation) ([]float64, []float64, error) {
+	starts := make([]float64, rank)
+	ends := make([]float64, rank)
+
+	for axis := range ends {
+		ends[axis] = 1
+	}
+
+	if transformation != TransformTFCropAndResize {
+		return starts, ends, nil
+	}
+
+	if len(roi) != 2*len(axes) {
+		return nil, nil, ErrDimension("the roi should have twice the length of the resized axes")
+	}
+
+	for i, axis := range axes {
+		starts[axis] = roi[i]
+		ends[axis] = roi[i+len(axes)]
+	}
+
+	return starts, ends, nil
+}
+
+// resizeWeights are the positions in the input along an axis and their weights, of which
+// the weighted sum is the value of an element of the output. If extrapolate is set, the
+// element lies outside the input and gets the extrapolation value.
+type resizeWeights struct {
+	positions   []int
+	weights     []float64
+	extrapolate bool
+}
+
+// axisWeights returns the weights of every position along an axis of the output.
+func (a ResizeAttributes) axisWeights(inSize, outSize int, scale, roiStart, roiEnd float64) []resizeWeights {
+	weights := make([]resizeWeights, outSize)
+
+	for x := range weights {
+		position, ok := a.inputCoordinate(float64(x), inSize, outSize, scale, roiStart, roiEnd)
+		if !ok {
+			weights[x].extrapolate = true
+			continue
+		}
+
+		// The ratio lies in (0, 1], such that an integer position is the right neighbour.
+		lower := math.Floor(position)
+		ratio := position - lower
+
+		if ratio == 0 {
+			lower--
+			ratio = 1
+		}
+
+		coefficients := a.coefficients(ratio, scale)
+		first := int(lower) - len(coefficients)/2 + 1
+		positions := make([]int, len(coefficients))
+
+		for i := range coefficients {
+			position := first + i
+			if a.ExcludeOutside && (position < 0 || position >= inSize) {
+				coefficients[i] = 0
+			}
+
+			// Positions outside the input get the value of the nearest edge.
+			positions[i] = min(max(position, 0), inSize-1)
+		}
+
+		if a.ExcludeOutside {
+			normalize(coefficients)
+		}
+
+		weights[x] = resizeWeights{positions: positions, weights: coefficients}
+	}
+
+	return weights
+}
+
+// inputCoordinate maps a coordinate along an axis of the output to a coordinate in the
+// input, and returns false if the coordinate lies outside the cropped input.
+func (a ResizeAttributes) inputCoordinate(
+	x float64, inSize, outSize int, scale, roiStart, roiEnd float64,
+) (float64, bool) {
+	in := float64(inSize)
+	outWidth := scale * in
+
+	switch a.CoordinateTransformation {
+	case TransformAlignCorners:
+		if outWidth == 1 {
+			return 0, true
+		}
+
+		return x * (in - 1) / (outWidth - 1), true
+	case TransformAsymmetric:
+		return x / scale, true
+	case TransformTFCropAndResize:
+		var position float64
+		if outWidth == 1 {
+			position = (roiEnd - roiStart) * (in - 1) / 2
+		} else {
+			position = x * (roiEnd - roiStart) * (in - 1) / (outWidth - 1)
+		}
+
+		position += roiStart * (in - 1)
+
+		return position, position >= 0 && position <= in-1
+	case TransformPytorchHalfPixel:
+		if outWidth == 1 {
+			return -0.5, true
+		}
+
+		return (x+0.5)/scale - 0.5, true
+	case TransformHalfPixelSymmetric:
+		// The center of the input is mapped to the center of the output, which differs from
+		// half_pixel when the size of the output is rounded.
+		adjustment := float64(outSize) / outWidth
+		offset := in / 2 * (1 - adjustment)
+
+		return offset + (x+0.5)/scale - 0.5, true
+	default:
+		return (x+0.5)/scale - 0.5, true
+	}
+}
+
+// coefficients returns the weights of the neighbours of a position in the input, of which
+// the ratio is the distance to the left neighbour. The neighbours are centered around the
+// position, e.g. the 4 neighbours of the cubic mode lie at -1-ratio, -ratio, 1-ratio and
+// 2-ratio. With antialiasing, downsampling widens the filter by the inverse of the scale.
+func (a ResizeAttributes) coefficients(ratio, scale float64) []float64 {
+	switch a.Mode {
+	case ResizeLinear:
+		if a.Antialias {
+			return antialiasCoefficients(ratio, scale, 1, func(x float64) float64 {
+				return max(1-x, 0)
+			})
+		}
+
+		return []float64{1 - ratio, ratio}
+	case ResizeCubic:
+		cubic := func(x float64) float64 {
+			return cubicCoefficient(x, a.CubicCoeffA)
+		}
+
+		if a.Antialias {
+			return antialiasCoefficients(ratio, scale, 2, cubic)
+		}
+
+		return []float64{cubic(1 + ratio), cubic(ratio), cubic(1 - ratio), cubic(2 - ratio)}
+	default:
+		return nearestCoefficients(ratio, a.NearestMode)
+	}
+}
+
+// nearestCoefficients returns the weights of the left and the right neighbour, of which
+// only the neighbour the position is rounded to has weight.
+func nearestCoefficients(ratio float64, mode NearestMode) []float64 {
+	var right bool
+
+	switch mode {
+	case NearestRoundPreferCeil:
+		right = ratio >= 0.5
+	case NearestFloor:
+		right = ratio == 1
+	case NearestCeil:
+		right = true
+	default:
+		right = ratio > 0.5
+	}
+
+	if right {
+		return []float64{0, 1}
+	}
+
+	return []float64{1, 0}
+}
+
+// cubicCoefficient returns the weight of a neighbour at the given distance for the cubic
+// convolution with the coefficient a.
+func cubicCoefficient(x, a float64) float64 {
+	x = math.Abs(x)
+
+	switch {
+	case x <= 1:
+		return ((a+2)*x-(a+3))*x*x + 1
+	case x < 2:
+		return ((a*x-5*a)*x+8*a)*x - 4*a
+	default:
+		return 0
+	}
+}
+
+// antialiasCoefficients returns the normalized weights of the neighbours for a filter with
+// the given support, which is stretched by the inverse of the scale when downsampling.
+func antialiasCoefficients(ratio, scale float64, support int, filter func(float64) float64) []float64 {
+	scale = min(scale, 1)
+	start := int(math.Floor(-float64(support)/scale)) + 1
+	coefficients := make([]float64, 2*(1-start))
+
+	for i := range coefficients {
+		coefficients[i] = filter(math.Abs((float64(start+i) - ratio) * scale))
+	}
+
+	normalize(coefficients)
+
+	return coefficients
+}
+
+// normalize divides the weights by their sum, such that they add up to 1.
+func normalize(weights []float64) {
+	var sum float64
+	for _, weight := range weights {
+		sum += weight
+	}
+
+	if sum == 0 {
+		return
+	}
+
+	for i := range weights {
+		weights[i] /= sum
+	}
+}
+
+// resizeAxis resizes a single axis of the data with the given shape, using the weights of
+// every position along the axis of the output.
+func resizeAxis(data []float64, shape []int, axis int, weights []resizeWeights, extrapolationValue float64) []float64 {
+	inSize := shape[axis]
+	inner := NElements(shape[axis+1:]...)
+	outer := len(data) / (inSize * inner)
+
+	out := make([]float64, 0, outer*len(weights)*inner)
+
+	for o := 0; o < outer; o++ {
+		block := data[o*inSize*inner : (o+1)*inSize*inner]
+
+		for _, weight := range weights {
+			for i := 0; i < inner; i++ {
+				if weight.extrapolate {
+					out = append(out, extrapolationValue)
+					continue
+				}
+
+				var value float64
+				for j, position := range weight.positions {
+					value += weight.weights[j] * block[position*inner+i]
+				}
+
+				out = append(out, value)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,185 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func resizeAttributesFixture(modify func(a *ResizeAttributes)) ResizeAttributes {
+	attributes := NewResizeAttributes()
+	modify(&attributes)
+
+	return attributes
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		backing       any
+		shape         []int
+		roi           []float64
+		scales        []float64
+		sizes         []int
+		attributes    ResizeAttributes
+		expected      any
+		expectedShape tensor.Shape
+	}{
+		{
+			[]float32{1, 2, 3, 4}, []int{2, 2}, nil, []float64{2, 3}, nil,
+			NewResizeAttributes(),
+			[]float32{1, 1, 1, 2, 2, 2, 1, 1, 1, 2, 2, 2, 3, 3, 3, 4, 4, 4, 3, 3, 3, 4, 4, 4},
+			tensor.Shape{4, 6},
+		},
+		{
+			[]int64{1, 2, 3, 4, 5, 6, 7, 8}, []int{2, 4}, nil, []float64{0.6, 0.6}, nil,
+			NewResizeAttributes(),
+			[]int64{1, 3},
+			tensor.Shape{1, 2},
+		},
+		{
+			[]float32{1, 2, 3, 4}, []int{2, 2}, nil, []float64{2, 2}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) { a.Mode = ResizeLinear }),
+			[]float32{1, 1.25, 1.75, 2, 1.5, 1.75, 2.25, 2.5, 2.5, 2.75, 3.25, 3.5, 3, 3.25, 3.75, 4},
+			tensor.Shape{4, 4},
+		},
+		{
+			[]float32{1, 2, 3, 4}, []int{2, 2}, nil, []float64{2, 2}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.CoordinateTransformation = TransformAlignCorners
+			}),
+			[]float32{
+				1, 1.3333333, 1.6666667, 2, 1.6666667, 2, 2.3333333, 2.6666667,
+				2.3333333, 2.6666667, 3, 3.3333333, 3, 3.3333333, 3.6666667, 4,
+			},
+			tensor.Shape{4, 4},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8}, []int{2, 4}, nil, []float64{0.6, 0.6}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) { a.Mode = ResizeLinear }),
+			[]float32{2.6666665, 4.3333331},
+			tensor.Shape{1, 2},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4}, nil, []float64{0.6, 0.6}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.Antialias = true
+			}),
+			[]float32{2.875, 4.5, 9.375, 11},
+			tensor.Shape{2, 2},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4}, nil, []float64{0.6, 0.6}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeCubic
+				a.Antialias = true
+			}),
+			[]float32{2.5180721, 4.2858863, 9.589329, 11.357142},
+			tensor.Shape{2, 2},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4}, nil, []float64{0.8, 0.8}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) { a.Mode = ResizeCubic }),
+			[]float32{1.4711914, 2.78125, 4.0825195, 6.7114258, 8.021484, 9.322754, 11.916504, 13.2265625, 14.527832},
+			tensor.Shape{3, 3},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4}, nil, []float64{0.8, 0.8}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeCubic
+				a.CubicCoeffA = -0.5
+				a.ExcludeOutside = true
+			}),
+			[]float32{1.3681267, 2.6695014, 4.0133367, 6.5736254, 7.875, 9.218835, 11.948967, 13.250341, 14.594177},
+			tensor.Shape{3, 3},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4},
+			[]float64{0.4, 0.6, 0.6, 0.8}, nil, []int{3, 3},
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.CoordinateTransformation = TransformTFCropAndResize
+			}),
+			[]float32{7.6, 7.9, 8.2, 8.8, 9.1, 9.4, 10, 10.3, 10.6},
+			tensor.Shape{3, 3},
+		},
+		{
+			[]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []int{4, 4},
+			[]float64{0.4, 0.6, 1.2, 1.7}, nil, []int{3, 3},
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.CoordinateTransformation = TransformTFCropAndResize
+				a.ExtrapolationValue = 10
+			}),
+			[]float32{7.6, 10, 10, 12.4, 10, 10, 10, 10, 10},
+			tensor.Shape{3, 3},
+		},
+		{
+			[]float32{1, 2, 3, 4}, []int{1, 2, 2}, nil, nil, []int{7, 8},
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Axes = []int{-1, 1}
+				a.KeepAspectRatioPolicy = AspectRatioNotLarger
+			}),
+			[]float32{
+				1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 2, 2, 2,
+				3, 3, 3, 3, 4, 4, 4, 3, 3, 3, 3, 4, 4, 4, 3, 3, 3, 3, 4, 4, 4,
+			},
+			tensor.Shape{1, 7, 7},
+		},
+		{
+			[]float32{1, 2, 3, 4}, []int{1, 4}, nil, []float64{1, 0.6}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.CoordinateTransformation = TransformHalfPixelSymmetric
+			}),
+			[]float32{1.6666666, 3.3333333},
+			tensor.Shape{1, 2},
+		},
+		{
+			[]float32{1, 2, 3, 4}, []int{2, 2}, nil, []float64{2.3, 2.94}, nil,
+			resizeAttributesFixture(func(a *ResizeAttributes) {
+				a.Mode = ResizeLinear
+				a.CoordinateTransformation = TransformHalfPixelSymmetric
+			}),
+			[]float32{
+				1, 1.159864, 1.5, 1.840136, 2, 1.5652174, 1.7250813, 2.0652174, 2.4053535, 2.5652174,
+				2.4347826, 2.5946466, 2.9347826, 3.2749187, 3.4347826, 3, 3.159864, 3.5, 3.840136, 4,
+			},
+			tensor.Shape{4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		input := TensorWithBackingFixture(test.backing, test.shape...)
+
+		out, err := Resize(input, test.roi, test.scales, test.sizes, test.attributes)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expectedShape, out.Shape())
+		assert.InDeltaSlice(t, test.expected, out.Data(), 0.00001)
+	}
+}
+
+func TestResizeErrors(t *testing.T) {
+	input := TensorWithBackingFixture([]float32{1, 2, 3, 4}, 2, 2)
+	attributes := NewResizeAttributes()
+
+	_, err := Resize(input, nil, []float64{2}, nil, attributes)
+	assert.Equal(t, ErrDimension("there should be a scale for every resized axis"), err)
+
+	_, err = Resize(input, nil, []float64{2, 2}, []int{4, 4}, attributes)
+	assert.Equal(t, ErrDimension("only one of the scales and the sizes should be given"), err)
+
+	_, err = Resize(input, nil, []float64{1, 0.4}, nil, attributes)
+	assert.Equal(t, ErrDimension("empty tensors are not supported"), err)
+
+	attributes.Axes = []int{1, -1}
+	_, err = Resize(input, nil, nil, []int{4, 4}, attributes)
+	assert.Equal(t, ErrDimension("the axes to resize should be unique"), err)
+
+	attributes.Axes = nil
+	attributes.CoordinateTransformation = TransformTFCropAndResize
+	_, err = Resize(input, []float64{0, 1}, nil, []int{4, 4}, attributes)
+	assert.Equal(t, ErrDimension("the roi should have twice the length of the resized axes"), err)
+}
@@ -0,0 +1,251 @@
+package ops
+
+import (
+	"fmt"
+
+	"gorgonia.org/tensor"
+)
+
+// ScatterReduction is the reduction the scatter operators use to combine the updates
+// with the values of the data at the updated positions.
+type ScatterReduction string
+
+const (
+	ScatterNone ScatterReduction = "none"
+	ScatterAdd  ScatterReduction = "add"
+	ScatterMul  ScatterReduction = "mul"
+	ScatterMax  ScatterReduction = "max"
+	ScatterMin  ScatterReduction = "min"
+)
+
+// ScatterND creates a copy of the data and replaces the slices of the data given by the
+// indices with the updates, using the reduction to combine the updates with the data.
+// The last dim of the indices holds the indices into the first dims of the data.
+func ScatterND(data, indices, updates tensor.Tensor, reduction ScatterReduction) (tensor.Tensor, error) {
+	indexList, err := AnyToIntSlice(IfScalarToSlice(indices.Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	dataShape := data.Shape()
+	indicesShape := indices.Shape()
+
+	k := indicesShape[len(indicesShape)-1]
+	if k < 1 || k > len(dataShape) {
+		return nil, ErrDimension("last dim of indices should be between 1 and the rank of the data")
+	}
+
+	expectedUpdates := append(indicesShape[:len(indicesShape)-1].Clone(), dataShape[k:]...)
+	if !expectedUpdates.Eq(updates.Shape()) {
+		return nil, ErrDimension(fmt.Sprintf("updates should have shape %v", expectedUpdates))
+	}
+
+	strides := dataShape.CalcStrides()
+	sliceSize := NElements(dataShape[k:]...)
+
+	offsets := make([]int, 0, len(indexList)/k)
+
+	for i := 0; i < len(indexList); i += k {
+		offset := 0
+
+		for j := 0; j < k; j++ {
+			index, err := scatterIndex(indexList[i+j], dataShape[j])
+			if err != nil {
+				return nil, err
+			}
+
+			offset += index * strides[j]
+		}
+
+		offsets = append(offsets, offset)
+	}
+
+	return scatter(data, updates, reduction, func(i int) int {
+		return offsets[i/sliceSize] + i%sliceSize
+	})
+}
+
+// ScatterElements creates a copy of the data and replaces the elements of the data given
+// by the indices with the updates, using the reduction to combine the updates with the data.
+// For every element of the indices, the position in the data is equal to the position of
+// the element in the indices, except for the given axis, which is the value of the element.
+// A negative axis counts from the last dim.
+func ScatterElements(
+	data, indices, updates tensor.Tensor, axis int, reduction ScatterReduction,
+) (tensor.Tensor, error) {
+	indexList, err := AnyToIntSlice(IfScalarToSlice(indices.Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	dataShape := data.Shape()
+	indicesShape := indices.Shape()
+
+	if !indicesShape.Eq(updates.Shape()) {
+		return nil, ErrDimension("indices and updates should have the same shape")
+	}
+
+	if len(indicesShape) != len(dataShape) {
+		return nil, ErrDimension("indices and data should have the same rank")
+	}
+
+	rank := len(dataShape)
+	if axis < -rank || axis >= rank {
+		return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+	}
+
+	axis = ConvertNegativeAxis(axis, rank)
+
+	dataStrides := dataShape.CalcStrides()
+	indicesStrides := indicesShape.CalcStrides()
+
+	offsets := make([]int, len(indexList))
+
+	for i, value := range indexList {
+		index, err := scatterIndex(value, dataShape[axis])
+		if err != nil {
+			return nil, err
+		}
+
+		remaining := i
+
+		for dim, stride := range indicesStrides {
+			position := remaining / stride
+			remaining %= stride
+
+			if dim == axis {
+				position = index
+			}
+
+			offsets[i] += position * dataStrides[dim]
+		}
+	}
+
+	return scatter(data, updates, reduction, func(i int) int {
+		return offsets[i]
+	})
+}
+
+// ValidateScatterInputs validates the inputs of a scatter operator, which are the data,
+// the indices and the updates. The updates must have the same dtype as the data.
+func ValidateScatterInputs(op Operator, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	inputs, err := ValidateInputs(op, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if inputs[0].Dtype() != inputs[2].Dtype() {
+		return nil, ErrInvalidTensor("DType of 'updates' does not match DType of 'data'", op)
+	}
+
+	return inputs, nil
+}
+
+// scatterIndex converts a negative index to a positive one and checks whether it is
+// within a dim of the given size.
+func scatterIndex(index, size int) (int, error) {
+	if index < 0 {
+		index += size
+	}
+
+	if index < 0 || index >= size {
+		return 0, fmt.Errorf("%w: index %d for dim of size %d", ErrScatterIndex, index, size)
+	}
+
+	return index, nil
+}
+
+// scatter copies the data and combines every element of the updates with the element of
+// the copy at the offset returned by the given function.
+func scatter(
+	data, updates tensor.Tensor, reduction ScatterReduction, offset func(int) int,
+) (tensor.Tensor, error) {
+	out, ok := data.Clone().(tensor.Tensor)
+	if !ok {
+		return nil, ErrTypeAssert("tensor.Tensor", data.Clone())
+	}
+
+	var err error
+
+	switch outData := IfScalarToSlice(out.Data()).(type) {
+	case []float32:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []float64:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []int8:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []int16:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []int32:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []int64:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []uint8:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []uint16:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []uint32:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []uint64:
+		err = scatterNumbers(outData, updates.Data(), reduction, offset)
+	case []bool:
+		err = scatterValues(outData, updates.Data(), reduction, offset)
+	case []string:
+		err = scatterValues(outData, updates.Data(), reduction, offset)
+	default:
+		return nil, ErrTypeAssert("list", out.Data())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func scatterNumbers[T Number](out []T, updates any, reduction ScatterReduction, offset func(int) int) error {
+	var combine func(a, b T) T
+
+	switch reduction {
+	case ScatterNone:
+		combine = func(_, b T) T { return b }
+	case ScatterAdd:
+		combine = func(a, b T) T { return a + b }
+	case ScatterMul:
+		combine = func(a, b T) T { return a * b }
+	case ScatterMax:
+		combine = func(a, b T) T { return max(a, b) }
+	case ScatterMin:
+		combine = func(a, b T) T { return min(a, b) }
+	default:
+		return ErrUnsupportedScatterReduction(reduction)
+	}
+
+	return scatterWith(out, updates, offset, combine)
+}
+
+func scatterValues[T bool | string](out []T, updates any, reduction ScatterReduction, offset func(int) int) error {
+	if reduction != ScatterNone {
+		return ErrUnsupportedScatterReduction(reduction)
+	}
+
+	return scatterWith(out, updates, offset, func(_, b T) T { return b })
+}
+
+func scatterWith[T any](out []T, updates any, offset func(int) int, combine func(a, b T) T) error {
+	updateList, ok := updates.([]T)
+	if !ok {
+		if update, ok := updates.(T); ok {
+			updateList = []T{update}
+		} else {
+			return ErrTypeAssert("list", updates)
+		}
+	}
+
+	for i, update := range updateList {
+		o := offset(i)
+		out[o] = combine(out[o], update)
+	}
+
+	return nil
+}
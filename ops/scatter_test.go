@@ -0,0 +1,160 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestScatterND(t *testing.T) {
+	tests := []struct {
+		data      tensor.Tensor
+		indices   tensor.Tensor
+		updates   tensor.Tensor
+		reduction ScatterReduction
+		expected  any
+		err       error
+	}{
+		{
+			TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6, 7, 8}, 8),
+			TensorWithBackingFixture([]int64{4, 3, 1, 7}, 4, 1),
+			TensorWithBackingFixture([]float32{9, 10, 11, 12}, 4),
+			ScatterNone,
+			[]float32{1, 11, 3, 10, 9, 6, 7, 12},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]int32{1, 2, 3, 4, 5, 6}, 3, 2),
+			TensorWithBackingFixture([]int64{0, -1}, 2, 1),
+			TensorWithBackingFixture([]int32{10, 20, 30, 40}, 2, 2),
+			ScatterAdd,
+			[]int32{11, 22, 3, 4, 35, 46},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]float64{1, 2, 3, 4}, 2, 2),
+			TensorWithBackingFixture([]int64{0, 1, 0, 1}, 2, 2),
+			TensorWithBackingFixture([]float64{3, 5}, 2),
+			ScatterMul,
+			[]float64{1, 30, 3, 4},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2, 3, 4}, 4),
+			TensorWithBackingFixture([]int64{0, 1}, 2, 1),
+			TensorWithBackingFixture([]float32{0, 5}, 2),
+			ScatterMax,
+			[]float32{1, 5, 3, 4},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]bool{true, true}, 2),
+			TensorWithBackingFixture([]int64{1}, 1, 1),
+			TensorWithBackingFixture([]bool{false}, 1),
+			ScatterAdd,
+			nil,
+			ErrUnsupportedScatterReduction(ScatterAdd),
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2}, 2),
+			TensorWithBackingFixture([]int64{2}, 1, 1),
+			TensorWithBackingFixture([]float32{0}, 1),
+			ScatterNone,
+			nil,
+			scatterIndexErrorFixture(2, 2),
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2}, 2),
+			TensorWithBackingFixture([]int64{0, 1}, 2, 1),
+			TensorWithBackingFixture([]float32{0, 1, 2}, 3),
+			ScatterNone,
+			nil,
+			ErrDimension("updates should have shape (2)"),
+		},
+	}
+
+	for _, test := range tests {
+		out, err := ScatterND(test.data, test.indices, test.updates, test.reduction)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, out.Data())
+			assert.Equal(t, test.data.Shape(), out.Shape())
+		}
+	}
+}
+
+func TestScatterElements(t *testing.T) {
+	tests := []struct {
+		data      tensor.Tensor
+		indices   tensor.Tensor
+		updates   tensor.Tensor
+		axis      int
+		reduction ScatterReduction
+		expected  any
+		err       error
+	}{
+		{
+			TensorWithBackingFixture([]float32{0, 0, 0, 0, 0, 0, 0, 0, 0}, 3, 3),
+			TensorWithBackingFixture([]int64{1, 0, 2, 0, 2, 1}, 2, 3),
+			TensorWithBackingFixture([]float32{1, 1.1, 1.2, 2, 2.1, 2.2}, 2, 3),
+			0,
+			ScatterNone,
+			[]float32{2, 1.1, 0, 1, 0, 2.2, 0, 2.1, 1.2},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2, 3, 4, 5}, 1, 5),
+			TensorWithBackingFixture([]int32{1, -2}, 1, 2),
+			TensorWithBackingFixture([]float32{1.1, 2.1}, 1, 2),
+			-1,
+			ScatterNone,
+			[]float32{1, 1.1, 3, 2.1, 5},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]int64{1, 2, 3, 4, 5}, 1, 5),
+			TensorWithBackingFixture([]int64{1, 1}, 1, 2),
+			TensorWithBackingFixture([]int64{10, 20}, 1, 2),
+			1,
+			ScatterAdd,
+			[]int64{1, 32, 3, 4, 5},
+			nil,
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2}, 1, 2),
+			TensorWithBackingFixture([]int64{0}, 1, 1),
+			TensorWithBackingFixture([]float32{0}, 1, 1),
+			2,
+			ScatterNone,
+			nil,
+			ErrAxisOutOfRange(-2, 1, 2),
+		},
+		{
+			TensorWithBackingFixture([]float32{1, 2}, 1, 2),
+			TensorWithBackingFixture([]int64{0, 1}, 1, 2),
+			TensorWithBackingFixture([]float32{0}, 1, 1),
+			1,
+			ScatterNone,
+			nil,
+			ErrDimension("indices and updates should have the same shape"),
+		},
+	}
+
+	for _, test := range tests {
+		out, err := ScatterElements(test.data, test.indices, test.updates, test.axis, test.reduction)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, test.expected, out.Data())
+			assert.Equal(t, test.data.Shape(), out.Shape())
+		}
+	}
+}
+
+func scatterIndexErrorFixture(index, size int) error {
+	_, err := scatterIndex(index, size)
+
+	return err
+}
@@ -0,0 +1,80 @@
+package ops
+
+import (
+	"fmt"
+
+	"gorgonia.org/tensor"
+)
+
+// Split splits the input along an axis into parts with the given sizes, which should add
+// up to the size of the axis. A negative axis counts from the last dim.
+func Split(input tensor.Tensor, axis int, sizes []int) ([]tensor.Tensor, error) {
+	shape := input.Shape()
+	rank := len(shape)
+
+	if axis < -rank || axis >= rank {
+		return nil, ErrAxisOutOfRange(-rank, rank-1, axis)
+	}
+
+	axis = ConvertNegativeAxis(axis, rank)
+
+	total := 0
+
+	for _, size := range sizes {
+		if size <= 0 {
+			return nil, ErrDimension("empty tensors are not supported")
+		}
+
+		total += size
+	}
+
+	if total != shape[axis] {
+		return nil, ErrDimension(fmt.Sprintf("split sizes should add up to %d", shape[axis]))
+	}
+
+	outputs := make([]tensor.Tensor, len(sizes))
+	slices := make([]tensor.Slice, rank)
+	start := 0
+
+	for i, size := range sizes {
+		slices[axis] = NewSlicer(start, start+size)
+
+		view, err := input.Slice(slices...)
+		if err != nil {
+			return nil, err
+		}
+
+		out, ok := view.Materialize().(tensor.Tensor)
+		if !ok {
+			return nil, ErrTypeAssert("tensor.Tensor", view.Materialize())
+		}
+
+		outShape := shape.Clone()
+		outShape[axis] = size
+
+		if err := out.Reshape(outShape...); err != nil {
+			return nil, err
+		}
+
+		outputs[i] = out
+		start += size
+	}
+
+	return outputs, nil
+}
+
+// EqualSplitSizes returns the sizes of the given number of parts in which an axis of the
+// given size is split. All parts have the same size, except for the last part, which is
+// smaller if the size is not divisible by the number of parts.
+func EqualSplitSizes(size, parts int) []int {
+	partSize := (size + parts - 1) / parts
+
+	sizes := make([]int, parts)
+	for i := range sizes {
+		sizes[i] = partSize
+	}
+
+	sizes[parts-1] = size - partSize*(parts-1)
+
+	return sizes
+}
@@ -0,0 +1,62 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		shape          []int
+		axis           int
+		sizes          []int
+		expected       [][]float32
+		expectedShapes []tensor.Shape
+	}{
+		{
+			[]int{6}, 0, []int{2, 4},
+			[][]float32{{1, 2}, {3, 4, 5, 6}},
+			[]tensor.Shape{{2}, {4}},
+		},
+		{
+			[]int{2, 3}, 1, []int{1, 1, 1},
+			[][]float32{{1, 4}, {2, 5}, {3, 6}},
+			[]tensor.Shape{{2, 1}, {2, 1}, {2, 1}},
+		},
+		{
+			[]int{2, 3}, -2, []int{1, 1},
+			[][]float32{{1, 2, 3}, {4, 5, 6}},
+			[]tensor.Shape{{1, 3}, {1, 3}},
+		},
+	}
+
+	for _, test := range tests {
+		input := TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, test.shape...)
+
+		outputs, err := Split(input, test.axis, test.sizes)
+		assert.Nil(t, err)
+
+		for i, out := range outputs {
+			assert.Equal(t, test.expectedShapes[i], out.Shape())
+			assert.Equal(t, test.expected[i], IfScalarToSlice(out.Data()))
+		}
+	}
+}
+
+func TestSplitErrors(t *testing.T) {
+	input := TensorWithBackingFixture([]float32{1, 2, 3, 4, 5, 6}, 2, 3)
+
+	_, err := Split(input, 2, []int{1, 1})
+	assert.Equal(t, ErrAxisOutOfRange(-2, 1, 2), err)
+
+	_, err = Split(input, 1, []int{1, 1})
+	assert.Equal(t, ErrDimension("split sizes should add up to 3"), err)
+}
+
+func TestEqualSplitSizes(t *testing.T) {
+	assert.Equal(t, []int{2, 2, 2}, EqualSplitSizes(6, 3))
+	assert.Equal(t, []int{3, 3, 1}, EqualSplitSizes(7, 3))
+	assert.Equal(t, []int{1}, EqualSplitSizes(1, 1))
+}
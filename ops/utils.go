@@ -162,6 +162,17 @@ func IfScalarToSlice(value any) any {
 	}
 }
 
+// ShallowCopy returns a tensor that shares its data with the given tensor, but has its
+// own shape and strides. This allows the copy to be reshaped or transposed without
+// changing the original tensor.
+func ShallowCopy(t tensor.Tensor) tensor.Tensor {
+	if dense, ok := t.(*tensor.Dense); ok {
+		return dense.ShallowClone()
+	}
+
+	return t
+}
+
 // Zeros fills a float32 slice with 0's.
 func Zeros(size int) []float32 {
 	res := make([]float32, size)
@@ -254,3 +265,17 @@ func ConvertNegativeAxis(axis, rank int) int {
 
 	return axis
 }
+
+// ScalarInt returns the value of a tensor holding a single integer.
+func ScalarInt(t tensor.Tensor) (int, error) {
+	values, err := AnyToIntSlice(IfScalarToSlice(t.Data()))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(values) != 1 {
+		return 0, ErrDimension("expected a tensor with a single value")
+	}
+
+	return values[0], nil
+}
@@ -270,6 +270,18 @@ func TestIfScalarToSlice(t *testing.T) {
 	}
 }
 
+func TestShallowCopy(t *testing.T) {
+	original := tensor.New(tensor.WithShape(2, 3), tensor.WithBacking([]float32{1, 2, 3, 4, 5, 6}))
+
+	copied := ShallowCopy(original)
+	err := copied.Reshape(3, 2)
+	assert.Nil(t, err)
+
+	assert.Equal(t, tensor.Shape{2, 3}, original.Shape())
+	assert.Equal(t, tensor.Shape{3, 2}, copied.Shape())
+	assert.Equal(t, original.Data(), copied.Data())
+}
+
 func TestZeros(t *testing.T) {
 	assert.Equal(t, []float32{0, 0}, Zeros(2))
 	assert.Equal(t, []float32{0, 0, 0, 0}, Zeros(4))
@@ -329,3 +341,16 @@ func TestPairwiseAssign(t *testing.T) {
 		}
 	}
 }
+
+func TestScalarInt(t *testing.T) {
+	value, err := ScalarInt(tensor.New(tensor.FromScalar(int64(3))))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, value)
+
+	value, err = ScalarInt(tensor.New(tensor.WithShape(1), tensor.WithBacking([]int32{-2})))
+	assert.Nil(t, err)
+	assert.Equal(t, -2, value)
+
+	_, err = ScalarInt(tensor.New(tensor.WithShape(2), tensor.WithBacking([]int64{1, 2})))
+	assert.Equal(t, ErrDimension("expected a tensor with a single value"), err)
+}
@@ -9,7 +9,7 @@ import (
 // Expects either 1 requirement ==> the expected number of inputs, or 2 requirements,
 // the minimum and the maximum number of inputs.
 func ValidateInputs(op Operator, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
-	padLength, err := checkNInputs(op, inputs)
+	padLength, err := checkNInputs(op, len(inputs))
 	if err != nil {
 		return inputs, err
 	}
@@ -24,8 +24,58 @@ func ValidateInputs(op Operator, inputs []tensor.Tensor) ([]tensor.Tensor, error
 	return inputs, nil
 }
 
-func checkNInputs(op Operator, inputs []tensor.Tensor) (int, error) {
-	nInputs := len(inputs)
+// ValidateValues validates a list of input values like ValidateInputs does for tensors. The
+// type constraints apply to the tensors of the values, like the tensors in a sequence.
+func ValidateValues(op Operator, inputs []Value) ([]Value, error) {
+	padLength, err := checkNInputs(op, len(inputs))
+	if err != nil {
+		return inputs, err
+	}
+
+	inputs = padInputs(inputs, padLength)
+
+	typeConstraints := op.GetInputTypeConstraints()
+
+	for i, input := range inputs {
+		// Like nil inputs, empty sequences and optionals have no types to check.
+		dtypes := valueDtypes(input)
+		if len(dtypes) == 0 {
+			continue
+		}
+
+		typeConstraint := newTypeConstraint(typeConstraints[i])
+
+		for _, dtype := range dtypes {
+			if _, ok := typeConstraint[dtype]; !ok {
+				return inputs, ErrInvalidInputType(i, dtype.Name(), op)
+			}
+		}
+	}
+
+	return inputs, nil
+}
+
+// valueDtypes returns the dtypes of the tensors in a value. Maps are not checked, hence
+// they have no dtypes.
+func valueDtypes(v Value) []tensor.Dtype {
+	switch value := v.(type) {
+	case tensor.Tensor:
+		return []tensor.Dtype{value.Dtype()}
+	case Sequence:
+		var dtypes []tensor.Dtype
+		for _, element := range value {
+			dtypes = append(dtypes, valueDtypes(element)...)
+		}
+
+		return dtypes
+	case Optional:
+		return valueDtypes(value.Element)
+	default:
+		return nil
+	}
+}
+
+func checkNInputs(op Operator, nInputs int) (int, error) {
 	padLength := 0
 
 	min := op.GetMinInputs()
@@ -49,9 +99,11 @@ func checkNInputs(op Operator, inputs []tensor.Tensor) (int, error) {
 }
 
 // padInputs pads a list of input nodes to the given length with nils.
-func padInputs(inputs []tensor.Tensor, length int) []tensor.Tensor {
+func padInputs[T any](inputs []T, length int) []T {
+	var empty T
+
 	for len(inputs) < length {
-		inputs = append(inputs, nil)
+		inputs = append(inputs, empty)
 	}
 
 	return inputs
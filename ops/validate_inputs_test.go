@@ -121,6 +121,36 @@ func TestValidateInputs(t *testing.T) {
 	}
 }
 
+func TestValidateValues(t *testing.T) {
+	op := &MockOp{
+		minInputs:            1,
+		maxInputs:            2,
+		inputTypeConstraints: [][]tensor.Dtype{{tensor.Float32}, {tensor.Int64}},
+	}
+
+	tests := []struct {
+		inputs []Value
+		err    error
+	}{
+		{[]Value{Float32TensorFixture(2)}, nil},
+		{[]Value{Sequence{Float32TensorFixture(2)}, Optional{}}, nil},
+		{[]Value{Optional{Element: Float32TensorFixture(2)}, Sequence{}}, nil},
+		{[]Value{Map{"a": Float32TensorFixture(2)}}, nil},
+		{[]Value{}, ErrInvalidOptionalInputCount(0, op)},
+		{[]Value{Sequence{Float32TensorFixture(2), TensorWithBackingFixture([]int64{1}, 1)}}, ErrInvalidInputType(0, "int64", op)},
+		{[]Value{nil, Optional{Element: Float32TensorFixture(2)}}, ErrInvalidInputType(1, "float32", op)},
+	}
+
+	for _, test := range tests {
+		inputs, err := ValidateValues(op, test.inputs)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			assert.Equal(t, 2, len(inputs))
+		}
+	}
+}
+
 func TestPadInputs(t *testing.T) {
 	nodes := PaddedInputsFixture(2, 0)
 
@@ -0,0 +1,120 @@
+package ops
+
+import "gorgonia.org/tensor"
+
+// Value is a value flowing through the graph of a model. Besides a tensor.Tensor, a value
+// is either a Sequence, a Map or an Optional, which are the other types of values ONNX
+// knows.
+type Value any
+
+// Sequence is an ordered list of values which all have the same type, like a list of
+// tensors with the same dtype.
+type Sequence []Value
+
+// Map maps keys to values of the same type. The keys are either all int64 or all string.
+type Map map[any]Value
+
+// Optional is a value which either holds an element, or is empty when the element is nil.
+type Optional struct {
+	Element Value
+}
+
+// ShallowCopyValue returns a copy of the value in which every tensor is a shallow copy of
+// the original tensor, like ShallowCopy does for a single tensor.
+func ShallowCopyValue(v Value) Value {
+	switch value := v.(type) {
+	case tensor.Tensor:
+		return ShallowCopy(value)
+	case Sequence:
+		res := make(Sequence, len(value))
+		for i, element := range value {
+			res[i] = ShallowCopyValue(element)
+		}
+
+		return res
+	case Map:
+		res := make(Map, len(value))
+		for key, element := range value {
+			res[key] = ShallowCopyValue(element)
+		}
+
+		return res
+	case Optional:
+		return Optional{Element: ShallowCopyValue(value.Element)}
+	default:
+		return v
+	}
+}
+
+// ValueBytes returns the number of bytes held by the tensors of the value.
+func ValueBytes(v Value) int64 {
+	var res int64
+
+	switch value := v.(type) {
+	case tensor.Tensor:
+		res = int64(value.MemSize())
+	case Sequence:
+		for _, element := range value {
+			res += ValueBytes(element)
+		}
+	case Map:
+		for _, element := range value {
+			res += ValueBytes(element)
+		}
+	case Optional:
+		res = ValueBytes(value.Element)
+	}
+
+	return res
+}
+
+// ApplyTensors applies a value operator on tensors. This only succeeds when all outputs
+// of the operator are tensors as well, otherwise the operator has to be applied using
+// ApplyValues.
+func ApplyTensors(op ValueOperator, inputs []tensor.Tensor) ([]tensor.Tensor, error) {
+	values := make([]Value, len(inputs))
+	for i, input := range inputs {
+		if input != nil {
+			values[i] = input
+		}
+	}
+
+	outputs, err := op.ApplyValues(values)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]tensor.Tensor, len(outputs))
+
+	for i, output := range outputs {
+		t, ok := output.(tensor.Tensor)
+		if !ok {
+			return nil, ErrTypeAssert("tensor.Tensor", output)
+		}
+
+		res[i] = t
+	}
+
+	return res, nil
+}
+
+// SequenceTensors returns the tensors of a value, which must be a sequence of tensors.
+func SequenceTensors(v Value) ([]tensor.Tensor, error) {
+	sequence, ok := v.(Sequence)
+	if !ok {
+		return nil, ErrTypeAssert("ops.Sequence", v)
+	}
+
+	tensors := make([]tensor.Tensor, len(sequence))
+
+	for i, element := range sequence {
+		t, ok := element.(tensor.Tensor)
+		if !ok {
+			return nil, ErrTypeAssert("tensor.Tensor", element)
+		}
+
+		tensors[i] = t
+	}
+
+	return tensors, nil
+}
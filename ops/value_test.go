@@ -0,0 +1,58 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
+)
+
+func TestShallowCopyValue(t *testing.T) {
+	a := Float32TensorFixture(2, 2)
+	value := Sequence{a, Map{"b": Optional{Element: a}}, Optional{}}
+
+	copied := ShallowCopyValue(value)
+	assert.Equal(t, value, copied)
+
+	sequence := copied.(Sequence)
+	assert.NotSame(t, a, sequence[0])
+	assert.NotSame(t, a, sequence[1].(Map)["b"].(Optional).Element)
+
+	// Reshaping the copy leaves the original untouched.
+	assert.Nil(t, sequence[0].(tensor.Tensor).Reshape(4))
+	assert.Equal(t, tensor.Shape{2, 2}, a.Shape())
+}
+
+func TestValueBytes(t *testing.T) {
+	a := Float32TensorFixture(2, 2)
+
+	tests := []struct {
+		value    Value
+		expected int64
+	}{
+		{nil, 0},
+		{a, 16},
+		{Sequence{a, a}, 32},
+		{Map{int64(1): a}, 16},
+		{Optional{Element: Sequence{a}}, 16},
+		{Optional{}, 0},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, ValueBytes(test.value))
+	}
+}
+
+func TestSequenceTensors(t *testing.T) {
+	a := Float32TensorFixture(2)
+
+	tensors, err := SequenceTensors(Sequence{a, a})
+	assert.Nil(t, err)
+	assert.Equal(t, []tensor.Tensor{a, a}, tensors)
+
+	_, err = SequenceTensors(a)
+	assert.Equal(t, ErrTypeAssert("ops.Sequence", a), err)
+
+	_, err = SequenceTensors(Sequence{Optional{}})
+	assert.Equal(t, ErrTypeAssert("tensor.Tensor", Optional{}), err)
+}
@@ -9,8 +9,19 @@ import (
 	"testing"
 
 	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/legacy"
 	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+	"github.com/advancedclimatesystems/gonnx/ops/opset15"
+	"github.com/advancedclimatesystems/gonnx/ops/opset16"
+	"github.com/advancedclimatesystems/gonnx/ops/opset17"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"github.com/advancedclimatesystems/gonnx/ops/opset20"
+	"github.com/advancedclimatesystems/gonnx/ops/opset21"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"gorgonia.org/tensor"
 )
@@ -24,137 +35,141 @@ import (
 // Another reason is that some tests require an opset version higher than we have currently
 // implemented, or lower, which we also haven't implemented yet.
 var ignoredTests = []string{
-	"test_add_uint8",                                 // Opset14
-	"test_div_uint8",                                 // Opset14
-	"test_gru_batchwise",                             // Opset14
-	"test_logsoftmax_axis_1_expanded_ver18",          // Opset18
-	"test_logsoftmax_example_1_expanded_ver18",       // Opset18
-	"test_logsoftmax_negative_axis_expanded_ver18",   // Opset18
-	"test_logsoftmax_large_number_expanded_ver18",    // Opset18
-	"test_logsoftmax_default_axis_expanded_ver18",    // Opset18
-	"test_logsoftmax_axis_0_expanded_ver18",          // Opset18
-	"test_logsoftmax_axis_2_expanded_ver18",          // Opset18
-	"test_lstm_batchwise",                            // Opset14
-	"test_mul_uint8",                                 // Opset14
-	"test_reduce_max_do_not_keepdims_random",         // Opset18
-	"test_reduce_max_keepdims_random",                // Opset18
-	"test_reduce_max_default_axes_keepdims_random",   // Opset18
-	"test_reduce_max_do_not_keepdims_example",        // Opset18
-	"test_reduce_max_default_axes_keepdim_example",   // Opset18
-	"test_reduce_max_negative_axes_keepdims_random",  // Opset18
-	"test_reduce_max_negative_axes_keepdims_example", // Opset18
-	"test_reduce_max_bool_inputs",                    // Opset20
-	"test_reduce_max_keepdims_example",               // Opset18
-	"test_reduce_min_keepdims_random",                // Opset18
-	"test_reduce_min_keepdims_example",               // Opset18
-	"test_reduce_min_do_not_keepdims_example",        // Opset18
-	"test_reduce_min_negative_axes_keepdims_example", // Opset18
-	"test_reduce_min_bool_inputs",                    // Opset18
-	"test_reduce_min_do_not_keepdims_random",         // Opset18
-	"test_reduce_min_default_axes_keepdims_example",  // Opset18
-	"test_reduce_min_empty_set",                      // Opset18
-	"test_reduce_min_default_axes_keepdims_random",   // Opset18
-	"test_reduce_min_negative_axes_keepdims_random",  // Opset18
-	"test_sub_uint8",                                 // Opset14
-	"test_shape_clip_end",                            // Opset15
-	"test_shape_clip_start",                          // Opset15
-	"test_shape_end_1",                               // Opset15
-	"test_shape_end_negative_1",                      // Opset15
-	"test_shape_example",                             // Opset15
-	"test_shape_start_1",                             // Opset15
-	"test_shape_start_1_end_2",                       // Opset15
-	"test_shape_start_1_end_negative_1",              // Opset15
-	"test_shape_start_negative_1",                    // Opset15
-	"test_softmax_default_axis_expanded_ver18",       // Opset18
-	"test_softmax_axis_1_expanded_ver18",             // Opset18
-	"test_softmax_negative_axis_expanded_ver18",      // Opset18
-	"test_softmax_example_expanded_ver18",            // Opset18
-	"test_softmax_axis_0_expanded_ver18",             // Opset18
-	"test_softmax_large_number_expanded_ver18",       // Opset18
-	"test_softmax_axis_2_expanded_ver18",             // Opset18
-	"test_reshape_allowzero_reordered",               // Opset14
-
-	"test_constant_pad",                      // Pad is not implemented yet.
-	"test_constant_pad_axes",                 // Pad is not implemented yet.
-	"test_gemm_alpha",                        // For gemm in opset 11.
-	"test_gemm_default_no_bias",              // For gemm in opset 11.
-	"test_gemm_default_scalar_bias",          // For gemm in opset 11.
-	"test_logsoftmax_large_number_expanded",  // Requires 'Exp' operator.
-	"test_logsoftmax_axis_0_expanded",        // Requires 'Exp' operator.
-	"test_logsoftmax_axis_1_expanded",        // Requires 'Exp' operator.
-	"test_logsoftmax_axis_2_expanded",        // Requires 'Exp' operator.
-	"test_logsoftmax_example_1_expanded",     // Requires 'Exp' operator.
-	"test_logsoftmax_default_axis_expanded",  // Requires 'Exp' operator.
-	"test_logsoftmax_negative_axis_expanded", // Requires 'Exp' operator.
-	"test_lstm_with_peepholes",               // Sequence lens attribute is not supported yet.
-	"test_relu_expanded_ver18",               // CastLike operator not implemented yet.
-	"test_softmax_axis_0_expanded",           // Requires 'Exp' operator.
-	"test_softmax_negative_axis_expanded",    // Requires 'Exp' operator.
-	"test_softmax_large_number_expanded",     // Requires 'Exp' operator.
-	"test_softmax_axis_1_expanded",           // Requires 'Exp' operator.
-	"test_softmax_example_expanded",          // Requires 'Exp' operator.
-	"test_softmax_axis_2_expanded",           // Requires 'Exp' operator.
-	"test_softmax_default_axis_expanded",     // Requires 'Exp' operator.
-	"test_slice_start_out_of_bounds",         // ONNX expects nil output, but we throw an error.
-	"test_slice_end_out_of_bounds",           // ONNX expects nil output, but we throw an error.
-	"test_slice_neg_steps",                   // ONNX expects nil output, but we throw an error.
-	"test_slice_neg",                         // ONNX expects nil output, but we throw an error.
-	"test_transpose_default",                 // For transpose in opset 9.
-
-	"test_equal_string",                               // Unsupported datatype String.
-	"test_equal_string_broadcast",                     // Unsupported datatype String.
-	"test_cast_INT4_to_INT8",                          // Unsupported datatype INT4.
-	"test_cast_INT4_to_FLOAT",                         // Unsupported datatype INT4.
-	"test_cast_FLOAT_to_INT4",                         // Unsupported datatype INT4.
-	"test_cast_FLOAT_to_UINT4",                        // Unsupported datatype UINT4.
-	"test_cast_INT4_to_FLOAT16",                       // Unsupported datatype INT4/FLOAT16.
-	"test_cast_FLOAT16_to_UINT4",                      // Unsupported datatype FLOAT16.
-	"test_cast_FLOAT16_to_INT4",                       // Unsupported datatype FLOAT16.
-	"test_cast_UINT4_to_UINT8",                        // Unsupported datatype UINT4.
-	"test_cast_UINT4_to_FLOAT",                        // Unsupported datatype UINT4.
-	"test_cast_UINT4_to_FLOAT16",                      // Unsupported datatype UINT4.
-	"test_cast_FLOAT_to_STRING",                       // Unsupported datatype STRING.
-	"test_cast_STRING_to_FLOAT",                       // Unsupported datatype STRING.
-	"test_cast_DOUBLE_to_FLOAT16",                     // Unsupported datatype FLOAT16.
-	"test_cast_FLOAT_to_FLOAT16",                      // Unsupported datatype FLOAT16.
-	"test_cast_FLOAT16_to_DOUBLE",                     // Unsupported datatype FLOAT16.
-	"test_cast_FLOAT16_to_FLOAT",                      // Unsupported datatype FLOAT16.
-	"test_cast_BFLOAT16_to_FLOAT",                     // Unsupported datatype BFLOAT16.
-	"test_cast_FLOAT_to_BFLOAT16",                     // Unsupported datatype BFLOAT16.
-	"test_cast_FLOAT_to_FLOAT8E5M2",                   // Unsupported datatype.
-	"test_cast_FLOAT_to_FLOAT8E4M3FN",                 // Unsupported datatype.
+	"test_reduce_max_empty_set",                                          // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_default_axes_keepdims_example",                      // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_default_axes_keepdims_random",                       // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_empty_axes_input_noop_example",                      // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_empty_axes_input_noop_random",                       // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_empty_set",                                          // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_empty_set_non_reduced_axis_zero",                    // Empty tensors are not supported in gorgonia
+	"test_reduce_min_empty_set",                                          // Empty tensors are not supported in gorgonia
+	"test_reduce_prod_empty_set",                                         // Empty tensors are not supported in gorgonia
+	"test_reduce_l1_empty_set",                                           // Empty tensors are not supported in gorgonia
+	"test_reduce_l1_empty_set_expanded",                                  // Empty tensors are not supported in gorgonia
+	"test_reduce_l2_empty_set",                                           // Empty tensors are not supported in gorgonia
+	"test_reduce_l2_empty_set_expanded",                                  // Empty tensors are not supported in gorgonia
+	"test_reduce_log_sum_empty_set",                                      // Empty tensors are not supported in gorgonia
+	"test_reduce_log_sum_empty_set_expanded",                             // Empty tensors are not supported in gorgonia
+	"test_reduce_log_sum_exp_empty_set",                                  // Empty tensors are not supported in gorgonia
+	"test_reduce_log_sum_exp_empty_set_expanded",                         // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_square_empty_set",                                   // Empty tensors are not supported in gorgonia
+	"test_reduce_sum_square_empty_set_expanded",                          // Empty tensors are not supported in gorgonia
+	"test_split_zero_size_splits_opset13",                                // Empty tensors are not supported in gorgonia
+	"test_split_zero_size_splits_opset18",                                // Empty tensors are not supported in gorgonia
+	"test_reduce_l2_do_not_keepdims_example_expanded",                    // Requires 'Sqrt' operator.
+	"test_reduce_l2_do_not_keepdims_random_expanded",                     // Requires 'Sqrt' operator.
+	"test_reduce_l2_keep_dims_example_expanded",                          // Requires 'Sqrt' operator.
+	"test_reduce_l2_keep_dims_random_expanded",                           // Requires 'Sqrt' operator.
+	"test_reduce_l2_default_axes_keepdims_example_expanded",              // Requires 'Sqrt' operator.
+	"test_reduce_l2_default_axes_keepdims_random_expanded",               // Requires 'Sqrt' operator.
+	"test_reduce_l2_negative_axes_keep_dims_example_expanded",            // Requires 'Sqrt' operator.
+	"test_reduce_l2_negative_axes_keep_dims_random_expanded",             // Requires 'Sqrt' operator.
+	"test_layer_normalization_2d_axis0_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis0_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis1_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis1_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis_negative_1_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis_negative_1_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis_negative_2_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_2d_axis_negative_2_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis0_epsilon_expanded",                 // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis0_epsilon_expanded_ver18",           // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis1_epsilon_expanded",                 // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis1_epsilon_expanded_ver18",           // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis2_epsilon_expanded",                 // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis2_epsilon_expanded_ver18",           // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_1_epsilon_expanded",       // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_1_epsilon_expanded_ver18", // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_2_epsilon_expanded",       // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_2_epsilon_expanded_ver18", // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_3_epsilon_expanded",       // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_3d_axis_negative_3_epsilon_expanded_ver18", // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis0_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis0_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis1_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis1_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis2_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis2_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis3_expanded",                         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis3_expanded_ver18",                   // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_1_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_1_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_2_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_2_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_3_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_3_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_4_expanded",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_4d_axis_negative_4_expanded_ver18",         // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_default_axis_expanded",                     // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_layer_normalization_default_axis_expanded_ver18",               // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_blackmanwindow_expanded",                                       // Requires 'Range' operator.
+	"test_blackmanwindow_symmetric_expanded",                             // Requires 'Range' operator.
+	"test_hammingwindow_expanded",                                        // Requires 'Range' operator.
+	"test_hammingwindow_symmetric_expanded",                              // Requires 'Range' operator.
+	"test_hannwindow_expanded",                                           // Requires 'Range' operator.
+	"test_hannwindow_symmetric_expanded",                                 // Requires 'Range' operator.
+	"test_affine_grid_2d_expanded",                                       // Requires 'Range' operator.
+	"test_affine_grid_2d_align_corners_expanded",                         // Requires 'Range' operator.
+	"test_affine_grid_3d_expanded",                                       // Requires 'Range' operator.
+	"test_affine_grid_3d_align_corners_expanded",                         // Requires 'Range' operator.
+	"test_gelu_default_1_expanded",                                       // Requires 'Sqrt' and 'Erf' operators.
+	"test_gelu_default_2_expanded",                                       // Requires 'Sqrt' and 'Erf' operators.
+	"test_gelu_tanh_1_expanded",                                          // Requires 'Sqrt' operator.
+	"test_gelu_tanh_2_expanded",                                          // Requires 'Sqrt' operator.
+	"test_group_normalization_epsilon_expanded",                          // Requires 'Sqrt' and 'Reciprocal' operators.
+	"test_group_normalization_example_expanded",                          // Requires 'Sqrt' and 'Reciprocal' operators.
+
+	"test_lstm_with_peepholes",       // Sequence lens attribute is not supported yet.
+	"test_relu_expanded_ver18",       // Requires 'Max' operator.
+	"test_slice_start_out_of_bounds", // ONNX expects nil output, but we throw an error.
+	"test_slice_end_out_of_bounds",   // ONNX expects nil output, but we throw an error.
+	"test_slice_neg_steps",           // ONNX expects nil output, but we throw an error.
+	"test_slice_neg",                 // ONNX expects nil output, but we throw an error.
+
+	"test_regex_full_match_empty",                     // Empty tensors are not supported in gorgonia
+	"test_string_split_empty_tensor",                  // Empty tensors are not supported in gorgonia
 	"test_cast_FLOAT_to_FLOAT8E4M3FNUZ",               // Unsupported datatype FLOAT8E4M3FNUZ.
 	"test_cast_FLOAT_to_FLOAT8E5M2FNUZ",               // Unsupported datatype.
-	"test_cast_FLOAT16_to_FLOAT8E5M2",                 // Unsupported datatype.
-	"test_cast_FLOAT16_to_FLOAT8E4M3FN",               // Unsupported datatype.
 	"test_cast_FLOAT16_to_FLOAT8E4M3FNUZ",             // Unsupported datatype.
 	"test_cast_FLOAT16_to_FLOAT8E5M2FNUZ",             // Unsupported datatype.
-	"test_cast_FLOAT8E5M2_to_FLOAT",                   // Unsupported datatype.
-	"test_cast_FLOAT8E5M2_to_FLOAT16",                 // Unsupported datatype.
-	"test_cast_FLOAT8E4M3FN_to_FLOAT",                 // Unsupported datatype.
-	"test_cast_FLOAT8E4M3FN_to_FLOAT16",               // Unsupported datatype.
 	"test_cast_FLOAT8E4M3FNUZ_to_FLOAT",               // Unsupported datatype.
 	"test_cast_FLOAT8E4M3FNUZ_to_FLOAT16",             // Unsupported datatype.
 	"test_cast_FLOAT8E5M2FNUZ_to_FLOAT",               // Unsupported datatype.
 	"test_cast_FLOAT8E5M2FNUZ_to_FLOAT16",             // Unsupported datatype.
-	"test_cast_no_saturate_FLOAT_to_FLOAT8E5M2",       // Unsupported datatype FLOAT8E5M2.
 	"test_cast_no_saturate_FLOAT_to_FLOAT8E4M3FNUZ",   // Unsupported datatype.
 	"test_cast_no_saturate_FLOAT_to_FLOAT8E5M2FNUZ",   // Unsupported datatype.
-	"test_cast_no_saturate_FLOAT_to_FLOAT8E4M3FN",     // Unsupported datatype.
 	"test_cast_no_saturate_FLOAT16_to_FLOAT8E4M3FNUZ", // Unsupported datatype.
 	"test_cast_no_saturate_FLOAT16_to_FLOAT8E5M2FNUZ", // Unsupported datatype.
-	"test_cast_no_saturate_FLOAT16_to_FLOAT8E4M3FN",   // Unsupported datatype.
-	"test_cast_no_saturate_FLOAT16_to_FLOAT8E5M2",     // Unsupported datatype.
 
-	"test_unsqueeze_axis_3",                 // Tests an old version of Unsqueeze (<= 11)
 	"test_constantofshape_int_shape_zero",   // Empty tensors are not supported in gorgonia
+	"test_reshape_allowzero_reordered",      // Empty tensors are not supported in gorgonia
+	"test_tril_zero",                        // Empty tensors are not supported in gorgonia
+	"test_triu_zero",                        // Empty tensors are not supported in gorgonia
 	"test_gather_elements_0",                // Operator GatherElements is not implemented
 	"test_gather_elements_1",                // Operator GatherElements is not implemented
 	"test_gather_elements_negative_indices", // Operator GatherElements is not implemented
 
-	"test_prelu_broadcast_expanded",   // Unsupported operator CastLike
-	"test_prelu_example_expanded",     // Unsupported operator CastLike
-	"test_constant_pad_negative_axes", // Unsupported operator Pad
+	"test_castlike_FLOAT_to_FLOAT8E4M3FNUZ",          // Unsupported datatype.
+	"test_castlike_FLOAT_to_FLOAT8E4M3FNUZ_expanded", // Unsupported datatype.
+	"test_castlike_FLOAT8E4M3FNUZ_to_FLOAT",          // Unsupported datatype.
+	"test_castlike_FLOAT8E4M3FNUZ_to_FLOAT_expanded", // Unsupported datatype.
+	"test_castlike_FLOAT_to_FLOAT8E5M2FNUZ",          // Unsupported datatype.
+	"test_castlike_FLOAT_to_FLOAT8E5M2FNUZ_expanded", // Unsupported datatype.
+	"test_castlike_FLOAT8E5M2FNUZ_to_FLOAT",          // Unsupported datatype.
+	"test_castlike_FLOAT8E5M2FNUZ_to_FLOAT_expanded", // Unsupported datatype.
+	"test_bernoulli",                                      // Output is random.
+	"test_bernoulli_double",                               // Output is random.
+	"test_bernoulli_double_expanded",                      // Output is random.
+	"test_bernoulli_expanded",                             // Output is random.
+	"test_bernoulli_seed",                                 // Output is random.
+	"test_bernoulli_seed_expanded",                        // Output is random.
+	"test_gridsample_volumetric_bilinear_align_corners_0", // Only 4D inputs are supported.
+	"test_gridsample_volumetric_bilinear_align_corners_1", // Only 4D inputs are supported.
+	"test_gridsample_volumetric_nearest_align_corners_0",  // Only 4D inputs are supported.
+	"test_gridsample_volumetric_nearest_align_corners_1",  // Only 4D inputs are supported.
+
+	"test_batchnorm_epsilon_training_mode", // Training mode is not supported
+	"test_batchnorm_example_training_mode", // Training mode is not supported
 
 	"test_argmax_keepdims_random_select_last_index",                // Unsupported attribute
 	"test_argmax_keepdims_example_select_last_index",               // Unsupported attribute
@@ -169,32 +184,35 @@ var ignoredTests = []string{
 type ONNXTestCase struct {
 	name    string
 	model   *Model
-	inputs  Tensors
-	outputs Tensors
+	inputs  Values
+	outputs Values
 }
 
 func TestOps(t *testing.T) {
 	runnedTests := []string{}
-	opNames := opset13.GetOpNames()
+	opNames := getTestOpNames()
+
+	// The filter of an operator can match the tests of another operator, e.g. the filter of
+	// ReduceSum matches the tests of ReduceSumSquare, so every test is run only once.
+	seen := make(map[string]bool)
 
 	for _, opName := range opNames {
 		tests, err := getTestCasesForOp(opName)
 		assert.Nil(t, err)
 
 		for _, test := range tests {
+			if seen[test.name] {
+				continue
+			}
+
+			seen[test.name] = true
+
 			t.Run(test.name, func(t *testing.T) {
-				outputs, err := test.model.Run(test.inputs)
+				outputs, err := test.model.RunValues(test.inputs)
 				assert.Nil(t, err)
 
 				for outputName := range test.outputs {
-					expectedTensor := test.outputs[outputName]
-					actualTensor := outputs[outputName]
-
-					if expectedTensor.Dtype() == tensor.Bool {
-						assert.ElementsMatch(t, expectedTensor.Data(), actualTensor.Data())
-					} else {
-						assert.InDeltaSlice(t, expectedTensor.Data(), actualTensor.Data(), 0.00001)
-					}
+					assertValue(t, test.outputs[outputName], outputs[outputName])
 				}
 			})
 
@@ -208,16 +226,61 @@ func TestOps(t *testing.T) {
 	assert.Equal(t, expectedTests, runnedTests)
 }
 
+// getTestOpNames returns the names of the operators of all implemented opsets. Operators
+// which are implemented in multiple opsets are only returned once.
+func getTestOpNames() []string {
+	var opNames []string
+
+	seen := make(map[string]bool)
+
+	for _, names := range [][]string{
+		legacy.GetOpNames(),
+		opset13.GetOpNames(),
+		opset14.GetOpNames(),
+		opset15.GetOpNames(),
+		opset16.GetOpNames(),
+		opset17.GetOpNames(),
+		opset18.GetOpNames(),
+		opset19.GetOpNames(),
+		opset20.GetOpNames(),
+		opset21.GetOpNames(),
+	} {
+		for _, opName := range names {
+			if !seen[opName] {
+				seen[opName] = true
+				opNames = append(opNames, opName)
+			}
+		}
+	}
+
+	return opNames
+}
+
 func getTestCasesForOp(opName string) ([]*ONNXTestCase, error) {
 	testOpName := strings.ToLower(opName)
+	testOpNames := []string{testOpName}
 	// Because the naming of the ONNX test cases are not fully consistent, we need
-	// to map some operator names to insert some '_' in the filter.
-	if mappedFilter, ok := opNameMap[testOpName]; ok {
-		testOpName = mappedFilter
+	// to map some operator names to insert some '_' in the filter, or to the names
+	// used by the test cases of the operator.
+	if mappedFilters, ok := opNameMap[testOpName]; ok {
+		testOpNames = mappedFilters
+	}
+
+	var tests []*ONNXTestCase
+
+	for _, name := range testOpNames {
+		filterTests, err := getTestCasesForFilter(fmt.Sprintf("test_%v", name))
+		if err != nil {
+			return nil, err
+		}
+
+		tests = append(tests, filterTests...)
 	}
 
-	opFilter := fmt.Sprintf("test_%v", testOpName)
+	return tests, nil
+}
 
+func getTestCasesForFilter(opFilter string) ([]*ONNXTestCase, error) {
 	testDir, err := os.Open("./test_data")
 	if err != nil {
 		return nil, err
@@ -272,12 +335,12 @@ func getTestCase(folder string) (*ONNXTestCase, error) {
 
 	basePath := fmt.Sprintf("%v/test_data_set_0", folder)
 
-	inputs, err := readTestTensors(basePath, "input", model.mp.Graph.GetInput())
+	inputs, err := readTestValues(basePath, "input", model.mp.Graph.GetInput())
 	if err != nil {
 		return nil, err
 	}
 
-	outputs, err := readTestTensors(basePath, "output", model.mp.Graph.GetOutput())
+	outputs, err := readTestValues(basePath, "output", model.mp.Graph.GetOutput())
 	if err != nil {
 		return nil, err
 	}
@@ -305,9 +368,9 @@ func readTestModel(folder string) (*Model, error) {
 		return nil, err
 	}
 
-	// Currently we only implemented Opset13, hence we enforce this in our tests. All
+	// Tests of opsets newer than we support are run with the newest supported opset. All
 	// tests that fail because of this are ignored.
-	mp.OpsetImport[0].Version = 13
+	mp.OpsetImport[0].Version = min(mp.OpsetImport[0].GetVersion(), MaxOpsetVersion)
 
 	model, err := NewModel(mp)
 	if err != nil {
@@ -317,8 +380,11 @@ func readTestModel(folder string) (*Model, error) {
 	return model, nil
 }
 
-func readTestTensors(basePath, baseFile string, inputs []*onnx.ValueInfoProto) (Tensors, error) {
-	tensors := make(Tensors)
+// readTestValues reads the values of the inputs or the outputs of a test. Tensors are stored
+// as a TensorProto, the other values as a SequenceProto, MapProto or OptionalProto, which
+// follows from the type of the input or output in the graph.
+func readTestValues(basePath, baseFile string, inputs []*onnx.ValueInfoProto) (Values, error) {
+	values := make(Values)
 
 	for i := 0; i < len(inputs); i++ {
 		filePath := fmt.Sprintf("%v/%v_%d.pb", basePath, baseFile, i)
@@ -333,20 +399,272 @@ func readTestTensors(basePath, baseFile string, inputs []*onnx.ValueInfoProto) (
 			return nil, err
 		}
 
-		tp := &onnx.TensorProto{}
-		if err := proto.Unmarshal(bytesInput, tp); err != nil {
-			return nil, err
+		var value ops.Value
+
+		typeProto := inputs[i].GetType()
+
+		switch {
+		case typeProto.GetSequenceType() != nil:
+			value, err = readTestSequence(bytesInput)
+		case typeProto.GetMapType() != nil:
+			value, err = readTestMap(bytesInput)
+		case typeProto.GetOptionalType() != nil:
+			value, err = readTestOptional(bytesInput)
+		default:
+			value, err = readTestTensor(bytesInput)
 		}
 
-		t, err := onnx.TensorFromProto(tp)
 		if err != nil {
 			return nil, err
 		}
 
-		tensors[inputs[i].GetName()] = t
+		values[inputs[i].GetName()] = value
+	}
+
+	return values, nil
+}
+
+func readTestTensor(data []byte) (tensor.Tensor, error) {
+	tp := &onnx.TensorProto{}
+	if err := proto.Unmarshal(data, tp); err != nil {
+		return nil, err
+	}
+
+	return onnx.TensorFromProto(tp)
+}
+
+// The field numbers of the values in the SequenceProto and the OptionalProto of the ONNX
+// onnx-data.proto, which are the same for both messages, and of the MapProto. No Go code is
+// generated for these messages, hence they are decoded field by field.
+const (
+	valueTensorField       protowire.Number = 3
+	valueSparseTensorField protowire.Number = 4
+	valueSequenceField     protowire.Number = 5
+	valueMapField          protowire.Number = 6
+	valueOptionalField     protowire.Number = 7
+
+	mapKeysField       protowire.Number = 3
+	mapStringKeysField protowire.Number = 4
+	mapValuesField     protowire.Number = 5
+)
+
+func readTestSequence(data []byte) (ops.Sequence, error) {
+	sequence := ops.Sequence{}
+
+	err := readProtoFields(data, func(num protowire.Number, _ protowire.Type, _ uint64, bytes []byte) error {
+		element, err := readTestElement(num, bytes)
+		if err != nil || element == nil {
+			return err
+		}
+
+		sequence = append(sequence, element)
+
+		return nil
+	})
+
+	return sequence, err
+}
+
+func readTestOptional(data []byte) (ops.Optional, error) {
+	optional := ops.Optional{}
+
+	err := readProtoFields(data, func(num protowire.Number, _ protowire.Type, _ uint64, bytes []byte) error {
+		element, err := readTestElement(num, bytes)
+		if err != nil || element == nil {
+			return err
+		}
+
+		optional.Element = element
+
+		return nil
+	})
+
+	return optional, err
+}
+
+func readTestMap(data []byte) (ops.Map, error) {
+	var (
+		keys   []any
+		values ops.Sequence
+	)
+
+	err := readProtoFields(data, func(num protowire.Number, typ protowire.Type, varint uint64, bytes []byte) error {
+		var err error
+
+		switch num {
+		case mapKeysField:
+			if typ == protowire.VarintType {
+				keys = append(keys, int64(varint))
+				return nil
+			}
+
+			// The keys can also be packed, as a list of varints.
+			for len(bytes) > 0 {
+				key, n := protowire.ConsumeVarint(bytes)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+
+				keys = append(keys, int64(key))
+				bytes = bytes[n:]
+			}
+		case mapStringKeysField:
+			keys = append(keys, string(bytes))
+		case mapValuesField:
+			values, err = readTestSequence(bytes)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("map has %d keys and %d values", len(keys), len(values))
+	}
+
+	res := make(ops.Map, len(keys))
+	for i, key := range keys {
+		res[key] = values[i]
+	}
+
+	return res, nil
+}
+
+// readTestElement reads an element of a sequence or an optional. Nil is returned for fields
+// which are not an element, like the name and the type of the elements.
+func readTestElement(num protowire.Number, data []byte) (ops.Value, error) {
+	switch num {
+	case valueTensorField:
+		return readTestTensor(data)
+	case valueSparseTensorField:
+		return nil, fmt.Errorf("sparse tensors are not supported")
+	case valueSequenceField:
+		return readTestSequence(data)
+	case valueMapField:
+		return readTestMap(data)
+	case valueOptionalField:
+		return readTestOptional(data)
+	default:
+		return nil, nil
+	}
+}
+
+// readProtoFields calls the function for every field of a serialized protobuf message, with
+// either the varint or the bytes of the field.
+func readProtoFields(
+	data []byte, fn func(num protowire.Number, typ protowire.Type, varint uint64, bytes []byte) error,
+) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		data = data[n:]
+
+		var (
+			varint uint64
+			bytes  []byte
+		)
+
+		switch typ {
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			bytes, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		data = data[n:]
+
+		if err := fn(num, typ, varint, bytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assertValue asserts that the actual value equals the expected value. Sequences, maps and
+// optionals are compared element by element.
+func assertValue(t *testing.T, expected, actual ops.Value) {
+	t.Helper()
+
+	switch expectedValue := expected.(type) {
+	case ops.Sequence:
+		actualValue, ok := actual.(ops.Sequence)
+		if !assert.True(t, ok, "expected a sequence, got %T", actual) || !assert.Len(t, actualValue, len(expectedValue)) {
+			return
+		}
+
+		for i, element := range expectedValue {
+			assertValue(t, element, actualValue[i])
+		}
+	case ops.Map:
+		actualValue, ok := actual.(ops.Map)
+		if !assert.True(t, ok, "expected a map, got %T", actual) || !assert.Len(t, actualValue, len(expectedValue)) {
+			return
+		}
+
+		for key, element := range expectedValue {
+			assertValue(t, element, actualValue[key])
+		}
+	case ops.Optional:
+		actualValue, ok := actual.(ops.Optional)
+		if !assert.True(t, ok, "expected an optional, got %T", actual) {
+			return
+		}
+
+		if expectedValue.Element == nil || actualValue.Element == nil {
+			assert.Equal(t, expectedValue.Element == nil, actualValue.Element == nil)
+			return
+		}
+
+		assertValue(t, expectedValue.Element, actualValue.Element)
+	case tensor.Tensor:
+		actualValue, ok := actual.(tensor.Tensor)
+		if !assert.True(t, ok, "expected a tensor, got %T", actual) {
+			return
+		}
+
+		assertTensor(t, expectedValue, actualValue)
 	}
+}
+
+func assertTensor(t *testing.T, expectedTensor, actualTensor tensor.Tensor) {
+	t.Helper()
 
-	return tensors, nil
+	switch expectedTensor.Dtype() {
+	case tensor.Bool:
+		if expectedTensor.IsScalar() {
+			assert.Equal(t, expectedTensor.Data(), actualTensor.Data())
+			return
+		}
+
+		assert.ElementsMatch(t, expectedTensor.Data(), actualTensor.Data())
+	case tensor.String:
+		assert.Equal(t, expectedTensor.Data(), actualTensor.Data())
+	case onnx.Float16Dtype, onnx.BFloat16Dtype, onnx.Float8E4M3FNDtype, onnx.Float8E5M2Dtype,
+		onnx.Int4Dtype, onnx.Uint4Dtype:
+		// Half precision, float8 and 4 bit values can only be compared after
+		// converting them.
+		expectedData, err := ops.Float64Data(expectedTensor)
+		assert.Nil(t, err)
+
+		actualData, err := ops.Float64Data(actualTensor)
+		assert.Nil(t, err)
+
+		assert.InDeltaSlice(t, expectedData, actualData, 0.00001)
+	default:
+		assert.InDeltaSlice(t, expectedTensor.Data(), actualTensor.Data(), 0.00001)
+	}
 }
 
 // With this we check if we truly run all tests we expected from the integration test.
@@ -381,6 +699,36 @@ var expectedTests = []string{
 	"test_atanh_example",
 	"test_cast_DOUBLE_to_FLOAT",
 	"test_cast_FLOAT_to_DOUBLE",
+	"test_cast_FLOAT_to_STRING",
+	"test_cast_STRING_to_FLOAT",
+	"test_cast_DOUBLE_to_FLOAT16",
+	"test_cast_FLOAT_to_FLOAT16",
+	"test_cast_FLOAT16_to_DOUBLE",
+	"test_cast_FLOAT16_to_FLOAT",
+	"test_cast_BFLOAT16_to_FLOAT",
+	"test_cast_FLOAT_to_BFLOAT16",
+	"test_cast_FLOAT_to_FLOAT8E4M3FN",
+	"test_cast_FLOAT_to_FLOAT8E5M2",
+	"test_cast_FLOAT16_to_FLOAT8E4M3FN",
+	"test_cast_FLOAT16_to_FLOAT8E5M2",
+	"test_cast_FLOAT8E4M3FN_to_FLOAT",
+	"test_cast_FLOAT8E4M3FN_to_FLOAT16",
+	"test_cast_FLOAT8E5M2_to_FLOAT",
+	"test_cast_FLOAT8E5M2_to_FLOAT16",
+	"test_cast_no_saturate_FLOAT_to_FLOAT8E4M3FN",
+	"test_cast_no_saturate_FLOAT_to_FLOAT8E5M2",
+	"test_cast_no_saturate_FLOAT16_to_FLOAT8E4M3FN",
+	"test_cast_no_saturate_FLOAT16_to_FLOAT8E5M2",
+	"test_cast_FLOAT_to_INT4",
+	"test_cast_FLOAT_to_UINT4",
+	"test_cast_FLOAT16_to_INT4",
+	"test_cast_FLOAT16_to_UINT4",
+	"test_cast_INT4_to_FLOAT",
+	"test_cast_INT4_to_FLOAT16",
+	"test_cast_INT4_to_INT8",
+	"test_cast_UINT4_to_FLOAT",
+	"test_cast_UINT4_to_FLOAT16",
+	"test_cast_UINT4_to_UINT8",
 	"test_concat_1d_axis_0",
 	"test_concat_1d_axis_negative_1",
 	"test_concat_2d_axis_0",
@@ -409,6 +757,8 @@ var expectedTests = []string{
 	"test_div_example",
 	"test_equal",
 	"test_equal_bcast",
+	"test_equal_string",
+	"test_equal_string_broadcast",
 	"test_expand_dim_changed",
 	"test_expand_dim_unchanged",
 	"test_flatten_axis0",
@@ -432,6 +782,9 @@ var expectedTests = []string{
 	"test_gemm_default_zero_bias",
 	"test_gemm_beta",
 	"test_gemm_transposeB",
+	"test_gemm_alpha",
+	"test_gemm_default_no_bias",
+	"test_gemm_default_scalar_bias",
 	"test_greater",
 	"test_greater_bcast",
 	"test_greater_equal",
@@ -516,9 +869,11 @@ var expectedTests = []string{
 	"test_transpose_all_permutations_3",
 	"test_transpose_all_permutations_4",
 	"test_transpose_all_permutations_5",
+	"test_transpose_default",
 	"test_unsqueeze_axis_0",
 	"test_unsqueeze_axis_1",
 	"test_unsqueeze_axis_2",
+	"test_unsqueeze_axis_3",
 	"test_unsqueeze_negative_axes",
 	"test_unsqueeze_three_axes",
 	"test_unsqueeze_two_axes",
@@ -528,9 +883,428 @@ var expectedTests = []string{
 	"test_xor_bcast4v2d",
 	"test_xor_bcast4v3d",
 	"test_xor_bcast4v4d",
+	"test_add_uint8",
+	"test_batchnorm_epsilon",
+	"test_batchnorm_example",
+	"test_cumsum_1d",
+	"test_cumsum_1d_exclusive",
+	"test_cumsum_1d_reverse",
+	"test_cumsum_1d_reverse_exclusive",
+	"test_cumsum_2d_axis_0",
+	"test_cumsum_2d_axis_1",
+	"test_cumsum_2d_negative_axis",
+	"test_div_uint8",
+	"test_gru_batchwise",
+	"test_lstm_batchwise",
+	"test_mul_uint8",
+	"test_sub_uint8",
+	"test_tril",
+	"test_tril_neg",
+	"test_tril_one_row_neg",
+	"test_tril_out_neg",
+	"test_tril_out_pos",
+	"test_tril_pos",
+	"test_tril_square",
+	"test_tril_square_neg",
+	"test_triu",
+	"test_triu_neg",
+	"test_triu_one_row",
+	"test_triu_out_neg_out",
+	"test_triu_out_pos",
+	"test_triu_pos",
+	"test_triu_square",
+	"test_triu_square_neg",
+	"test_castlike_DOUBLE_to_FLOAT",
+	"test_castlike_DOUBLE_to_FLOAT_expanded",
+	"test_castlike_FLOAT_to_DOUBLE",
+	"test_castlike_FLOAT_to_DOUBLE_expanded",
+	"test_castlike_FLOAT_to_STRING",
+	"test_castlike_FLOAT_to_STRING_expanded",
+	"test_castlike_STRING_to_FLOAT",
+	"test_castlike_STRING_to_FLOAT_expanded",
+	"test_castlike_FLOAT_to_FLOAT16",
+	"test_castlike_FLOAT_to_FLOAT16_expanded",
+	"test_castlike_FLOAT16_to_FLOAT",
+	"test_castlike_FLOAT16_to_FLOAT_expanded",
+	"test_castlike_FLOAT16_to_DOUBLE",
+	"test_castlike_FLOAT16_to_DOUBLE_expanded",
+	"test_castlike_DOUBLE_to_FLOAT16",
+	"test_castlike_DOUBLE_to_FLOAT16_expanded",
+	"test_castlike_FLOAT_to_BFLOAT16",
+	"test_castlike_FLOAT_to_BFLOAT16_expanded",
+	"test_castlike_BFLOAT16_to_FLOAT",
+	"test_castlike_BFLOAT16_to_FLOAT_expanded",
+	"test_castlike_FLOAT_to_FLOAT8E4M3FN",
+	"test_castlike_FLOAT_to_FLOAT8E4M3FN_expanded",
+	"test_castlike_FLOAT8E4M3FN_to_FLOAT",
+	"test_castlike_FLOAT8E4M3FN_to_FLOAT_expanded",
+	"test_castlike_FLOAT_to_FLOAT8E5M2",
+	"test_castlike_FLOAT_to_FLOAT8E5M2_expanded",
+	"test_castlike_FLOAT8E5M2_to_FLOAT",
+	"test_castlike_FLOAT8E5M2_to_FLOAT_expanded",
+	"test_gridsample",
+	"test_gridsample_aligncorners_true",
+	"test_gridsample_bicubic",
+	"test_gridsample_bicubic_align_corners_0_additional_1",
+	"test_gridsample_bicubic_align_corners_1_additional_1",
+	"test_gridsample_bilinear",
+	"test_gridsample_bilinear_align_corners_0_additional_1",
+	"test_gridsample_bilinear_align_corners_1_additional_1",
+	"test_gridsample_border_padding",
+	"test_gridsample_nearest",
+	"test_gridsample_nearest_align_corners_0_additional_1",
+	"test_gridsample_nearest_align_corners_1_additional_1",
+	"test_gridsample_reflection_padding",
+	"test_gridsample_zeros_padding",
+	"test_leakyrelu",
+	"test_leakyrelu_default",
+	"test_leakyrelu_default_expanded",
+	"test_leakyrelu_example",
+	"test_leakyrelu_example_expanded",
+	"test_leakyrelu_expanded",
+	"test_prelu_broadcast_expanded",
+	"test_prelu_example_expanded",
+	"test_scatter_elements_with_axis",
+	"test_scatter_elements_with_duplicate_indices",
+	"test_scatter_elements_with_negative_indices",
+	"test_scatter_elements_without_axis",
+	"test_scatternd",
+	"test_scatternd_add",
+	"test_scatternd_multiply",
+	"test_shape_clip_end",
+	"test_shape_clip_start",
+	"test_shape_end_1",
+	"test_shape_end_negative_1",
+	"test_shape_example",
+	"test_shape_start_1",
+	"test_shape_start_1_end_2",
+	"test_shape_start_1_end_negative_1",
+	"test_shape_start_negative_1",
+	"test_where_example",
+	"test_where_long_example",
+	"test_blackmanwindow",
+	"test_blackmanwindow_symmetric",
+	"test_hammingwindow",
+	"test_hammingwindow_symmetric",
+	"test_hannwindow",
+	"test_hannwindow_symmetric",
+	"test_constant_pad",
+	"test_constant_pad_axes",
+	"test_constant_pad_negative_axes",
+	"test_edge_pad",
+	"test_reflect_pad",
+	"test_dft_axis_opset19",
+	"test_dft_inverse_opset19",
+	"test_dft_opset19",
+	"test_clip",
+	"test_clip_default_inbounds",
+	"test_clip_default_int8_inbounds",
+	"test_clip_default_int8_max",
+	"test_clip_default_int8_min",
+	"test_clip_default_max",
+	"test_clip_default_min",
+	"test_clip_example",
+	"test_clip_inbounds",
+	"test_clip_outbounds",
+	"test_clip_splitbounds",
+	"test_optional_get_element_optional_sequence",
+	"test_optional_get_element_optional_tensor",
+	"test_optional_get_element_sequence",
+	"test_optional_get_element_tensor",
+	"test_optional_has_element_empty_no_input_name_optional_input",
+	"test_optional_has_element_empty_no_input_name_tensor_input",
+	"test_optional_has_element_empty_optional_input",
+	"test_optional_has_element_optional_input",
+	"test_optional_has_element_tensor_input",
+	"test_split_to_sequence_1",
+	"test_split_to_sequence_2",
+	"test_split_to_sequence_nokeepdims",
+	"test_upsample_nearest",
+	"test_resize_downsample_scales_cubic",
+	"test_resize_downsample_scales_cubic_A_n0p5_exclude_outside",
+	"test_resize_downsample_scales_cubic_align_corners",
+	"test_resize_downsample_scales_cubic_antialias",
+	"test_resize_downsample_scales_linear",
+	"test_resize_downsample_scales_linear_align_corners",
+	"test_resize_downsample_scales_linear_antialias",
+	"test_resize_downsample_scales_linear_half_pixel_symmetric",
+	"test_resize_downsample_scales_nearest",
+	"test_resize_downsample_sizes_cubic",
+	"test_resize_downsample_sizes_cubic_antialias",
+	"test_resize_downsample_sizes_linear_antialias",
+	"test_resize_downsample_sizes_linear_pytorch_half_pixel",
+	"test_resize_downsample_sizes_nearest",
+	"test_resize_downsample_sizes_nearest_not_larger",
+	"test_resize_downsample_sizes_nearest_not_smaller",
+	"test_resize_tf_crop_and_resize",
+	"test_resize_tf_crop_and_resize_axes_2_3",
+	"test_resize_tf_crop_and_resize_axes_3_2",
+	"test_resize_tf_crop_and_resize_extrapolation_value",
+	"test_resize_upsample_scales_cubic",
+	"test_resize_upsample_scales_cubic_A_n0p5_exclude_outside",
+	"test_resize_upsample_scales_cubic_align_corners",
+	"test_resize_upsample_scales_cubic_asymmetric",
+	"test_resize_upsample_scales_linear",
+	"test_resize_upsample_scales_linear_align_corners",
+	"test_resize_upsample_scales_linear_half_pixel_symmetric",
+	"test_resize_upsample_scales_nearest",
+	"test_resize_upsample_scales_nearest_axes_2_3",
+	"test_resize_upsample_scales_nearest_axes_3_2",
+	"test_resize_upsample_sizes_cubic",
+	"test_resize_upsample_sizes_nearest",
+	"test_resize_upsample_sizes_nearest_axes_2_3",
+	"test_resize_upsample_sizes_nearest_axes_3_2",
+	"test_resize_upsample_sizes_nearest_ceil_half_pixel",
+	"test_resize_upsample_sizes_nearest_floor_align_corners",
+	"test_resize_upsample_sizes_nearest_not_larger",
+	"test_resize_upsample_sizes_nearest_not_smaller",
+	"test_resize_upsample_sizes_nearest_round_prefer_ceil_asymmetric",
+	"test_dft",
+	"test_dft_axis",
+	"test_dft_inverse",
+	"test_wrap_pad",
+	"test_reduce_max_bool_inputs",
+	"test_reduce_min_bool_inputs",
+	"test_quantizelinear",
+	"test_quantizelinear_axis",
+	"test_quantizelinear_e4m3fn",
+	"test_quantizelinear_e5m2",
+	"test_quantizelinear_int4",
+	"test_quantizelinear_uint4",
+	"test_quantizelinear_int16",
+	"test_quantizelinear_uint16",
+	"test_dequantizelinear",
+	"test_dequantizelinear_axis",
+	"test_dequantizelinear_e4m3fn",
+	"test_dequantizelinear_e4m3fn_float16",
+	"test_dequantizelinear_e4m3fn_zero_point",
+	"test_dequantizelinear_e5m2",
+	"test_dequantizelinear_int4",
+	"test_dequantizelinear_uint4",
+	"test_dequantizelinear_int16",
+	"test_dequantizelinear_uint16",
+	"test_basic_deform_conv_with_padding",
+	"test_basic_deform_conv_without_padding",
+	"test_deform_conv_with_mask_bias",
+	"test_deform_conv_with_multiple_offset_groups",
+	"test_gelu_default_1",
+	"test_gelu_default_2",
+	"test_gelu_tanh_1",
+	"test_gelu_tanh_2",
+	"test_affine_grid_2d",
+	"test_affine_grid_2d_align_corners",
+	"test_affine_grid_3d",
+	"test_affine_grid_3d_align_corners",
+	"test_group_normalization_epsilon",
+	"test_group_normalization_example",
+	"test_exp",
+	"test_exp_example",
+	"test_log",
+	"test_log_example",
+	"test_layer_normalization_2d_axis0",
+	"test_layer_normalization_2d_axis1",
+	"test_layer_normalization_2d_axis_negative_1",
+	"test_layer_normalization_2d_axis_negative_2",
+	"test_layer_normalization_3d_axis0_epsilon",
+	"test_layer_normalization_3d_axis1_epsilon",
+	"test_layer_normalization_3d_axis2_epsilon",
+	"test_layer_normalization_3d_axis_negative_1_epsilon",
+	"test_layer_normalization_3d_axis_negative_2_epsilon",
+	"test_layer_normalization_3d_axis_negative_3_epsilon",
+	"test_layer_normalization_4d_axis0",
+	"test_layer_normalization_4d_axis1",
+	"test_layer_normalization_4d_axis2",
+	"test_layer_normalization_4d_axis3",
+	"test_layer_normalization_4d_axis_negative_1",
+	"test_layer_normalization_4d_axis_negative_2",
+	"test_layer_normalization_4d_axis_negative_3",
+	"test_layer_normalization_4d_axis_negative_4",
+	"test_layer_normalization_default_axis",
+	"test_melweightmatrix",
+	"test_stft",
+	"test_stft_with_window",
+	"test_reduce_l1_do_not_keepdims_example",
+	"test_reduce_l1_do_not_keepdims_example_expanded",
+	"test_reduce_l2_do_not_keepdims_example",
+	"test_reduce_l1_do_not_keepdims_random",
+	"test_reduce_l1_do_not_keepdims_random_expanded",
+	"test_reduce_l2_do_not_keepdims_random",
+	"test_reduce_l1_keep_dims_example",
+	"test_reduce_l1_keep_dims_example_expanded",
+	"test_reduce_l2_keep_dims_example",
+	"test_reduce_l1_keep_dims_random",
+	"test_reduce_l1_keep_dims_random_expanded",
+	"test_reduce_l2_keep_dims_random",
+	"test_reduce_l1_default_axes_keepdims_example",
+	"test_reduce_l1_default_axes_keepdims_example_expanded",
+	"test_reduce_l2_default_axes_keepdims_example",
+	"test_reduce_l1_default_axes_keepdims_random",
+	"test_reduce_l1_default_axes_keepdims_random_expanded",
+	"test_reduce_l2_default_axes_keepdims_random",
+	"test_reduce_l1_negative_axes_keep_dims_example",
+	"test_reduce_l1_negative_axes_keep_dims_example_expanded",
+	"test_reduce_l2_negative_axes_keep_dims_example",
+	"test_reduce_l1_negative_axes_keep_dims_random",
+	"test_reduce_l1_negative_axes_keep_dims_random_expanded",
+	"test_reduce_l2_negative_axes_keep_dims_random",
+	"test_reduce_log_sum_asc_axes",
+	"test_reduce_log_sum_asc_axes_expanded",
+	"test_reduce_log_sum_default",
+	"test_reduce_log_sum_default_expanded",
+	"test_reduce_log_sum_desc_axes",
+	"test_reduce_log_sum_desc_axes_expanded",
+	"test_reduce_log_sum_negative_axes",
+	"test_reduce_log_sum_negative_axes_expanded",
+	"test_reduce_log_sum_exp_default_axes_keepdims_example",
+	"test_reduce_log_sum_exp_default_axes_keepdims_example_expanded",
+	"test_reduce_sum_square_default_axes_keepdims_example",
+	"test_reduce_sum_square_default_axes_keepdims_example_expanded",
+	"test_reduce_mean_default_axes_keepdims_example",
+	"test_reduce_prod_default_axes_keepdims_example",
+	"test_reduce_min_default_axes_keepdims_example",
+	"test_reduce_max_default_axes_keepdim_example",
+	"test_reduce_log_sum_exp_default_axes_keepdims_random",
+	"test_reduce_log_sum_exp_default_axes_keepdims_random_expanded",
+	"test_reduce_sum_square_default_axes_keepdims_random",
+	"test_reduce_sum_square_default_axes_keepdims_random_expanded",
+	"test_reduce_mean_default_axes_keepdims_random",
+	"test_reduce_prod_default_axes_keepdims_random",
+	"test_reduce_min_default_axes_keepdims_random",
+	"test_reduce_max_default_axes_keepdims_random",
+	"test_reduce_log_sum_exp_do_not_keepdims_example",
+	"test_reduce_log_sum_exp_do_not_keepdims_example_expanded",
+	"test_reduce_sum_square_do_not_keepdims_example",
+	"test_reduce_sum_square_do_not_keepdims_example_expanded",
+	"test_reduce_mean_do_not_keepdims_example",
+	"test_reduce_prod_do_not_keepdims_example",
+	"test_reduce_sum_do_not_keepdims_example",
+	"test_reduce_min_do_not_keepdims_example",
+	"test_reduce_max_do_not_keepdims_example",
+	"test_reduce_log_sum_exp_do_not_keepdims_random",
+	"test_reduce_log_sum_exp_do_not_keepdims_random_expanded",
+	"test_reduce_sum_square_do_not_keepdims_random",
+	"test_reduce_sum_square_do_not_keepdims_random_expanded",
+	"test_reduce_mean_do_not_keepdims_random",
+	"test_reduce_prod_do_not_keepdims_random",
+	"test_reduce_sum_do_not_keepdims_random",
+	"test_reduce_min_do_not_keepdims_random",
+	"test_reduce_max_do_not_keepdims_random",
+	"test_reduce_log_sum_exp_keepdims_example",
+	"test_reduce_log_sum_exp_keepdims_example_expanded",
+	"test_reduce_sum_square_keepdims_example",
+	"test_reduce_sum_square_keepdims_example_expanded",
+	"test_reduce_mean_keepdims_example",
+	"test_reduce_prod_keepdims_example",
+	"test_reduce_sum_keepdims_example",
+	"test_reduce_min_keepdims_example",
+	"test_reduce_max_keepdims_example",
+	"test_reduce_log_sum_exp_keepdims_random",
+	"test_reduce_log_sum_exp_keepdims_random_expanded",
+	"test_reduce_sum_square_keepdims_random",
+	"test_reduce_sum_square_keepdims_random_expanded",
+	"test_reduce_mean_keepdims_random",
+	"test_reduce_prod_keepdims_random",
+	"test_reduce_sum_keepdims_random",
+	"test_reduce_min_keepdims_random",
+	"test_reduce_max_keepdims_random",
+	"test_reduce_log_sum_exp_negative_axes_keepdims_example",
+	"test_reduce_log_sum_exp_negative_axes_keepdims_example_expanded",
+	"test_reduce_sum_square_negative_axes_keepdims_example",
+	"test_reduce_sum_square_negative_axes_keepdims_example_expanded",
+	"test_reduce_mean_negative_axes_keepdims_example",
+	"test_reduce_prod_negative_axes_keepdims_example",
+	"test_reduce_sum_negative_axes_keepdims_example",
+	"test_reduce_min_negative_axes_keepdims_example",
+	"test_reduce_max_negative_axes_keepdims_example",
+	"test_reduce_log_sum_exp_negative_axes_keepdims_random",
+	"test_reduce_log_sum_exp_negative_axes_keepdims_random_expanded",
+	"test_reduce_sum_square_negative_axes_keepdims_random",
+	"test_reduce_sum_square_negative_axes_keepdims_random_expanded",
+	"test_reduce_mean_negative_axes_keepdims_random",
+	"test_reduce_prod_negative_axes_keepdims_random",
+	"test_reduce_sum_negative_axes_keepdims_random",
+	"test_reduce_min_negative_axes_keepdims_random",
+	"test_reduce_max_negative_axes_keepdims_random",
+	"test_logsoftmax_axis_0_expanded",
+	"test_logsoftmax_axis_0_expanded_ver18",
+	"test_logsoftmax_axis_1_expanded",
+	"test_logsoftmax_axis_1_expanded_ver18",
+	"test_logsoftmax_axis_2_expanded",
+	"test_logsoftmax_axis_2_expanded_ver18",
+	"test_logsoftmax_default_axis_expanded",
+	"test_logsoftmax_default_axis_expanded_ver18",
+	"test_logsoftmax_large_number_expanded",
+	"test_logsoftmax_large_number_expanded_ver18",
+	"test_logsoftmax_negative_axis_expanded",
+	"test_logsoftmax_negative_axis_expanded_ver18",
+	"test_logsoftmax_example_1_expanded",
+	"test_logsoftmax_example_1_expanded_ver18",
+	"test_softmax_axis_0_expanded",
+	"test_softmax_axis_0_expanded_ver18",
+	"test_softmax_axis_1_expanded",
+	"test_softmax_axis_1_expanded_ver18",
+	"test_softmax_axis_2_expanded",
+	"test_softmax_axis_2_expanded_ver18",
+	"test_softmax_default_axis_expanded",
+	"test_softmax_default_axis_expanded_ver18",
+	"test_softmax_large_number_expanded",
+	"test_softmax_large_number_expanded_ver18",
+	"test_softmax_negative_axis_expanded",
+	"test_softmax_negative_axis_expanded_ver18",
+	"test_softmax_example_expanded",
+	"test_softmax_example_expanded_ver18",
+	"test_scatter_elements_with_reduction_max",
+	"test_scatter_elements_with_reduction_min",
+	"test_scatternd_max",
+	"test_scatternd_min",
+	"test_split_equal_parts_1d_opset13",
+	"test_split_equal_parts_2d_opset13",
+	"test_split_equal_parts_default_axis_opset13",
+	"test_split_variable_parts_1d_opset13",
+	"test_split_variable_parts_2d_opset13",
+	"test_split_variable_parts_default_axis_opset13",
+	"test_split_equal_parts_1d_opset18",
+	"test_split_equal_parts_2d_opset18",
+	"test_split_equal_parts_default_axis_opset18",
+	"test_split_variable_parts_1d_opset18",
+	"test_split_variable_parts_2d_opset18",
+	"test_split_variable_parts_default_axis_opset18",
+	"test_split_1d_uneven_split_opset18",
+	"test_split_2d_uneven_split_opset18",
+	"test_string_concat",
+	"test_string_concat_broadcasting",
+	"test_string_concat_empty_string",
+	"test_string_concat_utf8",
+	"test_string_concat_zero_dimensional",
+	"test_regex_full_match_basic",
+	"test_regex_full_match_email_domain",
+	"test_string_split_basic",
+	"test_string_split_consecutive_delimiters",
+	"test_string_split_empty_string_delimiter",
+	"test_string_split_maxsplit",
+	"test_string_split_no_delimiter",
 }
 
-var opNameMap = map[string]string{
-	"reducemax": "reduce_max",
-	"reducemin": "reduce_min",
+var opNameMap = map[string][]string{
+	"affinegrid":         {"affine_grid"},
+	"batchnormalization": {"batchnorm"},
+	"deformconv":         {"basic_deform_conv", "deform_conv"},
+	"groupnormalization": {"group_normalization"},
+	"layernormalization": {"layer_normalization"},
+	"pad":                {"constant_pad", "edge_pad", "reflect_pad", "wrap_pad"},
+	"reducel1":           {"reduce_l1"},
+	"reducel2":           {"reduce_l2"},
+	"reducelogsum":       {"reduce_log_sum"},
+	"reducelogsumexp":    {"reduce_log_sum_exp"},
+	"reducemax":          {"reduce_max"},
+	"reducemean":         {"reduce_mean"},
+	"reducemin":          {"reduce_min"},
+	"reduceprod":         {"reduce_prod"},
+	"reducesum":          {"reduce_sum"},
+	"reducesumsquare":    {"reduce_sum_square"},
+	"regexfullmatch":     {"regex_full_match"},
+	"scatterelements":    {"scatter_elements"},
+	"stringconcat":       {"string_concat"},
+	"stringsplit":        {"string_split"},
+	"trilu":              {"tril", "triu"},
 }
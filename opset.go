@@ -1,22 +1,132 @@
 package gonnx
 
 import (
+	"fmt"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/legacy"
 	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+	"github.com/advancedclimatesystems/gonnx/ops/opset15"
+	"github.com/advancedclimatesystems/gonnx/ops/opset16"
+	"github.com/advancedclimatesystems/gonnx/ops/opset17"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"github.com/advancedclimatesystems/gonnx/ops/opset20"
+	"github.com/advancedclimatesystems/gonnx/ops/opset21"
 )
 
 // OpGetter is a function that gets an operator based on a string.
 type OpGetter func(string) (ops.Operator, error)
 
-var operatorGetters = map[int64]OpGetter{
-	13: opset13.GetOperator,
+// The versions of the operator sets of the ONNX domains supported by gonnx. A model can
+// be loaded if it imports a supported version, and all of its operators are implemented
+// for that version.
+const (
+	MinOpsetVersion   = 7
+	MaxOpsetVersion   = 21
+	MinMLOpsetVersion = 1
+	MaxMLOpsetVersion = 5
+)
+
+// builtinOperators holds the operators implemented by gonnx for the ONNX domains, by the
+// version since which every implementation is valid.
+var builtinOperators = newBuiltinRegistry()
+
+func newBuiltinRegistry() *Registry {
+	r := NewRegistry()
+
+	for _, op := range legacy.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset13.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset14.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset15.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset16.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset17.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset18.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset19.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset20.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset21.GetOperatorVersions() {
+		r.mustRegister(DomainONNX, op)
+	}
+
+	for _, op := range opset13.GetMLOperatorVersions() {
+		r.mustRegister(DomainML, op)
+	}
+
+	return r
 }
 
 // ResolveOperatorGetter resolves the getter for operators based on the opset version.
+// For every operator, the newest implementation which is valid for the version is used.
 func ResolveOperatorGetter(opsetID int64) (OpGetter, error) {
-	if getOperator, ok := operatorGetters[opsetID]; ok {
-		return getOperator, nil
+	return resolveBuiltinGetter(DomainONNX, opsetID, MinOpsetVersion, MaxOpsetVersion)
+}
+
+// ResolveMLOperatorGetter resolves the getter for operators of the ai.onnx.ml domain based
+// on the version of the domain.
+func ResolveMLOperatorGetter(version int64) (OpGetter, error) {
+	return resolveBuiltinGetter(DomainML, version, MinMLOpsetVersion, MaxMLOpsetVersion)
+}
+
+func resolveBuiltinGetter(domain string, version, minVersion, maxVersion int64) (OpGetter, error) {
+	if version < minVersion || version > maxVersion {
+		return nil, ops.ErrUnsupportedOpsetVersion
 	}
 
-	return nil, ops.ErrUnsupportedOpsetVersion
+	return func(opType string) (ops.Operator, error) {
+		factory, ok := builtinOperators.Lookup(domain, opType, version)
+		if !ok {
+			return nil, ops.ErrUnknownOperatorType(fmt.Sprintf("%v for opset version %d", opType, version))
+		}
+
+		return factory(), nil
+	}, nil
+}
+
+// opsets holds the version of every operator set imported by a model, by domain. The
+// default ONNX domain is stored as DomainONNX.
+type opsets map[string]int64
+
+// newOpsets creates the table of operator sets from the opset imports of a model.
+func newOpsets(opsetImports []*onnx.OperatorSetIdProto) opsets {
+	o := make(opsets, len(opsetImports))
+	for _, opsetImport := range opsetImports {
+		o[normalizeDomain(opsetImport.GetDomain())] = opsetImport.GetVersion()
+	}
+
+	return o
+}
+
+// version returns the version of the domain, and whether the domain is imported at all.
+func (o opsets) version(domain string) (int64, bool) {
+	version, ok := o[normalizeDomain(domain)]
+	return version, ok
 }
@@ -3,12 +3,367 @@ package gonnx
 import (
 	"testing"
 
+	"github.com/advancedclimatesystems/gonnx/onnx"
 	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/legacy"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/advancedclimatesystems/gonnx/ops/opset14"
+	"github.com/advancedclimatesystems/gonnx/ops/opset15"
+	"github.com/advancedclimatesystems/gonnx/ops/opset16"
+	"github.com/advancedclimatesystems/gonnx/ops/opset17"
+	"github.com/advancedclimatesystems/gonnx/ops/opset18"
+	"github.com/advancedclimatesystems/gonnx/ops/opset19"
+	"github.com/advancedclimatesystems/gonnx/ops/opset20"
+	"github.com/advancedclimatesystems/gonnx/ops/opset21"
 	"github.com/stretchr/testify/assert"
+	"gorgonia.org/tensor"
 )
 
 func TestResolveOperatorGetterFail(t *testing.T) {
-	opGetter, err := ResolveOperatorGetter(12)
+	for _, version := range []int64{MinOpsetVersion - 1, MaxOpsetVersion + 1} {
+		opGetter, err := ResolveOperatorGetter(version)
+		assert.Nil(t, opGetter)
+		assert.Equal(t, ops.ErrUnsupportedOpsetVersion, err)
+	}
+}
+
+func TestResolveOperatorGetter(t *testing.T) {
+	tests := []struct {
+		version  int64
+		opType   string
+		expected ops.Operator
+		err      error
+	}{
+		{13, "Abs", &opset13.Abs{}, nil},
+		{12, "Abs", &opset13.Abs{}, nil},
+		{9, "Slice", nil, ops.ErrUnknownOperatorType("Slice for opset version 9")},
+		{10, "Slice", &opset13.Slice{}, nil},
+		{7, "Gemm", &opset13.Gemm{}, nil},
+		{12, "Squeeze", &legacy.Squeeze{}, nil},
+		{13, "Squeeze", &opset13.Squeeze{}, nil},
+		{12, "Softmax", &legacy.Softmax{}, nil},
+		{10, "Clip", &legacy.Clip{}, nil},
+		{11, "Clip", &opset13.Clip{}, nil},
+		{9, "Upsample", &legacy.Upsample{}, nil},
+		{7, "Sin", &opset13.Sin{}, nil},
+		{12, "Sin", &opset13.Sin{}, nil},
+		{13, "Sin", &opset13.Sin{}, nil},
+		{8, "Acosh", nil, ops.ErrUnknownOperatorType("Acosh for opset version 8")},
+		{9, "Acosh", &opset13.Acosh{}, nil},
+		{12, "LessOrEqual", &opset13.LessOrEqual{}, nil},
+		{11, "LessOrEqual", nil, ops.ErrUnknownOperatorType("LessOrEqual for opset version 11")},
+		{13, "Scaler", nil, ops.ErrUnknownOperatorType("Scaler for opset version 13")},
+		{10, "SequenceAt", nil, ops.ErrUnknownOperatorType("SequenceAt for opset version 10")},
+		{11, "SequenceAt", &opset13.SequenceAt{}, nil},
+		{21, "SplitToSequence", &opset13.SplitToSequence{}, nil},
+		{13, "Add", &opset13.Add{}, nil},
+		{14, "Add", &opset14.Add{}, nil},
+		{14, "Abs", &opset13.Abs{}, nil},
+		{14, "Trilu", &opset14.Trilu{}, nil},
+		{13, "Trilu", nil, ops.ErrUnknownOperatorType("Trilu for opset version 13")},
+		{14, "Shape", &opset13.Shape{}, nil},
+		{15, "Shape", &opset15.Shape{}, nil},
+		{14, "CastLike", nil, ops.ErrUnknownOperatorType("CastLike for opset version 14")},
+		{15, "ScatterND", &opset13.ScatterND{}, nil},
+		{16, "ScatterND", &opset16.ScatterND{}, nil},
+		{15, "Where", &opset13.Where{}, nil},
+		{16, "Where", &opset16.Where{}, nil},
+		{16, "LeakyRelu", &opset16.LeakyRelu{}, nil},
+		{16, "LayerNormalization", nil, ops.ErrUnknownOperatorType("LayerNormalization for opset version 16")},
+		{17, "LayerNormalization", &opset17.LayerNormalization{}, nil},
+		{18, "LayerNormalization", &opset17.LayerNormalization{}, nil},
+		{17, "ReduceMax", &opset13.ReduceMax{}, nil},
+		{18, "ReduceMax", &opset18.ReduceMax{}, nil},
+		{17, "Split", &opset13.Split{}, nil},
+		{18, "Split", &opset18.Split{}, nil},
+		{18, "ScatterND", &opset18.ScatterND{}, nil},
+		{18, "Pad", &opset18.Pad{}, nil},
+		{19, "Pad", &opset19.Pad{}, nil},
+		{13, "Resize", &opset13.Resize{}, nil},
+		{18, "Resize", &opset18.Resize{}, nil},
+		{21, "Resize", &opset19.Resize{}, nil},
+		{18, "DeformConv", nil, ops.ErrUnknownOperatorType("DeformConv for opset version 18")},
+		{19, "DeformConv", &opset19.DeformConv{}, nil},
+		{19, "ReduceMin", &opset18.ReduceMin{}, nil},
+		{20, "ReduceMin", &opset20.ReduceMin{}, nil},
+		{19, "ConstantOfShape", &opset13.ConstantOfShape{}, nil},
+		{20, "ConstantOfShape", &opset20.ConstantOfShape{}, nil},
+		{21, "ConstantOfShape", &opset21.ConstantOfShape{}, nil},
+		{20, "GroupNormalization", nil, ops.ErrUnknownOperatorType("GroupNormalization for opset version 20")},
+		{21, "GroupNormalization", &opset21.GroupNormalization{}, nil},
+		{21, "Gelu", &opset20.Gelu{}, nil},
+		{20, "Cast", &opset19.Cast{}, nil},
+		{21, "Cast", &opset21.Cast{}, nil},
+	}
+
+	for _, test := range tests {
+		opGetter, err := ResolveOperatorGetter(test.version)
+		assert.Nil(t, err)
+
+		op, err := opGetter(test.opType)
+		assert.Equal(t, test.err, err)
+
+		if test.expected != nil {
+			assert.IsType(t, test.expected, op)
+		}
+	}
+}
+
+func TestModelOlderOpset(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Sin", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Cos", Input: []string{"a_out"}, Output: []string{"z"}},
+		},
+	)
+	mp.OpsetImport[0].Version = 9
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{1}}, [][]float32{{0}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1}, outputs["z"].Data())
+
+	mp.Graph.Node[1].OpType = "Slice"
+
+	_, err = NewModel(mp)
+	assert.Equal(t, ops.ErrUnknownOperatorType("Slice for opset version 9"), err)
+}
+
+func TestModelOpset9(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{
+				Name:      "a",
+				OpType:    "Unsqueeze",
+				Input:     []string{"x"},
+				Output:    []string{"a_out"},
+				Attribute: []*onnx.AttributeProto{{Name: "axes", Ints: []int64{0}}},
+			},
+			{
+				Name:      "b",
+				OpType:    "Clip",
+				Input:     []string{"a_out"},
+				Output:    []string{"b_out"},
+				Attribute: []*onnx.AttributeProto{{Name: "min", F: 0}},
+			},
+			{Name: "c", OpType: "Softmax", Input: []string{"b_out"}, Output: []string{"z"}},
+		},
+	)
+	mp.OpsetImport[0].Version = 9
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{-1, 0}}))
+	assert.Nil(t, err)
+	assert.Equal(t, tensor.Shape{1, 2}, outputs["z"].Shape())
+	assert.Equal(t, []float32{0.5, 0.5}, outputs["z"].Data())
+}
+
+func TestModelOpset14(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Add", Input: []string{"x", "y"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Trilu", Input: []string{"a_out"}, Output: []string{"z"}},
+		},
+	)
+	mp.OpsetImport[0].Version = 14
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{
+		"x": tensor.New(tensor.WithShape(2, 2), tensor.WithBacking([]uint8{1, 2, 3, 4})),
+		"y": tensor.New(tensor.WithShape(2, 2), tensor.WithBacking([]uint8{1, 1, 1, 1})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint8{2, 3, 0, 5}, outputs["z"].Data())
+}
+
+func TestModelOpset16(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "i", "u"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{
+				Name:      "a",
+				OpType:    "ScatterND",
+				Input:     []string{"x", "i", "u"},
+				Output:    []string{"a_out"},
+				Attribute: []*onnx.AttributeProto{{Name: "reduction", S: []byte("add")}},
+			},
+			{Name: "b", OpType: "Shape", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "CastLike", Input: []string{"b_out", "a_out"}, Output: []string{"c_out"}},
+			{Name: "d", OpType: "Add", Input: []string{"a_out", "c_out"}, Output: []string{"z"}},
+		},
+	)
+	mp.OpsetImport[0].Version = 16
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{
+		"x": tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{1, 2})),
+		"i": tensor.New(tensor.WithShape(1, 1), tensor.WithBacking([]int64{1})),
+		"u": tensor.New(tensor.WithShape(1), tensor.WithBacking([]float32{3})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{3, 7}, outputs["z"].Data())
+}
+
+func TestModelOpset18(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "axes"},
+		[]string{"y", "z"},
+		[]*onnx.NodeProto{
+			{
+				Name:      "a",
+				OpType:    "ReduceMax",
+				Input:     []string{"x", "axes"},
+				Output:    []string{"a_out"},
+				Attribute: []*onnx.AttributeProto{{Name: "keepdims", I: 0}},
+			},
+			{
+				Name:      "b",
+				OpType:    "Split",
+				Input:     []string{"a_out"},
+				Output:    []string{"y", "z"},
+				Attribute: []*onnx.AttributeProto{{Name: "num_outputs", I: 2}},
+			},
+		},
+	)
+	mp.OpsetImport[0].Version = 18
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{
+		"x":    tensor.New(tensor.WithShape(2, 2), tensor.WithBacking([]float32{1, 2, 3, 4})),
+		"axes": tensor.New(tensor.WithShape(1), tensor.WithBacking([]int64{1})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{2}, outputs["y"].Data())
+	assert.Equal(t, []float32{4}, outputs["z"].Data())
+}
+
+func TestModelOpset20(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Gelu", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Greater", Input: []string{"a_out", "y"}, Output: []string{"b_out"}},
+			{
+				Name:      "c",
+				OpType:    "ReduceMax",
+				Input:     []string{"b_out"},
+				Output:    []string{"z"},
+				Attribute: []*onnx.AttributeProto{{Name: "keepdims", I: 0}},
+			},
+		},
+	)
+	mp.OpsetImport[0].Version = 20
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(Tensors{
+		"x": tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{-1, 1})),
+		"y": tensor.New(tensor.WithShape(2), tensor.WithBacking([]float32{0, 0})),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, true, outputs["z"].Data())
+}
+
+func TestResolveMLOperatorGetter(t *testing.T) {
+	opGetter, err := ResolveMLOperatorGetter(3)
+	assert.Nil(t, err)
+
+	op, err := opGetter("Scaler")
+	assert.Nil(t, err)
+	assert.IsType(t, &opset13.Scaler{}, op)
+
+	opGetter, err = ResolveMLOperatorGetter(99)
 	assert.Nil(t, opGetter)
 	assert.Equal(t, ops.ErrUnsupportedOpsetVersion, err)
 }
+
+func TestNewOpsets(t *testing.T) {
+	o := newOpsets([]*onnx.OperatorSetIdProto{
+		{Version: 13},
+		{Domain: DomainML, Version: 3},
+		{Domain: "com.example", Version: 100},
+	})
+
+	tests := []struct {
+		domain   string
+		version  int64
+		imported bool
+	}{
+		{"", 13, true},
+		{DomainONNX, 13, true},
+		{DomainML, 3, true},
+		{"com.example", 100, true},
+		{"com.other", 0, false},
+	}
+
+	for _, test := range tests {
+		version, ok := o.version(test.domain)
+		assert.Equal(t, test.version, version)
+		assert.Equal(t, test.imported, ok)
+	}
+}
+
+func TestModelOpsetPerDomain(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.Register("com.example", "MyAbs", 100, opFactoryFixture("Abs")))
+
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "MyAbs", Domain: "com.example", Input: []string{"x"}, Output: []string{"a_out"}},
+			{
+				Name:   "b",
+				OpType: "Scaler",
+				Domain: DomainML,
+				Input:  []string{"a_out"},
+				Output: []string{"z"},
+				Attribute: []*onnx.AttributeProto{
+					{Name: "offset", Floats: []float32{1}},
+					{Name: "scale", Floats: []float32{2}},
+				},
+			},
+		},
+	)
+	mp.OpsetImport = append(
+		mp.OpsetImport,
+		&onnx.OperatorSetIdProto{Domain: DomainML, Version: 3},
+		&onnx.OperatorSetIdProto{Domain: "com.example", Version: 100},
+	)
+
+	model, err := NewModel(mp, WithRegistry(registry))
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{-1, 2}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{0, 2}, outputs["z"].Data())
+
+	mp.OpsetImport[1].Version = 99
+
+	_, err = NewModel(mp, WithRegistry(registry))
+	assert.Equal(t, ops.ErrUnsupportedOpsetVersion, err)
+
+	mp.OpsetImport = mp.OpsetImport[:1]
+
+	_, err = NewModel(mp, WithRegistry(registry))
+	assert.Equal(t, ErrModel("domain %v of node %v is not imported by the model", "com.example", "a"), err)
+}
@@ -0,0 +1,121 @@
+package gonnx
+
+// RunOption configures a single run of a model.
+type RunOption func(*runConfig)
+
+// runConfig holds the configuration of a single run, as set by the run options.
+type runConfig struct {
+	outputs       []string
+	intermediates []string
+}
+
+// WithOutputs restricts a run to the given outputs of the graph. Only the nodes needed to
+// compute these outputs are executed, and only the inputs needed by those nodes have to
+// be given.
+func WithOutputs(names ...string) RunOption {
+	return func(c *runConfig) {
+		c.outputs = append(c.outputs, names...)
+	}
+}
+
+// WithIntermediates requests the tensors with the given names to be returned by a run,
+// in addition to the outputs of the graph. Any tensor in the graph can be requested,
+// like the outputs of the nodes inside the graph. The requested tensors are kept alive
+// until the end of the run.
+func WithIntermediates(names ...string) RunOption {
+	return func(c *runConfig) {
+		c.intermediates = append(c.intermediates, names...)
+	}
+}
+
+func newRunConfig(opts []RunOption) *runConfig {
+	c := &runConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// ModelOption configures a model when it is created.
+type ModelOption func(*modelConfig)
+
+// modelConfig holds the configuration of a model, as set by the model options.
+type modelConfig struct {
+	registry      *Registry
+	mapParameters bool
+	observer      Observer
+	workers       int
+}
+
+// WithRegistry sets the registry used to resolve the operators of the model, instead of
+// the default registry.
+func WithRegistry(registry *Registry) ModelOption {
+	return func(c *modelConfig) {
+		c.registry = registry
+	}
+}
+
+// WithMappedParameters backs the numeric parameters of the model directly by their raw
+// data, instead of decoding the data element by element. Parameters stored as external
+// data are mapped into memory on platforms which support it, when the model is loaded
+// from a file on disk. The model should be closed once it is not used anymore, which
+// releases the mappings.
+func WithMappedParameters() ModelOption {
+	return func(c *modelConfig) {
+		c.mapParameters = true
+	}
+}
+
+// WithObserver sets an observer which is called before and after every node that is
+// executed. A Profiler can be used as observer to profile the model.
+func WithObserver(observer Observer) ModelOption {
+	return func(c *modelConfig) {
+		c.observer = observer
+	}
+}
+
+// WithWorkers sets the maximum number of nodes executed at the same time during a run.
+// Nodes which do not depend on each other, like the branches of a graph, are then
+// executed in parallel. When it is at most 1, which is the default, the nodes are
+// executed one after another. The results are the same in both cases.
+func WithWorkers(workers int) ModelOption {
+	return func(c *modelConfig) {
+		c.workers = workers
+	}
+}
+
+func newModelConfig(opts []ModelOption) *modelConfig {
+	c := &modelConfig{registry: DefaultRegistry}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// ProfilerOption configures a profiler when it is created.
+type ProfilerOption func(*profilerConfig)
+
+// profilerConfig holds the configuration of a profiler, as set by the profiler options.
+type profilerConfig struct {
+	maxTraceEvents int
+}
+
+// WithTrace makes the profiler keep a trace event for every executed node, which can be
+// written with WriteTrace. Only the last maxEvents events are kept, such that profiling
+// a model over many runs does not take an unbounded amount of memory.
+func WithTrace(maxEvents int) ProfilerOption {
+	return func(c *profilerConfig) {
+		c.maxTraceEvents = maxEvents
+	}
+}
+
+func newProfilerConfig(opts []ProfilerOption) *profilerConfig {
+	c := &profilerConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
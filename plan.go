@@ -0,0 +1,393 @@
+package gonnx
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+)
+
+// noSlot is used for empty input and output names of a node. ONNX uses an empty name to
+// skip an optional input, in which case the operator receives a nil tensor, or to skip an
+// optional output, in which case the output of the operator is dropped.
+const noSlot = -1
+
+// nodeOpGetter gets the operator of a node.
+type nodeOpGetter func(*onnx.NodeProto) (ops.Operator, error)
+
+// step is a single node of the graph, compiled into an initialized operator. The
+// names of the inputs and outputs of the node are resolved to slots. A slot is an
+// index in the list of tensors that is kept during a single run of the model.
+type step struct {
+	node    *onnx.NodeProto
+	index   int
+	op      ops.Operator
+	inputs  []int
+	outputs []int
+
+	// free holds the slots of the tensors that are not used anymore after this step.
+	free []int
+
+	// deps is the number of steps producing the inputs of this step, and next holds the
+	// indices of the steps which use the outputs of this step.
+	deps int
+	next []int
+}
+
+// plan is the compiled form of a graph. It is created once when the model is loaded,
+// after which it can be executed for every run without resolving or initializing
+// operators again.
+type plan struct {
+	steps []*step
+
+	// inputs holds the names of the inputs of the graph needed by the steps.
+	inputs []string
+
+	// slots maps every tensor name in the graph to its slot.
+	slots map[string]int
+
+	// consumers holds for every slot how often it is used as input by the steps, and
+	// freeable marks the slots that may be dropped once they are not used anymore.
+	consumers []int
+	freeable  []bool
+}
+
+// newPlan compiles the nodes of the graph into a plan. The nodes are sorted such that
+// every node comes after the nodes it depends on. Every node gets its operator resolved
+// and initialized, hence invalid graphs, operators or attributes are reported here.
+func newPlan(graph *onnx.GraphProto, getOperator nodeOpGetter) (*plan, error) {
+	order, err := sortNodes(graph)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{inputs: graph.InputNames(), slots: make(map[string]int)}
+
+	for _, name := range graph.InputNames() {
+		p.slot(name)
+	}
+
+	for _, name := range graph.ParamNames() {
+		p.slot(name)
+	}
+
+	for _, index := range order {
+		n := graph.GetNode()[index]
+
+		op, err := getOperator(n)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := op.Init(n); err != nil {
+			return nil, err
+		}
+
+		s := &step{
+			node:    n,
+			index:   index,
+			op:      op,
+			inputs:  make([]int, len(n.GetInput())),
+			outputs: make([]int, len(n.GetOutput())),
+		}
+
+		for i, name := range n.GetInput() {
+			// An empty name can happen in between optional inputs, like:
+			//   [<required_input>, <optional_input>, nil, <optional_input>]
+			// In such a case, ONNX includes the name of the input in the node, and we need
+			// to set a value (nil) for it, although it will not be used.
+			if name == "" {
+				s.inputs[i] = noSlot
+			} else {
+				s.inputs[i] = p.slot(name)
+			}
+		}
+
+		// Skipped outputs do not share a slot, as steps executed at the same time would
+		// write to it at the same time.
+		for i, name := range n.GetOutput() {
+			if name == "" {
+				s.outputs[i] = noSlot
+			} else {
+				s.outputs[i] = p.slot(name)
+			}
+		}
+
+		p.steps = append(p.steps, s)
+	}
+
+	for _, name := range graph.OutputNames() {
+		p.slot(name)
+	}
+
+	p.setFreeSlots(graph.OutputNames())
+	p.setDependencies()
+
+	return p, nil
+}
+
+// setFreeSlots determines for every tensor produced by a step which step uses it last.
+// That step gets the slot of the tensor in its free list, such that the tensor can be
+// dropped as soon as it is not needed anymore. Tensors that are not used by any step are
+// dropped right after the step producing them. Outputs of the graph are never dropped.
+// Inputs and parameters of the graph are never dropped either, as they are owned by
+// the caller and the model, hence dropping them would not free any memory.
+func (p *plan) setFreeSlots(outputNames []string) {
+	keep := make(map[int]bool, len(outputNames))
+	for _, name := range outputNames {
+		keep[p.slots[name]] = true
+	}
+
+	lastUse := make(map[int]int)
+
+	for i, s := range p.steps {
+		for _, slot := range s.outputs {
+			if slot != noSlot {
+				lastUse[slot] = i
+			}
+		}
+
+		for _, slot := range s.inputs {
+			if _, ok := lastUse[slot]; ok {
+				lastUse[slot] = i
+			}
+		}
+	}
+
+	p.freeable = make([]bool, len(p.slots))
+
+	// Iterating over the steps again keeps the order of the free lists deterministic.
+	for _, s := range p.steps {
+		for _, slot := range s.outputs {
+			if slot == noSlot || keep[slot] {
+				continue
+			}
+
+			last := p.steps[lastUse[slot]]
+			last.free = append(last.free, slot)
+			p.freeable[slot] = true
+		}
+	}
+}
+
+// setDependencies links every step to the steps producing its inputs, which is used to
+// execute steps that do not depend on each other in parallel. It also counts how often
+// every slot is used, such that tensors can be dropped when executing in parallel, where
+// the last step using a tensor is not known beforehand.
+func (p *plan) setDependencies() {
+	producers := make(map[int]int)
+	p.consumers = make([]int, len(p.slots))
+
+	for i, s := range p.steps {
+		deps := make(map[int]bool)
+
+		for _, slot := range s.inputs {
+			if slot == noSlot {
+				continue
+			}
+
+			p.consumers[slot]++
+
+			if j, ok := producers[slot]; ok && !deps[j] {
+				deps[j] = true
+				s.deps++
+				p.steps[j].next = append(p.steps[j].next, i)
+			}
+		}
+
+		for _, slot := range s.outputs {
+			if slot != noSlot {
+				producers[slot] = i
+			}
+		}
+	}
+}
+
+// prune returns a plan with only the steps needed to compute the tensors with the given
+// names, which must all have a slot. The steps are copied, because which tensors can be
+// dropped after a step depends on the steps that are left.
+func (p *plan) prune(names []string) *plan {
+	needed := make(map[int]bool, len(names))
+	for _, name := range names {
+		needed[p.slots[name]] = true
+	}
+
+	// The steps are sorted, hence going through them backwards visits every step after
+	// all steps that need its outputs.
+	var steps []*step
+
+	for i := len(p.steps) - 1; i >= 0; i-- {
+		s := p.steps[i]
+		if !slices.ContainsFunc(s.outputs, func(slot int) bool { return needed[slot] }) {
+			continue
+		}
+
+		for _, slot := range s.inputs {
+			if slot != noSlot {
+				needed[slot] = true
+			}
+		}
+
+		pruned := *s
+		pruned.free = nil
+		pruned.deps = 0
+		pruned.next = nil
+		steps = append(steps, &pruned)
+	}
+
+	slices.Reverse(steps)
+
+	pruned := &plan{steps: steps, slots: p.slots}
+
+	for _, name := range p.inputs {
+		if needed[p.slots[name]] {
+			pruned.inputs = append(pruned.inputs, name)
+		}
+	}
+
+	pruned.setFreeSlots(names)
+	pruned.setDependencies()
+
+	return pruned
+}
+
+// slot returns the slot for a tensor name, creating a new one if the name has none yet.
+func (p *plan) slot(name string) int {
+	if s, ok := p.slots[name]; ok {
+		return s
+	}
+
+	s := len(p.slots)
+	p.slots[name] = s
+
+	return s
+}
+
+// newSlots returns an empty list of values with room for every slot of the plan.
+func (p *plan) newSlots() []ops.Value {
+	return make([]ops.Value, len(p.slots))
+}
+
+// Visit states of a node while sorting the graph.
+const (
+	unvisited = iota
+	visiting
+	visited
+)
+
+// sortNodes sorts the nodes of the graph topologically, such that all nodes producing
+// the inputs of a node come before that node. It returns the indices of the nodes in the
+// sorted order. Nodes which do not depend on each other
+// keep the order in which they appear in the graph. An error is returned if the graph
+// contains a cycle, or if a node has an input which is not produced by any node and is
+// not an input or initializer of the graph either.
+func sortNodes(graph *onnx.GraphProto) ([]int, error) {
+	nodes := graph.GetNode()
+
+	available := make(map[string]bool)
+	for _, name := range graph.InputNames() {
+		available[name] = true
+	}
+
+	for _, name := range graph.ParamNames() {
+		available[name] = true
+	}
+
+	producers := make(map[string]int, len(nodes))
+
+	for i, n := range nodes {
+		for _, name := range n.GetOutput() {
+			if name == "" {
+				continue
+			}
+
+			if j, ok := producers[name]; ok {
+				return nil, ErrModel(
+					"tensor %v is produced by both node %v and node %v",
+					name, nodeDescription(nodes[j], j), nodeDescription(n, i),
+				)
+			}
+
+			producers[name] = i
+		}
+	}
+
+	for _, name := range graph.OutputNames() {
+		if _, ok := producers[name]; !ok && !available[name] {
+			return nil, ErrModel("graph output %v is not produced by any node", name)
+		}
+	}
+
+	sorted := make([]int, 0, len(nodes))
+	states := make([]int, len(nodes))
+
+	var visit func(i int) error
+
+	visit = func(i int) error {
+		if states[i] == visited {
+			return nil
+		}
+
+		states[i] = visiting
+
+		for _, name := range nodes[i].GetInput() {
+			if name == "" {
+				continue
+			}
+
+			j, ok := producers[name]
+			if !ok {
+				if available[name] {
+					continue
+				}
+
+				return ErrModel(
+					"input %v of node %v is not produced by any node, nor is it an input or initializer of the graph",
+					name, nodeDescription(nodes[i], i),
+				)
+			}
+
+			// The producer of the input is still being visited, which means it depends
+			// on the current node. Hence, the graph contains a cycle.
+			if states[j] == visiting {
+				return ErrModel(
+					"graph contains a cycle: input %v of node %v is produced by node %v, which depends on node %v",
+					name, nodeDescription(nodes[i], i), nodeDescription(nodes[j], j), nodeDescription(nodes[i], i),
+				)
+			}
+
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+
+		states[i] = visited
+		sorted = append(sorted, i)
+
+		return nil
+	}
+
+	for i := range nodes {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
+// nodeDescription describes a node for use in error messages. Nodes are not required to
+// have a name, in which case the index of the node in the graph is used.
+func nodeDescription(n *onnx.NodeProto, index int) string {
+	if n.GetName() != "" {
+		return fmt.Sprintf("%v (%v)", n.GetName(), n.GetOpType())
+	}
+
+	return fmt.Sprintf("%d (%v)", index, n.GetOpType())
+}
+
+// String describes the node of the step, which is used in error messages.
+func (s *step) String() string {
+	return nodeDescription(s.node, s.index)
+}
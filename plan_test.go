@@ -0,0 +1,246 @@
+package gonnx
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortNodes(t *testing.T) {
+	tests := []struct {
+		inputs   []string
+		outputs  []string
+		nodes    []*onnx.NodeProto
+		expected []string
+		err      error
+	}{
+		{
+			[]string{"x"},
+			[]string{"z"},
+			[]*onnx.NodeProto{
+				{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"y"}},
+				{Name: "b", OpType: "Relu", Input: []string{"y"}, Output: []string{"z"}},
+			},
+			[]string{"a", "b"},
+			nil,
+		},
+		{
+			[]string{"x"},
+			[]string{"z"},
+			[]*onnx.NodeProto{
+				{Name: "b", OpType: "Relu", Input: []string{"y"}, Output: []string{"z"}},
+				{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"y"}},
+			},
+			[]string{"a", "b"},
+			nil,
+		},
+		{
+			[]string{"x"},
+			[]string{"out"},
+			[]*onnx.NodeProto{
+				{Name: "d", OpType: "Add", Input: []string{"b_out", "c_out"}, Output: []string{"out"}},
+				{Name: "c", OpType: "Abs", Input: []string{"a_out"}, Output: []string{"c_out"}},
+				{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+				{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			},
+			[]string{"a", "b", "c", "d"},
+			nil,
+		},
+		{
+			[]string{"x"},
+			[]string{"y"},
+			[]*onnx.NodeProto{
+				{Name: "a", OpType: "Gemm", Input: []string{"x", "w", ""}, Output: []string{"y"}},
+			},
+			nil,
+			ErrModel(
+				"input %v of node %v is not produced by any node, nor is it an input or initializer of the graph",
+				"w", "a (Gemm)",
+			),
+		},
+		{
+			[]string{"x"},
+			[]string{"z"},
+			[]*onnx.NodeProto{
+				{Name: "a", OpType: "Add", Input: []string{"x", "z"}, Output: []string{"y"}},
+				{Name: "b", OpType: "Relu", Input: []string{"y"}, Output: []string{"z"}},
+			},
+			nil,
+			ErrModel(
+				"graph contains a cycle: input %v of node %v is produced by node %v, which depends on node %v",
+				"y", "b (Relu)", "a (Add)", "b (Relu)",
+			),
+		},
+		{
+			[]string{"x"},
+			[]string{"y"},
+			[]*onnx.NodeProto{
+				{OpType: "Abs", Input: []string{"x"}, Output: []string{"y"}},
+				{OpType: "Relu", Input: []string{"x"}, Output: []string{"y"}},
+			},
+			nil,
+			ErrModel("tensor %v is produced by both node %v and node %v", "y", "0 (Abs)", "1 (Relu)"),
+		},
+		{
+			[]string{"x"},
+			[]string{"y", "unknown"},
+			[]*onnx.NodeProto{
+				{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"y"}},
+			},
+			nil,
+			ErrModel("graph output %v is not produced by any node", "unknown"),
+		},
+	}
+
+	for _, test := range tests {
+		mp := modelProtoFixture(test.inputs, test.outputs, test.nodes)
+
+		sorted, err := sortNodes(mp.Graph)
+		assert.Equal(t, test.err, err)
+
+		if test.err == nil {
+			names := make([]string, len(sorted))
+			for i, index := range sorted {
+				names[i] = test.nodes[index].GetName()
+			}
+
+			assert.Equal(t, test.expected, names)
+		}
+	}
+}
+
+func TestModelRunUnsortedGraph(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "relu", OpType: "Relu", Input: []string{"y"}, Output: []string{"z"}},
+			{Name: "abs", OpType: "Abs", Input: []string{"x"}, Output: []string{"y"}},
+		},
+	)
+
+	model, err := NewModel(mp)
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{{-2, -1, 0, 1}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{2, 1, 0, 1}, outputs["z"].Data())
+}
+
+func TestPlanFreeSlots(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z", "w"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Add", Input: []string{"a_out", "b_out"}, Output: []string{"z"}},
+			{Name: "d", OpType: "Abs", Input: []string{"x"}, Output: []string{"unused"}},
+			{Name: "e", OpType: "Relu", Input: []string{"z"}, Output: []string{"w"}},
+		},
+	)
+
+	p, err := newPlan(mp.Graph, opGetterFixture)
+	assert.Nil(t, err)
+
+	names := make(map[int]string, len(p.slots))
+	for name, slot := range p.slots {
+		names[slot] = name
+	}
+
+	free := make(map[string][]string, len(p.steps))
+
+	for _, s := range p.steps {
+		for _, slot := range s.free {
+			free[s.node.GetName()] = append(free[s.node.GetName()], names[slot])
+		}
+	}
+
+	assert.Equal(t, map[string][]string{"c": {"a_out", "b_out"}, "d": {"unused"}}, free)
+}
+
+func TestPlanPrune(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x", "y"},
+		[]string{"z1", "z2"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"y"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"z1"}},
+			{Name: "d", OpType: "Add", Input: []string{"a_out", "b_out"}, Output: []string{"z2"}},
+		},
+	)
+
+	p, err := newPlan(mp.Graph, opGetterFixture)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		names         []string
+		expectedSteps []string
+		expectedInput []string
+	}{
+		{[]string{"z1", "z2"}, []string{"a", "b", "c", "d"}, []string{"x", "y"}},
+		{[]string{"z1"}, []string{"a", "c"}, []string{"x"}},
+		{[]string{"z2"}, []string{"a", "b", "d"}, []string{"x", "y"}},
+		{[]string{"b_out"}, []string{"b"}, []string{"y"}},
+		{[]string{"x"}, nil, []string{"x"}},
+	}
+
+	for _, test := range tests {
+		pruned := p.prune(test.names)
+
+		var steps []string
+		for _, s := range pruned.steps {
+			steps = append(steps, s.node.GetName())
+		}
+
+		assert.Equal(t, test.expectedSteps, steps)
+		assert.Equal(t, test.expectedInput, pruned.inputs)
+	}
+
+	// Pruning copies the steps, hence the free lists of the compiled plan are unchanged.
+	assert.Empty(t, p.steps[2].free)
+	assert.Equal(t, []int{p.slots["a_out"], p.slots["b_out"]}, p.steps[3].free)
+}
+
+func TestPlanDependencies(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{Name: "c", OpType: "Abs", Input: []string{"a_out"}, Output: []string{"c_out"}},
+			{Name: "d", OpType: "Mul", Input: []string{"b_out", "b_out"}, Output: []string{"d_out"}},
+			{Name: "e", OpType: "Add", Input: []string{"c_out", "d_out"}, Output: []string{"z"}},
+		},
+	)
+
+	p, err := newPlan(mp.Graph, opGetterFixture)
+	assert.Nil(t, err)
+
+	deps := make([]int, len(p.steps))
+	next := make([][]int, len(p.steps))
+
+	for i, s := range p.steps {
+		deps[i] = s.deps
+		next[i] = s.next
+	}
+
+	assert.Equal(t, []int{0, 1, 1, 1, 2}, deps)
+	assert.Equal(t, [][]int{{1, 2}, {3}, {4}, {4}, nil}, next)
+	assert.Equal(t, 2, p.consumers[p.slots["a_out"]])
+	assert.Equal(t, 2, p.consumers[p.slots["b_out"]])
+	assert.Equal(t, 0, p.consumers[p.slots["z"]])
+	assert.True(t, p.freeable[p.slots["d_out"]])
+	assert.False(t, p.freeable[p.slots["z"]])
+	assert.False(t, p.freeable[p.slots["x"]])
+}
+
+// opGetterFixture gets the operators of nodes from opset 13.
+func opGetterFixture(n *onnx.NodeProto) (ops.Operator, error) {
+	return opset13.GetOperator(n.GetOpType())
+}
@@ -0,0 +1,287 @@
+package gonnx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+)
+
+// ProfileStats holds the aggregated statistics of the executions of a single node, or of
+// all nodes with the same operator type.
+type ProfileStats struct {
+	// Name is the name of the node, or the operator type for the statistics per type.
+	Name   string
+	OpType string
+	Calls  int
+
+	// Duration is the total wall-clock time spent executing.
+	Duration time.Duration
+
+	// Bytes is the total number of bytes allocated for the output tensors.
+	Bytes int64
+}
+
+// AvgDuration returns the average wall-clock time per execution.
+func (s ProfileStats) AvgDuration() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+
+	return s.Duration / time.Duration(s.Calls)
+}
+
+// traceEvent is a complete event in the Chrome trace event format.
+type traceEvent struct {
+	Name      string         `json:"name"`
+	Category  string         `json:"cat"`
+	Phase     string         `json:"ph"`
+	Timestamp int64          `json:"ts"`
+	Duration  int64          `json:"dur"`
+	ProcessID int            `json:"pid"`
+	ThreadID  int            `json:"tid"`
+	Args      map[string]any `json:"args"`
+}
+
+// Profiler is an observer which aggregates the time spent, the number of calls and the
+// bytes allocated per operator type and per node, over all runs of the models it observes.
+// To profile a model, give the profiler to the model as its observer:
+//
+//	profiler := gonnx.NewProfiler()
+//	model, err := gonnx.NewModel(mp, gonnx.WithObserver(profiler))
+//
+// Next to the aggregated statistics, a profiler created with WithTrace keeps an event for
+// the last executed nodes, which can be written in the Chrome trace event format. Call
+// Reset to discard everything recorded.
+type Profiler struct {
+	mu      sync.Mutex
+	start   time.Time
+	opTypes map[string]*ProfileStats
+	nodes   map[string]*ProfileStats
+
+	// events is a ring buffer with the last maxEvents trace events, in which nextEvent is
+	// the position of the oldest event once the buffer is full.
+	events    []traceEvent
+	maxEvents int
+	nextEvent int
+
+	// lanes holds the end time of the last event on every thread of the trace. Nodes
+	// which are executed at the same time are put on different threads.
+	lanes []time.Time
+}
+
+// NewProfiler creates a new profiler. Trace events are only recorded when the profiler
+// is created with the WithTrace option.
+func NewProfiler(opts ...ProfilerOption) *Profiler {
+	config := newProfilerConfig(opts)
+
+	p := &Profiler{maxEvents: config.maxTraceEvents}
+	p.Reset()
+
+	return p
+}
+
+// Reset discards everything the profiler has recorded.
+func (p *Profiler) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.start = time.Now()
+	p.opTypes = make(map[string]*ProfileStats)
+	p.nodes = make(map[string]*ProfileStats)
+	p.events = nil
+	p.nextEvent = 0
+	p.lanes = nil
+}
+
+// BeforeNode implements the Observer interface. The profiler only records nodes when
+// they are done.
+func (p *Profiler) BeforeNode(ctx context.Context, _ *NodeEvent) context.Context {
+	return ctx
+}
+
+// AfterNode implements the Observer interface and records the execution of a node.
+func (p *Profiler) AfterNode(_ context.Context, event *NodeEvent) {
+	end := time.Now()
+	start := end.Add(-event.Duration)
+	opType := event.Node.GetOpType()
+	name := profileNodeName(event.Node)
+
+	var bytes int64
+	for _, output := range event.OutputValues {
+		bytes += ops.ValueBytes(output)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	addProfileStats(p.opTypes, opType, opType, event.Duration, bytes)
+	addProfileStats(p.nodes, name, opType, event.Duration, bytes)
+
+	if p.maxEvents <= 0 {
+		return
+	}
+
+	p.addEvent(traceEvent{
+		Name:      name,
+		Category:  "Node",
+		Phase:     "X",
+		Timestamp: start.Sub(p.start).Microseconds(),
+		Duration:  event.Duration.Microseconds(),
+		ThreadID:  p.lane(start, end),
+		Args:      map[string]any{"op_type": opType, "bytes": bytes},
+	})
+}
+
+// addEvent adds a trace event, replacing the oldest event when maxEvents are kept already.
+func (p *Profiler) addEvent(event traceEvent) {
+	if len(p.events) < p.maxEvents {
+		p.events = append(p.events, event)
+		return
+	}
+
+	p.events[p.nextEvent] = event
+	p.nextEvent = (p.nextEvent + 1) % p.maxEvents
+}
+
+// lane returns the first thread of the trace which is free at the start of an event, and
+// marks it as busy until the end of the event.
+func (p *Profiler) lane(start, end time.Time) int {
+	for i, laneEnd := range p.lanes {
+		if !laneEnd.After(start) {
+			p.lanes[i] = end
+			return i
+		}
+	}
+
+	p.lanes = append(p.lanes, end)
+
+	return len(p.lanes) - 1
+}
+
+// OpTypeStats returns the statistics per operator type, sorted by the total time spent,
+// the most expensive operator type first.
+func (p *Profiler) OpTypeStats() []ProfileStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return sortedProfileStats(p.opTypes)
+}
+
+// NodeStats returns the statistics per node, sorted by the total time spent, the most
+// expensive node first. Nodes without a name are identified by their first output.
+func (p *Profiler) NodeStats() []ProfileStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return sortedProfileStats(p.nodes)
+}
+
+// WriteTable writes the statistics per operator type and per node as text tables.
+func (p *Profiler) WriteTable(w io.Writer) error {
+	opTypes := p.OpTypeStats()
+	nodes := p.NodeStats()
+
+	var total time.Duration
+	for _, stats := range opTypes {
+		total += stats.Duration
+	}
+
+	if err := writeProfileTable(w, "op type", opTypes, total, false); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	return writeProfileTable(w, "node", nodes, total, true)
+}
+
+// WriteTrace writes an event for every recorded execution of a node in the Chrome trace
+// event format, the oldest first. The result can be viewed with chrome://tracing or
+// Perfetto. Without the WithTrace option, the trace is empty.
+func (p *Profiler) WriteTrace(w io.Writer) error {
+	p.mu.Lock()
+	events := make([]traceEvent, 0, len(p.events))
+	events = append(events, p.events[p.nextEvent:]...)
+	events = append(events, p.events[:p.nextEvent]...)
+	p.mu.Unlock()
+
+	return json.NewEncoder(w).Encode(map[string]any{"traceEvents": events})
+}
+
+func addProfileStats(stats map[string]*ProfileStats, name, opType string, duration time.Duration, bytes int64) {
+	s, ok := stats[name]
+	if !ok {
+		s = &ProfileStats{Name: name, OpType: opType}
+		stats[name] = s
+	}
+
+	s.Calls++
+	s.Duration += duration
+	s.Bytes += bytes
+}
+
+func sortedProfileStats(stats map[string]*ProfileStats) []ProfileStats {
+	res := make([]ProfileStats, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, *s)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Duration != res[j].Duration {
+			return res[i].Duration > res[j].Duration
+		}
+
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// writeProfileTable writes the statistics as a table, with the share of every row in the
+// total time as a percentage.
+func writeProfileTable(
+	w io.Writer, nameHeader string, stats []ProfileStats, total time.Duration, withOpType bool,
+) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "%v\tcalls\ttotal\tavg\t%%\tbytes\n", nameHeader)
+
+	for _, s := range stats {
+		var percentage float64
+		if total > 0 {
+			percentage = 100 * float64(s.Duration) / float64(total)
+		}
+
+		name := s.Name
+		if withOpType {
+			name = fmt.Sprintf("%v (%v)", s.Name, s.OpType)
+		}
+
+		fmt.Fprintf(
+			tw, "%v\t%d\t%v\t%v\t%.1f\t%d\n",
+			name, s.Calls, s.Duration, s.AvgDuration(), percentage, s.Bytes,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// profileNodeName returns the name of a node for the profiler. Nodes are not required to
+// have a name, in which case the name of the first output is used, which is unique.
+func profileNodeName(n *onnx.NodeProto) string {
+	if n.GetName() != "" || len(n.GetOutput()) == 0 {
+		return n.GetName()
+	}
+
+	return n.GetOutput()[0]
+}
@@ -0,0 +1,141 @@
+package gonnx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfiler(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"b_out"}},
+			{OpType: "Abs", Input: []string{"b_out"}, Output: []string{"z"}},
+		},
+	)
+
+	profiler := NewProfiler(WithTrace(100))
+
+	model, err := NewModel(mp, WithObserver(profiler))
+	assert.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{rangeFloat(4)}))
+		assert.Nil(t, err)
+	}
+
+	opTypes := map[string]ProfileStats{}
+	for _, stats := range profiler.OpTypeStats() {
+		opTypes[stats.Name] = stats
+	}
+
+	assert.Equal(t, 2, len(opTypes))
+	assert.Equal(t, 6, opTypes["Abs"].Calls)
+	assert.Equal(t, int64(6*16), opTypes["Abs"].Bytes)
+	assert.Equal(t, 3, opTypes["Relu"].Calls)
+	assert.Equal(t, int64(3*16), opTypes["Relu"].Bytes)
+
+	nodes := profiler.NodeStats()
+	assert.Equal(t, 3, len(nodes))
+
+	for i, stats := range nodes {
+		assert.Equal(t, 3, stats.Calls)
+		assert.Equal(t, stats.Duration/3, stats.AvgDuration())
+
+		if i > 0 {
+			assert.GreaterOrEqual(t, nodes[i-1].Duration, stats.Duration)
+		}
+	}
+
+	table := &bytes.Buffer{}
+	assert.Nil(t, profiler.WriteTable(table))
+	assert.True(t, strings.HasPrefix(table.String(), "op type"))
+	assert.Contains(t, table.String(), "Relu")
+	assert.Contains(t, table.String(), "z (Abs)")
+
+	trace := &bytes.Buffer{}
+	assert.Nil(t, profiler.WriteTrace(trace))
+
+	var decoded struct {
+		TraceEvents []traceEvent `json:"traceEvents"`
+	}
+
+	assert.Nil(t, json.Unmarshal(trace.Bytes(), &decoded))
+	assert.Equal(t, 9, len(decoded.TraceEvents))
+	assert.Equal(t, "a", decoded.TraceEvents[0].Name)
+	assert.Equal(t, "X", decoded.TraceEvents[0].Phase)
+	assert.Equal(t, "Abs", decoded.TraceEvents[0].Args["op_type"])
+
+	profiler.Reset()
+	assert.Empty(t, profiler.OpTypeStats())
+	assert.Empty(t, profiler.NodeStats())
+}
+
+func TestProfilerTraceEvents(t *testing.T) {
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"y"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "Abs", Input: []string{"x"}, Output: []string{"a_out"}},
+			{Name: "b", OpType: "Relu", Input: []string{"a_out"}, Output: []string{"y"}},
+		},
+	)
+
+	tests := []struct {
+		profiler *Profiler
+		expected []string
+	}{
+		{NewProfiler(), []string{}},
+		{NewProfiler(WithTrace(3)), []string{"b", "a", "b"}},
+		{NewProfiler(WithTrace(10)), []string{"a", "b", "a", "b", "a", "b"}},
+	}
+
+	for _, test := range tests {
+		model, err := NewModel(mp, WithObserver(test.profiler))
+		assert.Nil(t, err)
+
+		for i := 0; i < 3; i++ {
+			_, err = model.Run(tensorsFixture([]string{"x"}, [][]int{{4}}, [][]float32{rangeFloat(4)}))
+			assert.Nil(t, err)
+		}
+
+		trace := &bytes.Buffer{}
+		assert.Nil(t, test.profiler.WriteTrace(trace))
+
+		var decoded struct {
+			TraceEvents []traceEvent `json:"traceEvents"`
+		}
+
+		assert.Nil(t, json.Unmarshal(trace.Bytes(), &decoded))
+
+		names := []string{}
+		for _, event := range decoded.TraceEvents {
+			names = append(names, event.Name)
+		}
+
+		assert.Equal(t, test.expected, names)
+
+		// The aggregated statistics cover all runs, regardless of the trace.
+		for _, stats := range test.profiler.NodeStats() {
+			assert.Equal(t, 3, stats.Calls)
+		}
+	}
+}
+
+func TestProfilerLane(t *testing.T) {
+	profiler := NewProfiler()
+	start := time.Now()
+
+	assert.Equal(t, 0, profiler.lane(start, start.Add(2*time.Second)))
+	assert.Equal(t, 1, profiler.lane(start.Add(time.Second), start.Add(3*time.Second)))
+	assert.Equal(t, 0, profiler.lane(start.Add(2*time.Second), start.Add(4*time.Second)))
+	assert.Equal(t, 1, profiler.lane(start.Add(3*time.Second), start.Add(4*time.Second)))
+}
@@ -0,0 +1,122 @@
+package gonnx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/advancedclimatesystems/gonnx/ops"
+)
+
+// Domains of the operators defined by ONNX. Nodes without a domain belong to the default
+// ONNX domain.
+const (
+	DomainONNX = "ai.onnx"
+	DomainML   = "ai.onnx.ml"
+)
+
+// ErrOperatorRegistered is returned when an operator is registered twice for the same
+// domain, operator type and version.
+var ErrOperatorRegistered = errors.New("operator already registered")
+
+// OperatorFactory creates a new, uninitialized operator.
+type OperatorFactory func() ops.Operator
+
+type registryKey struct {
+	domain string
+	opType string
+}
+
+type registration struct {
+	sinceVersion int64
+	factory      OperatorFactory
+}
+
+// Registry holds operator factories by domain, operator type and the opset version
+// since which the implementation is valid. It allows for adding custom operators and
+// operators of custom domains, like contrib operators of other runtimes, without
+// changing gonnx. A registry is safe for concurrent use.
+type Registry struct {
+	mu            sync.RWMutex
+	registrations map[registryKey][]registration
+}
+
+// NewRegistry creates a new, empty registry.
+func NewRegistry() *Registry {
+	return &Registry{registrations: make(map[registryKey][]registration)}
+}
+
+// DefaultRegistry is the registry used by models which are not given a registry.
+var DefaultRegistry = NewRegistry()
+
+// RegisterOperator registers an operator factory in the default registry.
+func RegisterOperator(domain, opType string, sinceVersion int64, factory OperatorFactory) error {
+	return DefaultRegistry.Register(domain, opType, sinceVersion, factory)
+}
+
+// Register registers an operator factory for the operator type in the domain. The
+// operator is used for models importing the domain with a version of at least
+// sinceVersion, until a newer version of the operator is registered. An empty domain
+// is the same as the default ONNX domain.
+func (r *Registry) Register(domain, opType string, sinceVersion int64, factory OperatorFactory) error {
+	key := registryKey{normalizeDomain(domain), opType}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registrations := r.registrations[key]
+
+	for _, reg := range registrations {
+		if reg.sinceVersion == sinceVersion {
+			return fmt.Errorf(
+				"%w: %v of domain %v since version %d", ErrOperatorRegistered, opType, key.domain, sinceVersion,
+			)
+		}
+	}
+
+	registrations = append(registrations, registration{sinceVersion, factory})
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].sinceVersion < registrations[j].sinceVersion
+	})
+
+	r.registrations[key] = registrations
+
+	return nil
+}
+
+// mustRegister registers a built-in operator. It panics if the operator is registered
+// twice for the same version, which is a programming error.
+func (r *Registry) mustRegister(domain string, op ops.OperatorVersion) {
+	if err := r.Register(domain, op.OpType, op.SinceVersion, op.New); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the factory of the operator type in the domain for the given opset
+// version of the domain. This is the factory registered with the highest since version
+// which is not higher than the requested version.
+func (r *Registry) Lookup(domain, opType string, version int64) (OperatorFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	registrations := r.registrations[registryKey{normalizeDomain(domain), opType}]
+
+	for i := len(registrations) - 1; i >= 0; i-- {
+		if registrations[i].sinceVersion <= version {
+			return registrations[i].factory, true
+		}
+	}
+
+	return nil, false
+}
+
+// normalizeDomain returns the domain with the default ONNX domain written out, which
+// nodes and opset imports may leave empty.
+func normalizeDomain(domain string) string {
+	if domain == "" {
+		return DomainONNX
+	}
+
+	return domain
+}
@@ -0,0 +1,101 @@
+package gonnx
+
+import (
+	"testing"
+
+	"github.com/advancedclimatesystems/gonnx/onnx"
+	"github.com/advancedclimatesystems/gonnx/ops"
+	"github.com/advancedclimatesystems/gonnx/ops/opset13"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+
+	assert.Nil(t, registry.Register("com.example", "Op", 3, opFactoryFixture("Relu")))
+	assert.Nil(t, registry.Register("com.example", "Op", 1, opFactoryFixture("Abs")))
+	assert.Nil(t, registry.Register("", "Op", 1, opFactoryFixture("Sigmoid")))
+
+	err := registry.Register("com.example", "Op", 3, opFactoryFixture("Abs"))
+	assert.ErrorIs(t, err, ErrOperatorRegistered)
+
+	tests := []struct {
+		domain   string
+		opType   string
+		version  int64
+		expected ops.Operator
+	}{
+		{"com.example", "Op", 1, &opset13.Abs{}},
+		{"com.example", "Op", 2, &opset13.Abs{}},
+		{"com.example", "Op", 3, &opset13.Relu{}},
+		{"com.example", "Op", 100, &opset13.Relu{}},
+		{"com.example", "Op", 0, nil},
+		{"com.example", "Other", 3, nil},
+		{"", "Op", 1, &opset13.Sigmoid{}},
+		{DomainONNX, "Op", 1, &opset13.Sigmoid{}},
+	}
+
+	for _, test := range tests {
+		factory, ok := registry.Lookup(test.domain, test.opType, test.version)
+		assert.Equal(t, test.expected != nil, ok)
+
+		if test.expected != nil {
+			assert.IsType(t, test.expected, factory())
+		}
+	}
+}
+
+func TestModelCustomOperators(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.Register("com.example", "MyAbs", 1, opFactoryFixture("Abs")))
+	assert.Nil(t, registry.Register(DomainONNX, "Relu", 1, opFactoryFixture("Abs")))
+
+	mp := modelProtoFixture(
+		[]string{"x"},
+		[]string{"z", "w"},
+		[]*onnx.NodeProto{
+			{Name: "a", OpType: "MyAbs", Domain: "com.example", Input: []string{"x"}, Output: []string{"z"}},
+			{Name: "b", OpType: "Relu", Input: []string{"x"}, Output: []string{"w"}},
+		},
+	)
+	mp.OpsetImport = append(mp.OpsetImport, &onnx.OperatorSetIdProto{Domain: "com.example", Version: 1})
+
+	model, err := NewModel(mp, WithRegistry(registry))
+	assert.Nil(t, err)
+
+	outputs, err := model.Run(tensorsFixture([]string{"x"}, [][]int{{2}}, [][]float32{{-1, 2}}))
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2}, outputs["z"].Data())
+	assert.Equal(t, []float32{1, 2}, outputs["w"].Data())
+
+	_, err = NewModel(mp)
+	assert.Equal(t, ops.ErrUnknownOperatorType("MyAbs of domain com.example"), err)
+
+	mp.OpsetImport = mp.OpsetImport[:1]
+
+	_, err = NewModel(mp, WithRegistry(registry))
+	assert.Equal(t, ErrModel("domain %v of node %v is not imported by the model", "com.example", "a"), err)
+}
+
+func TestRegisterOperator(t *testing.T) {
+	// The default registry is replaced, such that running the test again does not register
+	// the operator twice.
+	defaultRegistry := DefaultRegistry
+	DefaultRegistry = NewRegistry()
+
+	t.Cleanup(func() { DefaultRegistry = defaultRegistry })
+
+	assert.Nil(t, RegisterOperator("com.example.default", "MyRelu", 1, opFactoryFixture("Relu")))
+
+	factory, ok := DefaultRegistry.Lookup("com.example.default", "MyRelu", 1)
+	assert.True(t, ok)
+	assert.IsType(t, &opset13.Relu{}, factory())
+}
+
+// opFactoryFixture creates a factory for an operator of opset 13.
+func opFactoryFixture(opType string) OperatorFactory {
+	return func() ops.Operator {
+		op, _ := opset13.GetOperator(opType)
+		return op
+	}
+}